@@ -2,20 +2,28 @@ package di
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
 
 	"github.com/soulcodex/rockets-message-processor/configs"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
 	eventbus "github.com/soulcodex/rockets-message-processor/pkg/bus/event"
 	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
 	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
 	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
 	"github.com/soulcodex/rockets-message-processor/pkg/logger"
 	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+	"github.com/soulcodex/rockets-message-processor/pkg/scheduler"
 	"github.com/soulcodex/rockets-message-processor/pkg/utils"
 )
 
+// busInstrumentationName names the OpenTelemetry meter and tracer of the buses.
+const busInstrumentationName = "github.com/soulcodex/rockets-message-processor/pkg/bus"
+
 type CommonServices struct {
 	Config       *configs.Config
 	Logger       logger.ZerologLogger
@@ -24,7 +32,9 @@ type CommonServices struct {
 	QueryBus     querybus.Bus
 	Mutex        distributedsync.MutexService
 	Deduplicator messaging.Deduplicator
+	SequenceGaps messaging.SequenceGapsFinder
 	Router       *httpserver.Router
+	Scheduler    *scheduler.Scheduler
 	UUIDProvider utils.UUIDProvider
 	TimeProvider utils.DateTimeProvider
 }
@@ -44,12 +54,7 @@ func MustInitCommonServices(ctx context.Context) *CommonServices {
 
 	timeProvider := utils.NewSystemTimeProvider()
 
-	redisOpts, err := redis.ParseURL(cfg.RedisURL)
-	if err != nil {
-		panic(err)
-	}
-
-	redisClient := redis.NewClient(redisOpts)
+	redisClient := newRedisClient(cfg)
 
 	routerOpts := []httpserver.RouterConfigFunc{
 		httpserver.WithHost(cfg.HTTPHost),
@@ -58,14 +63,17 @@ func MustInitCommonServices(ctx context.Context) *CommonServices {
 		httpserver.WithWriteTimeoutSeconds(cfg.HTTPWriteTimeout),
 		httpserver.WithMiddleware(httpserver.NewPanicRecoverMiddleware(appLogger).Middleware),
 		httpserver.WithMiddleware(httpserver.NewRequestLoggingMiddleware(appLogger, timeProvider).Middleware),
+		httpserver.WithMiddleware(newIdempotencyMiddleware(cfg, redisClient, timeProvider, appLogger).Middleware),
 		httpserver.WithCORSMiddleware(),
 	}
 	router := httpserver.New(routerOpts...)
 
-	eventBus := eventbus.InitEventBus()
-	queryBus := querybus.InitQueryBus()
-	deduplicator := messaging.NewDefaultRedisDeduplicator(redisClient)
-	mutexService := distributedsync.NewRedisMutexService(redisClient, appLogger)
+	busMiddlewares := newBusMiddlewares(cfg, timeProvider, appLogger)
+	eventBus := eventbus.InitEventBus(busMiddlewares)
+	queryBus := querybus.InitQueryBus(busMiddlewares)
+	deduplicator, sequenceGaps := newMessageDeduplicator(cfg, redisClient, timeProvider, appLogger)
+	mutexService := newMutexService(cfg, redisClient, appLogger)
+	jobScheduler := newScheduler(cfg, redisClient, timeProvider, appLogger)
 	uuidProvider := utils.NewRandomUUIDProvider()
 
 	return &CommonServices{
@@ -75,13 +83,38 @@ func MustInitCommonServices(ctx context.Context) *CommonServices {
 		EventBus:     eventBus,
 		QueryBus:     queryBus,
 		Deduplicator: deduplicator,
+		SequenceGaps: sequenceGaps,
 		Mutex:        mutexService,
 		Router:       &router,
+		Scheduler:    jobScheduler,
 		UUIDProvider: uuidProvider,
 		TimeProvider: timeProvider,
 	}
 }
 
+// FlushAll drops the state shared through Redis, standalone services keep none.
+func (c *CommonServices) FlushAll(ctx context.Context) {
+	if c.RedisClient == nil {
+		return
+	}
+
+	c.RedisClient.FlushAll(ctx)
+}
+
+// Shutdown waits for the events queued to asynchronous subscribers to be handled, or for the context to be done.
+func (c *CommonServices) Shutdown(ctx context.Context) error {
+	fanOutBus, isFanOutBus := c.EventBus.(*eventbus.FanOutBus)
+	if !isFanOutBus {
+		return nil
+	}
+
+	if err := fanOutBus.Close(ctx); err != nil {
+		return fmt.Errorf("error closing event bus: %w", err)
+	}
+
+	return nil
+}
+
 func MustInitCommonServicesWithEnvFiles(ctx context.Context, envFiles ...string) *CommonServices {
 	err := godotenv.Overload(envFiles...)
 	if err != nil {
@@ -90,3 +123,151 @@ func MustInitCommonServicesWithEnvFiles(ctx context.Context, envFiles ...string)
 
 	return MustInitCommonServices(ctx)
 }
+
+// newRedisClient connects to Redis unless the service runs standalone.
+func newRedisClient(cfg *configs.Config) *redis.Client {
+	if cfg.Standalone() {
+		return nil
+	}
+
+	redisOpts, err := redis.ParseURL(cfg.RedisURL)
+	if err != nil {
+		panic(err)
+	}
+
+	return redis.NewClient(redisOpts)
+}
+
+// newMutexService locks keys in memory when standalone, otherwise on the Redis lock nodes following Redlock, or on
+// the shared Redis when there's none.
+func newMutexService(cfg *configs.Config, client *redis.Client, appLogger logger.ZerologLogger) distributedsync.MutexService {
+	lockDefaults := distributedsync.WithLockDefaults(
+		distributedsync.WithLockWaitBudget(cfg.MutexWaitBudget),
+		distributedsync.WithLockExpiry(cfg.MutexExpiry),
+		distributedsync.WithLockRetryDelay(cfg.MutexRetryDelay),
+	)
+
+	if cfg.Standalone() {
+		return distributedsync.NewInMemoryMutexService(lockDefaults)
+	}
+
+	if len(cfg.RedisLockURLs) == 0 {
+		return distributedsync.NewRedisMutexService(client, appLogger, lockDefaults)
+	}
+
+	lockClients := make([]*redis.Client, len(cfg.RedisLockURLs))
+	for i, url := range cfg.RedisLockURLs {
+		redisOpts, err := redis.ParseURL(url)
+		if err != nil {
+			panic(err)
+		}
+
+		lockClients[i] = redis.NewClient(redisOpts)
+	}
+
+	return distributedsync.NewRedlockMutexService(lockClients, appLogger, lockDefaults)
+}
+
+// newBusMiddlewares logs every bus input, recovering handlers from panics and bounding how long they may take, then
+// records its duration and span on the global OpenTelemetry providers, which are no-ops until the service sets them.
+func newBusMiddlewares(cfg *configs.Config, timeProvider utils.DateTimeProvider, appLogger logger.ZerologLogger) bus.ConfigFunc {
+	recorder, err := bus.NewOpenTelemetryDurationRecorder(otel.Meter(busInstrumentationName))
+	if err != nil {
+		panic(err)
+	}
+
+	return bus.WithMiddleware(
+		bus.NewLoggingMiddleware(appLogger, timeProvider).Middleware,
+		bus.NewPanicRecoverMiddleware(appLogger).Middleware,
+		bus.NewTimeoutMiddleware(cfg.BusHandlerTimeout).Middleware,
+		bus.NewMetricsMiddleware(recorder, timeProvider).Middleware,
+		bus.NewTracingMiddleware(bus.NewOpenTelemetryTracer(otel.Tracer(busInstrumentationName))).Middleware,
+	)
+}
+
+// newScheduler keeps the status of every job run in the configured store, so any instance can answer it.
+func newScheduler(
+	cfg *configs.Config,
+	client *redis.Client,
+	timeProvider utils.DateTimeProvider,
+	appLogger logger.ZerologLogger,
+) *scheduler.Scheduler {
+	var store scheduler.JobStatusStore = scheduler.NewRedisJobStatusStore(client)
+	if cfg.Standalone() || cfg.SchedulerJobStatusStore == "memory" {
+		store = scheduler.NewInMemoryJobStatusStore()
+	}
+
+	return scheduler.NewScheduler(store, timeProvider, appLogger)
+}
+
+// newIdempotencyMiddleware keeps the responses of requests carrying an Idempotency-Key header in the configured store.
+func newIdempotencyMiddleware(
+	cfg *configs.Config,
+	client *redis.Client,
+	timeProvider utils.DateTimeProvider,
+	appLogger logger.ZerologLogger,
+) *httpserver.IdempotencyMiddleware {
+	var store httpserver.IdempotencyStore = httpserver.NewRedisIdempotencyStore(client)
+	if cfg.Standalone() || cfg.HTTPIdempotencyStore == "memory" {
+		store = httpserver.NewInMemoryIdempotencyStore(timeProvider)
+	}
+
+	return httpserver.NewIdempotencyMiddleware(
+		store,
+		cfg.HTTPIdempotencyTTL,
+		appLogger,
+		httpserver.WithIdempotencyReservationTTL(time.Duration(cfg.HTTPWriteTimeout)*time.Second),
+	)
+}
+
+// newMessageDeduplicator builds the configured deduplication strategy on top of Redis, or in memory for standalone
+// services, applying the configured failure policy to it. Deduplicating by sequence also tracks the gaps of every
+// channel, nil otherwise.
+func newMessageDeduplicator(
+	cfg *configs.Config,
+	client *redis.Client,
+	timeProvider utils.DateTimeProvider,
+	appLogger logger.ZerologLogger,
+) (messaging.Deduplicator, messaging.SequenceGapsFinder) {
+	policy, err := messaging.NewDeduplicatorFailurePolicy(cfg.DeduplicationFailurePolicy)
+	if err != nil {
+		panic(err)
+	}
+
+	switch {
+	case cfg.DeduplicationStrategy == "key" && cfg.Standalone():
+		deduplicator := messaging.NewInMemoryDeduplicator(timeProvider, cfg.DeduplicationClaimTTL, cfg.DeduplicationMessageTTL)
+		return messaging.WithFailurePolicy(deduplicator, policy, appLogger), nil
+	case cfg.DeduplicationStrategy == "key":
+		deduplicator := messaging.NewDefaultRedisDeduplicator(
+			client,
+			messaging.WithRedisDeduplicatorClaimTTL(cfg.DeduplicationClaimTTL),
+			messaging.WithRedisDeduplicatorMessageTTL(cfg.DeduplicationMessageTTL),
+		)
+		return messaging.WithFailurePolicy(deduplicator, policy, appLogger), nil
+	case cfg.DeduplicationStrategy == "sequence" && cfg.Standalone():
+		deduplicator, windowErr := messaging.NewInMemorySequenceDeduplicator(
+			timeProvider,
+			cfg.DeduplicationClaimTTL,
+			cfg.DeduplicationSequenceWindow,
+		)
+		if windowErr != nil {
+			panic(windowErr)
+		}
+
+		return messaging.WithFailurePolicy(deduplicator, policy, appLogger), deduplicator
+	case cfg.DeduplicationStrategy == "sequence":
+		deduplicator, windowErr := messaging.NewRedisSequenceDeduplicator(
+			client,
+			messaging.WithRedisSequenceDeduplicatorClaimTTL(cfg.DeduplicationClaimTTL),
+			messaging.WithRedisSequenceDeduplicatorWindowSize(cfg.DeduplicationSequenceWindow),
+		)
+		if windowErr != nil {
+			panic(windowErr)
+		}
+
+		return messaging.WithFailurePolicy(deduplicator, policy, appLogger), deduplicator
+	default:
+		panic("invalid message deduplication strategy provided: " + cfg.DeduplicationStrategy)
+	}
+}
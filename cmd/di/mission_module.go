@@ -0,0 +1,90 @@
+package di
+
+import (
+	"context"
+
+	missionevents "github.com/soulcodex/rockets-message-processor/internal/mission/application/events"
+	missionqueries "github.com/soulcodex/rockets-message-processor/internal/mission/application/queries"
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	missionentrypoint "github.com/soulcodex/rockets-message-processor/internal/mission/infrastructure/entrypoint"
+	missionpersistence "github.com/soulcodex/rockets-message-processor/internal/mission/infrastructure/persistence"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	eventbus "github.com/soulcodex/rockets-message-processor/pkg/bus/event"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+type MissionModule struct {
+	Repository    missiondomain.MissionRepository
+	RocketCounter missiondomain.MissionRocketCounter
+	Creator       *missiondomain.MissionCreator
+	Retirer       *missiondomain.MissionRetirer
+}
+
+func NewMissionModule(_ context.Context, common *CommonServices) *MissionModule {
+	missionRepo := missionpersistence.NewInMemoryMissionRepository()
+	rocketCounter := missionpersistence.NewInMemoryMissionRocketCounter()
+	creator := missiondomain.NewMissionCreator(missionRepo)
+	retirer := missiondomain.NewMissionRetirer(missionRepo)
+
+	common.Router.Post(
+		"/missions",
+		missionentrypoint.HandleCreateMissionV1HTTP(
+			creator,
+			common.TimeProvider,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	common.Router.Post(
+		"/missions/{mission_code}/retire",
+		missionentrypoint.HandleRetireMissionV1HTTP(
+			retirer,
+			common.TimeProvider,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	common.Router.Get(
+		"/missions/{mission_code}",
+		missionentrypoint.HandleFindMissionV1HTTP(
+			common.QueryBus,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	common.Router.Get(
+		"/missions",
+		missionentrypoint.HandleListMissionsV1HTTP(
+			common.QueryBus,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	// Event bus handlers registration, rocket domain events are published once applied by the rocket module. Rocket
+	// counts are kept asynchronously, as the counter orders the changes of a rocket by their time anyway
+	rocketChangedEvtHandler := missionevents.NewCountRocketsOnRocketChanged(rocketCounter)
+	rocketChangedOpts := []eventbus.SubscriptionOptFunc{
+		eventbus.WithAsyncDelivery(),
+		eventbus.WithAsyncErrorHandler(func(ctx context.Context, event bus.Dto, err error) {
+			common.Logger.Error().Ctx(ctx).Err(err).Str("event_type", event.Type()).Msg("failed to count mission rockets")
+		}),
+	}
+	eventbus.MustSubscribe(common.EventBus, &rocketdomain.RocketCreated{}, rocketChangedEvtHandler, rocketChangedOpts...)
+	eventbus.MustSubscribe(common.EventBus, &rocketdomain.RocketMissionReassigned{}, rocketChangedEvtHandler, rocketChangedOpts...)
+	eventbus.MustSubscribe(common.EventBus, &rocketdomain.RocketDestroyed{}, rocketChangedEvtHandler, rocketChangedOpts...)
+
+	// Query bus handlers registration
+	findMissionHandler := missionqueries.NewFindMissionQueryHandler(missionRepo, rocketCounter)
+	bus.MustRegister(common.QueryBus, &missionqueries.FindMissionQuery{}, findMissionHandler)
+
+	listMissionsHandler := missionqueries.NewListMissionsQueryHandler(missionRepo, rocketCounter)
+	bus.MustRegister(common.QueryBus, &missionqueries.ListMissionsQuery{}, listMissionsHandler)
+
+	return &MissionModule{
+		Repository:    missionRepo,
+		RocketCounter: rocketCounter,
+		Creator:       creator,
+		Retirer:       retirer,
+	}
+}
@@ -2,26 +2,49 @@ package di
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	rocketevents "github.com/soulcodex/rockets-message-processor/internal/rocket/application/events"
 	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
 	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
 	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	rocketmission "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/mission"
 	rocketpersistence "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/persistence"
 	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	eventbus "github.com/soulcodex/rockets-message-processor/pkg/bus/event"
 	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
 )
 
 type RocketModule struct {
-	Repository rocketdomain.RocketRepository
-	Creator    *rocketdomain.RocketCreator
-	Updater    *rocketdomain.RocketUpdater
+	Repository   rocketdomain.RocketRepository
+	RocketTypes  rocketdomain.RocketTypeRegistry
+	SpeedHistory rocketdomain.RocketSpeedHistory
+	Messages     rocketdomain.RocketMessageLedger
+	Creator      *rocketdomain.RocketCreator
+	Updater      *rocketdomain.RocketUpdater
 }
 
-func NewRocketModule(_ context.Context, common *CommonServices) *RocketModule {
+func NewRocketModule(ctx context.Context, common *CommonServices) *RocketModule {
 	rocketRepo := rocketpersistence.NewInMemoryRocketRepository()
-	creator := rocketdomain.NewRocketCreator(rocketRepo)
-	updater := rocketdomain.NewRocketUpdater(rocketRepo)
+	speedHistory := newRocketSpeedHistory(common)
+	messageLedger := newRocketMessageLedger(common)
+	rocketTypes, rocketTypeResolver := newRocketTypeCatalog(ctx, common)
+	eventPublisher := eventbus.NewBusPublisher(common.EventBus)
+	creator := rocketdomain.NewRocketCreator(
+		rocketRepo,
+		rocketdomain.WithCreatorRocketTypes(rocketTypeResolver),
+		rocketdomain.WithCreatorEvents(eventPublisher),
+	)
+	updater := rocketdomain.NewRocketUpdater(
+		rocketRepo,
+		rocketdomain.WithUpdaterRocketTypes(rocketTypeResolver),
+		rocketdomain.WithUpdaterEvents(eventPublisher),
+	)
+
+	missionGuard := newMissionGuard(common)
+
+	registerRocketSpeedHistoryCompaction(ctx, common, speedHistory)
 
 	common.Router.Post(
 		"/messages",
@@ -29,6 +52,76 @@ func NewRocketModule(_ context.Context, common *CommonServices) *RocketModule {
 			common.EventBus,
 			common.Mutex,
 			common.Deduplicator,
+			messageLedger,
+			common.TimeProvider,
+			common.Logger,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+			newRocketEventDispatchOptions(common)...,
+		),
+	)
+
+	common.Router.Get(
+		"/messages/{event_id}",
+		rocketentrypoint.HandleFindRocketMessageV1HTTP(
+			common.QueryBus,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	common.Router.Get(
+		"/rockets/stats",
+		rocketentrypoint.HandleRocketStatsV1HTTP(
+			common.QueryBus,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	common.Router.Get(
+		"/rockets/{rocket_id}/speed",
+		rocketentrypoint.HandleRocketSpeedHistoryV1HTTP(
+			common.QueryBus,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	if common.SequenceGaps != nil {
+		common.Router.Get(
+			"/rockets/{rocket_id}/message-gaps",
+			rocketentrypoint.HandleRocketMessageGapsV1HTTP(
+				common.SequenceGaps,
+				httpserver.NewJSONResponseMiddleware(common.Logger),
+			),
+		)
+	}
+
+	common.Router.Get(
+		"/rockets/{rocket_id}/missions",
+		rocketentrypoint.HandleFindRocketMissionsV1HTTP(
+			common.QueryBus,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	common.Router.Get(
+		"/missions/{mission}/rockets",
+		rocketentrypoint.HandleFindMissionRocketsV1HTTP(
+			common.QueryBus,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	common.Router.Get(
+		"/rocket-types",
+		rocketentrypoint.HandleListRocketTypesV1HTTP(
+			common.QueryBus,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	common.Router.Put(
+		"/rocket-types/{rocket_type}",
+		rocketentrypoint.HandleRegisterRocketTypeV1HTTP(
+			rocketTypes,
 			httpserver.NewJSONResponseMiddleware(common.Logger),
 		),
 	)
@@ -50,13 +143,18 @@ func NewRocketModule(_ context.Context, common *CommonServices) *RocketModule {
 	)
 
 	// Event bus handlers registration
-	launchEvtHandler := rocketevents.NewCreateRocketOnRocketLaunched(creator)
+	launchEvtHandler := rocketevents.NewCreateRocketOnRocketLaunched(creator, missionGuard)
 	bus.MustRegister(common.EventBus, &rocketevents.RocketLaunched{}, launchEvtHandler)
 
 	explodeEvtHandler := rocketevents.NewDeleteRocketOnRocketExploded(updater)
 	bus.MustRegister(common.EventBus, &rocketevents.RocketExploded{}, explodeEvtHandler)
 
-	paramsChangeEvtHandler := rocketevents.NewUpdateRocketOnRocketParamsChanged(updater)
+	paramsChangeEvtHandler := rocketevents.NewUpdateRocketOnRocketParamsChanged(
+		updater,
+		speedHistory,
+		missionGuard,
+		common.Logger,
+	)
 	bus.MustRegister(common.EventBus, &rocketevents.RocketMissionChanged{}, paramsChangeEvtHandler)
 	bus.MustRegister(common.EventBus, &rocketevents.RocketSpeedIncreased{}, paramsChangeEvtHandler)
 	bus.MustRegister(common.EventBus, &rocketevents.RocketSpeedDecreased{}, paramsChangeEvtHandler)
@@ -68,9 +166,143 @@ func NewRocketModule(_ context.Context, common *CommonServices) *RocketModule {
 	searchRocketsHandler := rocketqueries.NewSearchRocketsQueryHandler(rocketRepo)
 	bus.MustRegister(common.QueryBus, &rocketqueries.SearchRocketsQuery{}, searchRocketsHandler)
 
+	rocketStatsHandler := rocketqueries.NewRocketStatsQueryHandler(rocketRepo)
+	bus.MustRegister(common.QueryBus, &rocketqueries.RocketStatsQuery{}, rocketStatsHandler)
+
+	rocketMissionsHandler := rocketqueries.NewFindRocketMissionsQueryHandler(rocketRepo)
+	bus.MustRegister(common.QueryBus, &rocketqueries.FindRocketMissionsQuery{}, rocketMissionsHandler)
+
+	missionRocketsHandler := rocketqueries.NewFindMissionRocketsQueryHandler(rocketRepo, common.TimeProvider)
+	bus.MustRegister(common.QueryBus, &rocketqueries.FindMissionRocketsQuery{}, missionRocketsHandler)
+
+	speedHistoryHandler := rocketqueries.NewFindRocketSpeedHistoryQueryHandler(speedHistory, common.TimeProvider)
+	bus.MustRegister(common.QueryBus, &rocketqueries.FindRocketSpeedHistoryQuery{}, speedHistoryHandler)
+
+	listRocketTypesHandler := rocketqueries.NewListRocketTypesQueryHandler(rocketTypes)
+	bus.MustRegister(common.QueryBus, &rocketqueries.ListRocketTypesQuery{}, listRocketTypesHandler)
+
+	findRocketMessageHandler := rocketqueries.NewFindRocketMessageQueryHandler(messageLedger)
+	bus.MustRegister(common.QueryBus, &rocketqueries.FindRocketMessageQuery{}, findRocketMessageHandler)
+
 	return &RocketModule{
-		Repository: rocketRepo,
-		Creator:    creator,
-		Updater:    updater,
+		Repository:   rocketRepo,
+		RocketTypes:  rocketTypes,
+		SpeedHistory: speedHistory,
+		Messages:     messageLedger,
+		Creator:      creator,
+		Updater:      updater,
+	}
+}
+
+// newRocketTypeCatalog loads the rocket type catalog from the configured file, if any, applying the
+// configured policy to rocket types missing from it.
+func newRocketTypeCatalog(
+	ctx context.Context,
+	common *CommonServices,
+) (rocketdomain.RocketTypeRegistry, *rocketdomain.RocketTypeResolver) {
+	policy, err := rocketdomain.NewUnknownRocketTypePolicy(common.Config.RocketTypesUnknownPolicy)
+	if err != nil {
+		panic(err)
+	}
+
+	registry := rocketpersistence.NewInMemoryRocketTypeRegistry()
+	if common.Config.RocketTypesFile != "" {
+		if loadErr := rocketpersistence.LoadRocketTypeCatalog(ctx, common.Config.RocketTypesFile, registry); loadErr != nil {
+			panic(loadErr)
+		}
+	}
+
+	return registry, rocketdomain.NewRocketTypeResolver(registry, policy)
+}
+
+// newMissionGuard checks missions against the catalog of the missions module only when enabled.
+func newMissionGuard(common *CommonServices) rocketdomain.MissionGuard {
+	if !common.Config.RocketMissionValidation {
+		return rocketdomain.NewPermissiveMissionGuard()
+	}
+
+	return rocketmission.NewQueryBusMissionGuard(common.QueryBus)
+}
+
+// newRocketEventDispatchOptions makes rocket events either wait for their rocket to be free or be rejected right
+// away while it's being changed.
+func newRocketEventDispatchOptions(common *CommonServices) []bus.DispatchBlockingOptFunc {
+	switch common.Config.MutexLocking {
+	case "wait":
+		return nil
+	case "try":
+		return []bus.DispatchBlockingOptFunc{bus.WithTryLock()}
+	default:
+		panic("invalid mutex locking mode provided: " + common.Config.MutexLocking)
+	}
+}
+
+func newRocketSpeedHistory(common *CommonServices) rocketdomain.RocketSpeedHistory {
+	if inMemoryRocketSpeedHistory(common) {
+		return rocketpersistence.NewInMemoryRocketSpeedHistory()
+	}
+
+	return rocketpersistence.NewRedisRocketSpeedHistory(common.RedisClient)
+}
+
+func newRocketMessageLedger(common *CommonServices) rocketdomain.RocketMessageLedger {
+	if common.Config.Standalone() || common.Config.MessageLedgerStore == "memory" {
+		return rocketpersistence.NewInMemoryRocketMessageLedger(common.Config.MessageLedgerRetention, common.TimeProvider)
+	}
+
+	return rocketpersistence.NewRedisRocketMessageLedger(common.RedisClient, common.Config.MessageLedgerRetention)
+}
+
+func inMemoryRocketSpeedHistory(common *CommonServices) bool {
+	return common.Config.Standalone() || common.Config.SpeedHistoryStore == "memory"
+}
+
+// registerRocketSpeedHistoryCompaction applies the retention policy every compaction interval. Histories kept in
+// memory are compacted by every instance on its own until the context is done, while the history shared through
+// Redis is compacted once across instances, by the leader running the scheduled jobs.
+func registerRocketSpeedHistoryCompaction(ctx context.Context, common *CommonServices, history rocketdomain.RocketSpeedHistory) {
+	policy, err := rocketdomain.NewRocketSpeedRetentionPolicy(
+		common.Config.SpeedHistoryRawRetention,
+		common.Config.SpeedHistoryBucketSize,
+		common.Config.SpeedHistoryBucketRetention,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	compact := func(ctx context.Context) error {
+		if compactErr := history.Compact(ctx, policy, common.TimeProvider.Now()); compactErr != nil {
+			return fmt.Errorf("failed to compact rocket speed history: %w", compactErr)
+		}
+
+		return nil
+	}
+
+	if inMemoryRocketSpeedHistory(common) {
+		go func() {
+			ticker := time.NewTicker(common.Config.SpeedHistoryCompactionInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if compactErr := compact(ctx); compactErr != nil {
+						common.Logger.Error().Ctx(ctx).Err(compactErr).Msg("failed to compact rocket speed history")
+					}
+				}
+			}
+		}()
+
+		return
+	}
+
+	if err = common.Scheduler.Register(
+		"rocket-speed-history-compaction",
+		"@every "+common.Config.SpeedHistoryCompactionInterval.String(),
+		compact,
+	); err != nil {
+		panic(err)
 	}
 }
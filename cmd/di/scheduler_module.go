@@ -0,0 +1,51 @@
+package di
+
+import (
+	"context"
+
+	schedulerentrypoint "github.com/soulcodex/rockets-message-processor/internal/scheduler/infrastructure/entrypoint"
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+type SchedulerModule struct {
+	Leader *distributedsync.LeaderElector
+}
+
+// NewSchedulerModule campaigns for leadership until the context is done, running the jobs registered on the
+// scheduler by the other modules only while leading, so it must be built after them.
+func NewSchedulerModule(ctx context.Context, common *CommonServices) *SchedulerModule {
+	leader := distributedsync.NewLeaderElector(
+		newLeaderMutexService(common),
+		common.Config.SchedulerLeaderElection,
+		common.Logger,
+		distributedsync.WithLeaderLease(common.Config.SchedulerLeaderLease),
+		distributedsync.WithLeaderRetryInterval(common.Config.SchedulerLeaderRetryInterval),
+	)
+
+	go leader.Campaign(ctx, distributedsync.LeaderCallbacks{
+		OnElected: common.Scheduler.Run,
+		OnLost:    nil,
+	})
+
+	common.Router.Get(
+		"/admin/jobs",
+		schedulerentrypoint.HandleListJobsV1HTTP(
+			common.Scheduler,
+			leader.IsLeader,
+			httpserver.NewJSONResponseMiddleware(common.Logger),
+		),
+	)
+
+	return &SchedulerModule{Leader: leader}
+}
+
+// newLeaderMutexService elects the leader through the shared mutex service, but standalone services lead through
+// a mutex service of their own so the long held leadership lock never shares a lock with other keys.
+func newLeaderMutexService(common *CommonServices) distributedsync.MutexService {
+	if common.Config.Standalone() {
+		return distributedsync.NewInMemoryMutexService()
+	}
+
+	return common.Mutex
+}
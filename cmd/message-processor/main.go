@@ -4,18 +4,23 @@ import (
 	"context"
 	"os/signal"
 	"syscall"
+	"time"
 
 	_ "github.com/joho/godotenv/autoload"
 
 	"github.com/soulcodex/rockets-message-processor/cmd/di"
 )
 
+const shutdownTimeout = 10 * time.Second
+
 func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
 	common := di.MustInitCommonServices(ctx)
 	_ = di.NewRocketModule(ctx, common)
+	_ = di.NewMissionModule(ctx, common)
+	_ = di.NewSchedulerModule(ctx, common)
 
 	go func() {
 		common.Logger.Info().
@@ -30,4 +35,11 @@ func main() {
 
 	common.Logger.Info().Msg("message processor started successfully")
 	<-ctx.Done()
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer shutdownCancel()
+
+	if shutdownErr := common.Shutdown(shutdownCtx); shutdownErr != nil {
+		common.Logger.Error().Err(shutdownErr).Msg("error shutting down message processor")
+	}
 }
@@ -2,35 +2,128 @@ package configs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
 )
 
+// AppConfig describes the service, its runtime mode being either distributed, sharing state through Redis with
+// the other instances, or standalone, keeping every state in memory so it runs with no external dependencies.
 type AppConfig struct {
 	AppServiceName string `env:"SERVICE_NAME"`
 	AppEnv         string `env:"ENV"`
 	AppVersion     string `env:"VERSION"`
+	AppRuntimeMode string `env:"RUNTIME_MODE" envDefault:"distributed"`
 }
+
+// Standalone tells whether the service runs with no external dependencies.
+func (c AppConfig) Standalone() bool {
+	return c.AppRuntimeMode == "standalone"
+}
+
+// RedisConfig sets the Redis every state is shared through, along with the independent Redis nodes mutexes are
+// locked on following Redlock, a majority of them having to grant every mutex. Mutexes are locked on the Redis at
+// RedisURL when no lock node is set.
 type RedisConfig struct {
-	RedisURL string `env:"URL" envDefault:"redis://localhost:6379"`
+	RedisURL      string   `env:"URL" envDefault:"redis://localhost:6379"`
+	RedisLockURLs []string `env:"LOCK_URLS" envSeparator:","`
 }
 
+// HTTPConfig sets the HTTP server up, along with where the responses of requests carrying an Idempotency-Key
+// header are kept (redis or memory) and for how long they are replayed.
 type HTTPConfig struct {
-	HTTPHost         string `env:"HOST" envDefault:"0.0.0.0"`
-	HTTPPort         int    `env:"PORT" envDefault:"8080"`
-	HTTPReadTimeout  int    `env:"READ_TIMEOUT" envDefault:"30"`
-	HTTPWriteTimeout int    `env:"WRITE_TIMEOUT" envDefault:"30"`
+	HTTPHost             string        `env:"HOST" envDefault:"0.0.0.0"`
+	HTTPPort             int           `env:"PORT" envDefault:"8080"`
+	HTTPReadTimeout      int           `env:"READ_TIMEOUT" envDefault:"30"`
+	HTTPWriteTimeout     int           `env:"WRITE_TIMEOUT" envDefault:"30"`
+	HTTPIdempotencyStore string        `env:"IDEMPOTENCY_STORE" envDefault:"redis"`
+	HTTPIdempotencyTTL   time.Duration `env:"IDEMPOTENCY_TTL" envDefault:"24h"`
+}
+
+// RocketSpeedHistoryConfig sets where speed changes are recorded (redis or memory) and how long they are kept,
+// raw points older than RawRetention are compacted into buckets of BucketSize every CompactionInterval.
+type RocketSpeedHistoryConfig struct {
+	SpeedHistoryStore              string        `env:"STORE" envDefault:"redis"`
+	SpeedHistoryRawRetention       time.Duration `env:"RAW_RETENTION" envDefault:"24h"`
+	SpeedHistoryBucketSize         time.Duration `env:"BUCKET_SIZE" envDefault:"5m"`
+	SpeedHistoryBucketRetention    time.Duration `env:"BUCKET_RETENTION" envDefault:"720h"`
+	SpeedHistoryCompactionInterval time.Duration `env:"COMPACTION_INTERVAL" envDefault:"10m"`
+}
+
+// RocketMissionConfig sets whether rocket missions must be active missions of the catalog.
+type RocketMissionConfig struct {
+	RocketMissionValidation bool `env:"VALIDATION" envDefault:"false"`
+}
+
+// RocketTypesConfig sets the file the rocket type catalog is loaded from at startup, if any, and what
+// happens with rockets launched with a type missing from it (reject, flag or register).
+type RocketTypesConfig struct {
+	RocketTypesFile          string `env:"FILE"`
+	RocketTypesUnknownPolicy string `env:"UNKNOWN_POLICY" envDefault:"register"`
+}
+
+// RocketMessageLedgerConfig sets where the outcome of every received rocket message is recorded (redis or memory)
+// and how long it can be looked up.
+type RocketMessageLedgerConfig struct {
+	MessageLedgerStore     string        `env:"STORE" envDefault:"redis"`
+	MessageLedgerRetention time.Duration `env:"RETENTION" envDefault:"168h"`
+}
+
+// MessageDeduplicationConfig sets how messages are deduplicated, by key (kept for MessageTTL) or by sequence (through
+// the per channel high-water mark and a window of SequenceWindow numbers below it), whether messages are processed
+// anyway (open) or rejected (closed) while the deduplicator backend is unavailable and how long a claim lasts before
+// being considered crashed.
+type MessageDeduplicationConfig struct {
+	DeduplicationStrategy       string        `env:"STRATEGY" envDefault:"key"`
+	DeduplicationFailurePolicy  string        `env:"FAILURE_POLICY" envDefault:"closed"`
+	DeduplicationClaimTTL       time.Duration `env:"CLAIM_TTL" envDefault:"30s"`
+	DeduplicationMessageTTL     time.Duration `env:"MESSAGE_TTL" envDefault:"29s"`
+	DeduplicationSequenceWindow uint64        `env:"SEQUENCE_WINDOW" envDefault:"1024"`
+}
+
+// MutexConfig sets whether rocket events wait for their rocket to be free (wait) or are rejected right away while
+// it's being changed (try), how long waiting lasts at most, how long a lease lasts before being extended and how long
+// to wait between attempts, also answered to producers as a retry hint.
+type MutexConfig struct {
+	MutexLocking    string        `env:"LOCKING" envDefault:"wait"`
+	MutexWaitBudget time.Duration `env:"WAIT_BUDGET" envDefault:"10s"`
+	MutexExpiry     time.Duration `env:"EXPIRY" envDefault:"30s"`
+	MutexRetryDelay time.Duration `env:"RETRY_DELAY" envDefault:"250ms"`
+}
+
+// BusConfig sets how long the handlers of the command, query and event buses may take at most, handlers being told
+// through the deadline of their context. Zero means no timeout.
+type BusConfig struct {
+	BusHandlerTimeout time.Duration `env:"HANDLER_TIMEOUT" envDefault:"30s"`
+}
+
+// SchedulerConfig sets the leader election jobs run under, how long the leadership lease lasts before being extended
+// and how long followers wait between attempts to take over, along with where the status of every job run is kept
+// (redis or memory).
+type SchedulerConfig struct {
+	SchedulerLeaderElection      string        `env:"LEADER_ELECTION" envDefault:"scheduler"`
+	SchedulerLeaderLease         time.Duration `env:"LEADER_LEASE" envDefault:"15s"`
+	SchedulerLeaderRetryInterval time.Duration `env:"LEADER_RETRY_INTERVAL" envDefault:"5s"`
+	SchedulerJobStatusStore      string        `env:"JOB_STATUS_STORE" envDefault:"redis"`
 }
 
 type UncategorizedConfig struct {
 	LogLevel string `env:"LOG_LEVEL" envDefault:"debug"`
 }
 type Config struct {
-	AppConfig           `envPrefix:"APP_"`
-	HTTPConfig          `envPrefix:"HTTP_"`
-	RedisConfig         `envPrefix:"REDIS_"`
-	UncategorizedConfig `envPrefix:""`
+	AppConfig                  `envPrefix:"APP_"`
+	HTTPConfig                 `envPrefix:"HTTP_"`
+	RedisConfig                `envPrefix:"REDIS_"`
+	RocketSpeedHistoryConfig   `envPrefix:"ROCKET_SPEED_HISTORY_"`
+	RocketMissionConfig        `envPrefix:"ROCKET_MISSION_"`
+	RocketTypesConfig          `envPrefix:"ROCKET_TYPES_"`
+	RocketMessageLedgerConfig  `envPrefix:"ROCKET_MESSAGE_LEDGER_"`
+	MessageDeduplicationConfig `envPrefix:"MESSAGE_DEDUPLICATION_"`
+	MutexConfig                `envPrefix:"MUTEX_"`
+	BusConfig                  `envPrefix:"BUS_"`
+	SchedulerConfig            `envPrefix:"SCHEDULER_"`
+	UncategorizedConfig        `envPrefix:""`
 }
 
 func LoadConfig() (*Config, error) {
@@ -0,0 +1,54 @@
+package missionevents
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+)
+
+// CountRocketsOnRocketChanged keeps the per-mission rocket counts as rockets are created,
+// reassigned to another mission or destroyed. It follows the rocket domain events, so only
+// changes actually applied to rockets are counted.
+type CountRocketsOnRocketChanged struct {
+	counter missiondomain.MissionRocketCounter
+}
+
+func NewCountRocketsOnRocketChanged(counter missiondomain.MissionRocketCounter) *CountRocketsOnRocketChanged {
+	return &CountRocketsOnRocketChanged{
+		counter: counter,
+	}
+}
+
+func (e *CountRocketsOnRocketChanged) Handle(ctx context.Context, evt domain.Event) (interface{}, error) {
+	switch event := evt.(type) {
+	case *rocketdomain.RocketCreated:
+		return e.assign(ctx, event.RocketID.String(), event.Mission.String(), event.At)
+	case *rocketdomain.RocketMissionReassigned:
+		return e.assign(ctx, event.RocketID.String(), event.To.String(), event.At)
+	case *rocketdomain.RocketDestroyed:
+		if err := e.counter.Unassign(ctx, event.RocketID.String(), event.At); err != nil {
+			return nil, fmt.Errorf("error uncounting mission rocket: %w", err)
+		}
+
+		return struct{}{}, nil
+	default:
+		return nil, fmt.Errorf("unhandled rocket event type: %s", evt.Type())
+	}
+}
+
+func (e *CountRocketsOnRocketChanged) assign(ctx context.Context, rocketID, mission string, at time.Time) (interface{}, error) {
+	code, err := missiondomain.NewMissionCode(mission)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mission code: %w", err)
+	}
+
+	if assignErr := e.counter.Assign(ctx, rocketID, code, at); assignErr != nil {
+		return nil, fmt.Errorf("error counting mission rocket: %w", assignErr)
+	}
+
+	return struct{}{}, nil
+}
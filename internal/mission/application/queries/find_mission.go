@@ -0,0 +1,50 @@
+package missionqueries
+
+import (
+	"context"
+	"fmt"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+)
+
+type FindMissionQuery struct {
+	Code string
+}
+
+func (q *FindMissionQuery) Type() string {
+	return "find_mission_query"
+}
+
+type FindMissionQueryHandler struct {
+	repository missiondomain.MissionRepository
+	counter    missiondomain.MissionRocketCounter
+}
+
+func NewFindMissionQueryHandler(
+	repository missiondomain.MissionRepository,
+	counter missiondomain.MissionRocketCounter,
+) *FindMissionQueryHandler {
+	return &FindMissionQueryHandler{
+		repository: repository,
+		counter:    counter,
+	}
+}
+
+func (h *FindMissionQueryHandler) Handle(ctx context.Context, q *FindMissionQuery) (MissionResponse, error) {
+	code, err := missiondomain.NewMissionCode(q.Code)
+	if err != nil {
+		return MissionResponse{}, fmt.Errorf("invalid mission code provided: %w", err)
+	}
+
+	mission, err := h.repository.Find(ctx, code)
+	if err != nil {
+		return MissionResponse{}, fmt.Errorf("error while finding mission by code: %w", err)
+	}
+
+	rocketCount, err := h.counter.Count(ctx, code)
+	if err != nil {
+		return MissionResponse{}, fmt.Errorf("error while counting mission rockets: %w", err)
+	}
+
+	return newMissionResponse(mission.Primitives(), rocketCount), nil
+}
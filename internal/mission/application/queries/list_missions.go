@@ -0,0 +1,48 @@
+package missionqueries
+
+import (
+	"context"
+	"fmt"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+)
+
+type ListMissionsQuery struct{}
+
+func (q *ListMissionsQuery) Type() string {
+	return "list_missions_query"
+}
+
+type ListMissionsQueryHandler struct {
+	repository missiondomain.MissionRepository
+	counter    missiondomain.MissionRocketCounter
+}
+
+func NewListMissionsQueryHandler(
+	repository missiondomain.MissionRepository,
+	counter missiondomain.MissionRocketCounter,
+) *ListMissionsQueryHandler {
+	return &ListMissionsQueryHandler{
+		repository: repository,
+		counter:    counter,
+	}
+}
+
+func (h *ListMissionsQueryHandler) Handle(ctx context.Context, _ *ListMissionsQuery) (MissionsResponse, error) {
+	missions, err := h.repository.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing missions: %w", err)
+	}
+
+	response := make(MissionsResponse, len(missions))
+	for i, mission := range missions {
+		rocketCount, countErr := h.counter.Count(ctx, mission.Code())
+		if countErr != nil {
+			return nil, fmt.Errorf("error while counting mission rockets: %w", countErr)
+		}
+
+		response[i] = newMissionResponse(mission.Primitives(), rocketCount)
+	}
+
+	return response, nil
+}
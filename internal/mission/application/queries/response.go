@@ -0,0 +1,31 @@
+package missionqueries
+
+import (
+	"time"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+)
+
+type MissionsResponse []MissionResponse
+
+type MissionResponse struct {
+	Code        string
+	Name        string
+	Target      string
+	Status      string
+	RocketCount int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func newMissionResponse(p missiondomain.MissionPrimitives, rocketCount int) MissionResponse {
+	return MissionResponse{
+		Code:        p.Code,
+		Name:        p.Name,
+		Target:      p.Target,
+		Status:      p.Status,
+		RocketCount: rocketCount,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
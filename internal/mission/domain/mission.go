@@ -0,0 +1,50 @@
+package missiondomain
+
+import (
+	"time"
+)
+
+type Mission struct {
+	code      MissionCode
+	name      MissionName
+	target    MissionTarget
+	status    MissionStatus
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewMission(code MissionCode, name MissionName, target MissionTarget, at time.Time) *Mission {
+	return &Mission{
+		code:      code,
+		name:      name,
+		target:    target,
+		status:    MissionStatusActive,
+		createdAt: at,
+		updatedAt: at,
+	}
+}
+
+func (m *Mission) Primitives() MissionPrimitives {
+	return primitivesFromDomain(m)
+}
+
+func (m *Mission) Code() MissionCode {
+	return m.code
+}
+
+// IsActive reports whether rockets can still be assigned to the mission.
+func (m *Mission) IsActive() bool {
+	return m.status == MissionStatusActive
+}
+
+// Retire closes the mission to new rockets, a mission can only be retired once.
+func (m *Mission) Retire(at time.Time) error {
+	if !m.IsActive() {
+		return NewMissionAlreadyRetiredError(m.code)
+	}
+
+	m.status = MissionStatusRetired
+	m.updatedAt = at
+
+	return nil
+}
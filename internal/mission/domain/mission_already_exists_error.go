@@ -0,0 +1,32 @@
+package missiondomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const missionAlreadyExistsErrorMessage = "mission already exists."
+
+type MissionAlreadyExistsError struct {
+	domain.BaseError
+}
+
+func NewMissionAlreadyExistsError(code MissionCode) *MissionAlreadyExistsError {
+	return &MissionAlreadyExistsError{
+		BaseError: domain.NewError(
+			missionAlreadyExistsErrorMessage,
+			errutil.WithMetadataKeyValue("mission.code", code.String()),
+		),
+	}
+}
+
+func (mae *MissionAlreadyExistsError) Error() string {
+	return missionAlreadyExistsErrorMessage
+}
+
+func IsMissionAlreadyExistsError(err error) bool {
+	var self *MissionAlreadyExistsError
+	return errors.As(err, &self)
+}
@@ -0,0 +1,32 @@
+package missiondomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const missionAlreadyRetiredErrorMessage = "mission already retired."
+
+type MissionAlreadyRetiredError struct {
+	domain.BaseError
+}
+
+func NewMissionAlreadyRetiredError(code MissionCode) *MissionAlreadyRetiredError {
+	return &MissionAlreadyRetiredError{
+		BaseError: domain.NewError(
+			missionAlreadyRetiredErrorMessage,
+			errutil.WithMetadataKeyValue("mission.code", code.String()),
+		),
+	}
+}
+
+func (mar *MissionAlreadyRetiredError) Error() string {
+	return missionAlreadyRetiredErrorMessage
+}
+
+func IsMissionAlreadyRetiredError(err error) bool {
+	var self *MissionAlreadyRetiredError
+	return errors.As(err, &self)
+}
@@ -0,0 +1,36 @@
+package missiondomain
+
+import (
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	domainvalidation "github.com/soulcodex/rockets-message-processor/pkg/domain/validation"
+)
+
+const (
+	maxMissionCodeLength = 64
+)
+
+var (
+	ErrInvalidMissionCodeProvided = domain.NewError("invalid mission code provided")
+)
+
+// MissionCode identifies a mission, it's the value rockets carry as their mission.
+type MissionCode string
+
+func NewMissionCode(code string) (MissionCode, error) {
+	missionCode := MissionCode(code)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[string](),
+		domainvalidation.MaxLength(maxMissionCodeLength),
+	)
+
+	if err := validation.Validate(code); err != nil {
+		return "", ErrInvalidMissionCodeProvided.Wrap(err)
+	}
+
+	return missionCode, nil
+}
+
+func (c MissionCode) String() string {
+	return string(c)
+}
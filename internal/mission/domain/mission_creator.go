@@ -0,0 +1,57 @@
+package missiondomain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type MissionCreateParams struct {
+	Code   string
+	Name   string
+	Target string
+	At     time.Time
+}
+
+type MissionCreator struct {
+	repository MissionRepository
+}
+
+func NewMissionCreator(repository MissionRepository) *MissionCreator {
+	return &MissionCreator{
+		repository: repository,
+	}
+}
+
+func (c *MissionCreator) Create(ctx context.Context, dto MissionCreateParams) (*Mission, error) {
+	code, err := NewMissionCode(dto.Code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mission code: %w", err)
+	}
+
+	name, err := NewMissionName(dto.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mission name: %w", err)
+	}
+
+	target, err := NewMissionTarget(dto.Target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mission target: %w", err)
+	}
+
+	_, err = c.repository.Find(ctx, code)
+	switch {
+	case err == nil:
+		return nil, NewMissionAlreadyExistsError(code)
+	case !IsMissionNotFoundError(err):
+		return nil, fmt.Errorf("failed to find mission: %w", err)
+	}
+
+	mission := NewMission(code, name, target, dto.At)
+
+	if saveErr := c.repository.Save(ctx, mission); saveErr != nil {
+		return nil, fmt.Errorf("failed to save mission: %w", saveErr)
+	}
+
+	return mission, nil
+}
@@ -0,0 +1,115 @@
+package missiondomain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	missionmock "github.com/soulcodex/rockets-message-processor/internal/mission/domain/mock"
+)
+
+func validMissionCreateParams() missiondomain.MissionCreateParams {
+	return missiondomain.MissionCreateParams{
+		Code:   "ARTEMIS",
+		Name:   "Artemis program",
+		Target: "Moon",
+		At:     time.Now(),
+	}
+}
+
+func TestMissionCreator_Create(t *testing.T) {
+	ctx := context.Background()
+	notFound := func(_ context.Context, code missiondomain.MissionCode) (*missiondomain.Mission, error) {
+		return nil, missiondomain.NewMissionNotFoundError(code)
+	}
+
+	tests := []struct {
+		name          string
+		input         missiondomain.MissionCreateParams
+		setupMock     func(repo *missionmock.MissionRepositoryMock)
+		expectedError string
+	}{
+		{
+			name:  "should create mission successfully",
+			input: validMissionCreateParams(),
+			setupMock: func(repo *missionmock.MissionRepositoryMock) {
+				repo.FindFunc = notFound
+				repo.SaveFunc = func(ctx context.Context, m *missiondomain.Mission) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "should fail on invalid mission code",
+			input: func() missiondomain.MissionCreateParams {
+				in := validMissionCreateParams()
+				in.Code = ""
+				return in
+			}(),
+			setupMock:     func(repo *missionmock.MissionRepositoryMock) {},
+			expectedError: "invalid mission code",
+		},
+		{
+			name: "should fail on invalid mission target",
+			input: func() missiondomain.MissionCreateParams {
+				in := validMissionCreateParams()
+				in.Target = ""
+				return in
+			}(),
+			setupMock:     func(repo *missionmock.MissionRepositoryMock) {},
+			expectedError: "invalid mission target",
+		},
+		{
+			name:  "should fail when the mission already exists",
+			input: validMissionCreateParams(),
+			setupMock: func(repo *missionmock.MissionRepositoryMock) {
+				repo.FindFunc = func(_ context.Context, code missiondomain.MissionCode) (*missiondomain.Mission, error) {
+					return missiondomain.NewMission(code, "Artemis program", "Moon", time.Now()), nil
+				}
+			},
+			expectedError: "mission already exists",
+		},
+		{
+			name:  "should fail when the repository save fails",
+			input: validMissionCreateParams(),
+			setupMock: func(repo *missionmock.MissionRepositoryMock) {
+				repo.FindFunc = notFound
+				repo.SaveFunc = func(ctx context.Context, m *missiondomain.Mission) error {
+					return errors.New("db error")
+				}
+			},
+			expectedError: "failed to save mission",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &missionmock.MissionRepositoryMock{}
+			tt.setupMock(repo)
+
+			mission, err := missiondomain.NewMissionCreator(repo).Create(ctx, tt.input)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+				assert.Nil(t, mission)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, mission.IsActive())
+			assert.Equal(t, missiondomain.MissionCode(tt.input.Code), mission.Code())
+		})
+	}
+}
+
+func TestMission_Retire(t *testing.T) {
+	mission := missiondomain.NewMission("ARTEMIS", "Artemis program", "Moon", time.Now())
+
+	require.NoError(t, mission.Retire(time.Now()))
+	assert.False(t, mission.IsActive())
+	assert.True(t, missiondomain.IsMissionAlreadyRetiredError(mission.Retire(time.Now())))
+}
@@ -0,0 +1,12 @@
+package missiondomain
+
+import (
+	"errors"
+)
+
+// IsInvalidMissionError reports whether the error comes from validating the attributes of a mission.
+func IsInvalidMissionError(err error) bool {
+	return errors.Is(err, ErrInvalidMissionCodeProvided) ||
+		errors.Is(err, ErrInvalidMissionNameProvided) ||
+		errors.Is(err, ErrInvalidMissionTargetProvided)
+}
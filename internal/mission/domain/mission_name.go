@@ -0,0 +1,30 @@
+package missiondomain
+
+import (
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	domainvalidation "github.com/soulcodex/rockets-message-processor/pkg/domain/validation"
+)
+
+var (
+	ErrInvalidMissionNameProvided = domain.NewError("invalid mission name provided")
+)
+
+type MissionName string
+
+func NewMissionName(name string) (MissionName, error) {
+	missionName := MissionName(name)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[string](),
+	)
+
+	if err := validation.Validate(name); err != nil {
+		return "", ErrInvalidMissionNameProvided.Wrap(err)
+	}
+
+	return missionName, nil
+}
+
+func (n MissionName) String() string {
+	return string(n)
+}
@@ -0,0 +1,32 @@
+package missiondomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const missionNotExistsErrorMessage = "mission doesn't exists."
+
+type MissionNotFoundError struct {
+	domain.BaseError
+}
+
+func NewMissionNotFoundError(code MissionCode) *MissionNotFoundError {
+	return &MissionNotFoundError{
+		BaseError: domain.NewError(
+			missionNotExistsErrorMessage,
+			errutil.WithMetadataKeyValue("mission.code", code.String()),
+		),
+	}
+}
+
+func (mnf *MissionNotFoundError) Error() string {
+	return missionNotExistsErrorMessage
+}
+
+func IsMissionNotFoundError(err error) bool {
+	var self *MissionNotFoundError
+	return errors.As(err, &self)
+}
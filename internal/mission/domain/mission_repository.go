@@ -0,0 +1,13 @@
+package missiondomain
+
+import (
+	"context"
+)
+
+//go:generate moq -pkg missiondomainmock -out mock/mission_repository_moq.go . MissionRepository
+type MissionRepository interface {
+	Find(ctx context.Context, code MissionCode) (*Mission, error)
+	// All returns every mission in the catalog sorted by code.
+	All(ctx context.Context) ([]*Mission, error)
+	Save(ctx context.Context, m *Mission) error
+}
@@ -0,0 +1,39 @@
+package missiondomain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type MissionRetirer struct {
+	repository MissionRepository
+}
+
+func NewMissionRetirer(repository MissionRepository) *MissionRetirer {
+	return &MissionRetirer{
+		repository: repository,
+	}
+}
+
+func (r *MissionRetirer) Retire(ctx context.Context, missionCode string, at time.Time) (*Mission, error) {
+	code, err := NewMissionCode(missionCode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mission code: %w", err)
+	}
+
+	mission, err := r.repository.Find(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find mission: %w", err)
+	}
+
+	if retireErr := mission.Retire(at); retireErr != nil {
+		return nil, retireErr
+	}
+
+	if saveErr := r.repository.Save(ctx, mission); saveErr != nil {
+		return nil, fmt.Errorf("failed to save mission: %w", saveErr)
+	}
+
+	return mission, nil
+}
@@ -0,0 +1,17 @@
+package missiondomain
+
+import (
+	"context"
+	"time"
+)
+
+// MissionRocketCounter keeps how many rockets still flying are assigned to every mission, known to the catalog or not.
+//
+//go:generate moq -pkg missiondomainmock -out mock/mission_rocket_counter_moq.go . MissionRocketCounter
+type MissionRocketCounter interface {
+	// Assign moves the rocket to the given mission, changes older than the last one seen for the rocket are ignored.
+	Assign(ctx context.Context, rocketID string, code MissionCode, at time.Time) error
+	// Unassign takes the rocket out of its mission for good, as it won't fly any longer.
+	Unassign(ctx context.Context, rocketID string, at time.Time) error
+	Count(ctx context.Context, code MissionCode) (int, error)
+}
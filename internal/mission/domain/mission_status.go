@@ -0,0 +1,12 @@
+package missiondomain
+
+type MissionStatus string
+
+const (
+	MissionStatusActive  MissionStatus = "active"
+	MissionStatusRetired MissionStatus = "retired"
+)
+
+func (s MissionStatus) String() string {
+	return string(s)
+}
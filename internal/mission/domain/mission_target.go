@@ -0,0 +1,31 @@
+package missiondomain
+
+import (
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	domainvalidation "github.com/soulcodex/rockets-message-processor/pkg/domain/validation"
+)
+
+var (
+	ErrInvalidMissionTargetProvided = domain.NewError("invalid mission target provided")
+)
+
+// MissionTarget is where the mission is headed to, e.g. Moon, Mars or Low Earth Orbit.
+type MissionTarget string
+
+func NewMissionTarget(target string) (MissionTarget, error) {
+	missionTarget := MissionTarget(target)
+
+	validation := domainvalidation.NewValidator(
+		domainvalidation.NotEmpty[string](),
+	)
+
+	if err := validation.Validate(target); err != nil {
+		return "", ErrInvalidMissionTargetProvided.Wrap(err)
+	}
+
+	return missionTarget, nil
+}
+
+func (t MissionTarget) String() string {
+	return string(t)
+}
@@ -0,0 +1,176 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package missiondomainmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	"sync"
+)
+
+// Ensure, that MissionRepositoryMock does implement missiondomain.MissionRepository.
+// If this is not the case, regenerate this file with moq.
+var _ missiondomain.MissionRepository = &MissionRepositoryMock{}
+
+// MissionRepositoryMock is a mock implementation of missiondomain.MissionRepository.
+//
+//	func TestSomethingThatUsesMissionRepository(t *testing.T) {
+//
+//		// make and configure a mocked missiondomain.MissionRepository
+//		mockedMissionRepository := &MissionRepositoryMock{
+//			AllFunc: func(ctx context.Context) ([]*missiondomain.Mission, error) {
+//				panic("mock out the All method")
+//			},
+//			FindFunc: func(ctx context.Context, code missiondomain.MissionCode) (*missiondomain.Mission, error) {
+//				panic("mock out the Find method")
+//			},
+//			SaveFunc: func(ctx context.Context, m *missiondomain.Mission) error {
+//				panic("mock out the Save method")
+//			},
+//		}
+//
+//		// use mockedMissionRepository in code that requires missiondomain.MissionRepository
+//		// and then make assertions.
+//
+//	}
+type MissionRepositoryMock struct {
+	// AllFunc mocks the All method.
+	AllFunc func(ctx context.Context) ([]*missiondomain.Mission, error)
+
+	// FindFunc mocks the Find method.
+	FindFunc func(ctx context.Context, code missiondomain.MissionCode) (*missiondomain.Mission, error)
+
+	// SaveFunc mocks the Save method.
+	SaveFunc func(ctx context.Context, m *missiondomain.Mission) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// All holds details about calls to the All method.
+		All []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Find holds details about calls to the Find method.
+		Find []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code missiondomain.MissionCode
+		}
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// M is the m argument value.
+			M *missiondomain.Mission
+		}
+	}
+	lockAll  sync.RWMutex
+	lockFind sync.RWMutex
+	lockSave sync.RWMutex
+}
+
+// All calls AllFunc.
+func (mock *MissionRepositoryMock) All(ctx context.Context) ([]*missiondomain.Mission, error) {
+	if mock.AllFunc == nil {
+		panic("MissionRepositoryMock.AllFunc: method is nil but MissionRepository.All was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockAll.Lock()
+	mock.calls.All = append(mock.calls.All, callInfo)
+	mock.lockAll.Unlock()
+	return mock.AllFunc(ctx)
+}
+
+// AllCalls gets all the calls that were made to All.
+// Check the length with:
+//
+//	len(mockedMissionRepository.AllCalls())
+func (mock *MissionRepositoryMock) AllCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockAll.RLock()
+	calls = mock.calls.All
+	mock.lockAll.RUnlock()
+	return calls
+}
+
+// Find calls FindFunc.
+func (mock *MissionRepositoryMock) Find(ctx context.Context, code missiondomain.MissionCode) (*missiondomain.Mission, error) {
+	if mock.FindFunc == nil {
+		panic("MissionRepositoryMock.FindFunc: method is nil but MissionRepository.Find was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Code missiondomain.MissionCode
+	}{
+		Ctx:  ctx,
+		Code: code,
+	}
+	mock.lockFind.Lock()
+	mock.calls.Find = append(mock.calls.Find, callInfo)
+	mock.lockFind.Unlock()
+	return mock.FindFunc(ctx, code)
+}
+
+// FindCalls gets all the calls that were made to Find.
+// Check the length with:
+//
+//	len(mockedMissionRepository.FindCalls())
+func (mock *MissionRepositoryMock) FindCalls() []struct {
+	Ctx  context.Context
+	Code missiondomain.MissionCode
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Code missiondomain.MissionCode
+	}
+	mock.lockFind.RLock()
+	calls = mock.calls.Find
+	mock.lockFind.RUnlock()
+	return calls
+}
+
+// Save calls SaveFunc.
+func (mock *MissionRepositoryMock) Save(ctx context.Context, m *missiondomain.Mission) error {
+	if mock.SaveFunc == nil {
+		panic("MissionRepositoryMock.SaveFunc: method is nil but MissionRepository.Save was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		M   *missiondomain.Mission
+	}{
+		Ctx: ctx,
+		M:   m,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	return mock.SaveFunc(ctx, m)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//
+//	len(mockedMissionRepository.SaveCalls())
+func (mock *MissionRepositoryMock) SaveCalls() []struct {
+	Ctx context.Context
+	M   *missiondomain.Mission
+} {
+	var calls []struct {
+		Ctx context.Context
+		M   *missiondomain.Mission
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
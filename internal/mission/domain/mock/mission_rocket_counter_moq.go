@@ -0,0 +1,201 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package missiondomainmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	"sync"
+	"time"
+)
+
+// Ensure, that MissionRocketCounterMock does implement missiondomain.MissionRocketCounter.
+// If this is not the case, regenerate this file with moq.
+var _ missiondomain.MissionRocketCounter = &MissionRocketCounterMock{}
+
+// MissionRocketCounterMock is a mock implementation of missiondomain.MissionRocketCounter.
+//
+//	func TestSomethingThatUsesMissionRocketCounter(t *testing.T) {
+//
+//		// make and configure a mocked missiondomain.MissionRocketCounter
+//		mockedMissionRocketCounter := &MissionRocketCounterMock{
+//			AssignFunc: func(ctx context.Context, rocketID string, code missiondomain.MissionCode, at time.Time) error {
+//				panic("mock out the Assign method")
+//			},
+//			CountFunc: func(ctx context.Context, code missiondomain.MissionCode) (int, error) {
+//				panic("mock out the Count method")
+//			},
+//			UnassignFunc: func(ctx context.Context, rocketID string, at time.Time) error {
+//				panic("mock out the Unassign method")
+//			},
+//		}
+//
+//		// use mockedMissionRocketCounter in code that requires missiondomain.MissionRocketCounter
+//		// and then make assertions.
+//
+//	}
+type MissionRocketCounterMock struct {
+	// AssignFunc mocks the Assign method.
+	AssignFunc func(ctx context.Context, rocketID string, code missiondomain.MissionCode, at time.Time) error
+
+	// CountFunc mocks the Count method.
+	CountFunc func(ctx context.Context, code missiondomain.MissionCode) (int, error)
+
+	// UnassignFunc mocks the Unassign method.
+	UnassignFunc func(ctx context.Context, rocketID string, at time.Time) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Assign holds details about calls to the Assign method.
+		Assign []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RocketID is the rocketID argument value.
+			RocketID string
+			// Code is the code argument value.
+			Code missiondomain.MissionCode
+			// At is the at argument value.
+			At time.Time
+		}
+		// Count holds details about calls to the Count method.
+		Count []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code missiondomain.MissionCode
+		}
+		// Unassign holds details about calls to the Unassign method.
+		Unassign []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RocketID is the rocketID argument value.
+			RocketID string
+			// At is the at argument value.
+			At time.Time
+		}
+	}
+	lockAssign   sync.RWMutex
+	lockCount    sync.RWMutex
+	lockUnassign sync.RWMutex
+}
+
+// Assign calls AssignFunc.
+func (mock *MissionRocketCounterMock) Assign(ctx context.Context, rocketID string, code missiondomain.MissionCode, at time.Time) error {
+	if mock.AssignFunc == nil {
+		panic("MissionRocketCounterMock.AssignFunc: method is nil but MissionRocketCounter.Assign was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RocketID string
+		Code     missiondomain.MissionCode
+		At       time.Time
+	}{
+		Ctx:      ctx,
+		RocketID: rocketID,
+		Code:     code,
+		At:       at,
+	}
+	mock.lockAssign.Lock()
+	mock.calls.Assign = append(mock.calls.Assign, callInfo)
+	mock.lockAssign.Unlock()
+	return mock.AssignFunc(ctx, rocketID, code, at)
+}
+
+// AssignCalls gets all the calls that were made to Assign.
+// Check the length with:
+//
+//	len(mockedMissionRocketCounter.AssignCalls())
+func (mock *MissionRocketCounterMock) AssignCalls() []struct {
+	Ctx      context.Context
+	RocketID string
+	Code     missiondomain.MissionCode
+	At       time.Time
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RocketID string
+		Code     missiondomain.MissionCode
+		At       time.Time
+	}
+	mock.lockAssign.RLock()
+	calls = mock.calls.Assign
+	mock.lockAssign.RUnlock()
+	return calls
+}
+
+// Count calls CountFunc.
+func (mock *MissionRocketCounterMock) Count(ctx context.Context, code missiondomain.MissionCode) (int, error) {
+	if mock.CountFunc == nil {
+		panic("MissionRocketCounterMock.CountFunc: method is nil but MissionRocketCounter.Count was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Code missiondomain.MissionCode
+	}{
+		Ctx:  ctx,
+		Code: code,
+	}
+	mock.lockCount.Lock()
+	mock.calls.Count = append(mock.calls.Count, callInfo)
+	mock.lockCount.Unlock()
+	return mock.CountFunc(ctx, code)
+}
+
+// CountCalls gets all the calls that were made to Count.
+// Check the length with:
+//
+//	len(mockedMissionRocketCounter.CountCalls())
+func (mock *MissionRocketCounterMock) CountCalls() []struct {
+	Ctx  context.Context
+	Code missiondomain.MissionCode
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Code missiondomain.MissionCode
+	}
+	mock.lockCount.RLock()
+	calls = mock.calls.Count
+	mock.lockCount.RUnlock()
+	return calls
+}
+
+// Unassign calls UnassignFunc.
+func (mock *MissionRocketCounterMock) Unassign(ctx context.Context, rocketID string, at time.Time) error {
+	if mock.UnassignFunc == nil {
+		panic("MissionRocketCounterMock.UnassignFunc: method is nil but MissionRocketCounter.Unassign was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RocketID string
+		At       time.Time
+	}{
+		Ctx:      ctx,
+		RocketID: rocketID,
+		At:       at,
+	}
+	mock.lockUnassign.Lock()
+	mock.calls.Unassign = append(mock.calls.Unassign, callInfo)
+	mock.lockUnassign.Unlock()
+	return mock.UnassignFunc(ctx, rocketID, at)
+}
+
+// UnassignCalls gets all the calls that were made to Unassign.
+// Check the length with:
+//
+//	len(mockedMissionRocketCounter.UnassignCalls())
+func (mock *MissionRocketCounterMock) UnassignCalls() []struct {
+	Ctx      context.Context
+	RocketID string
+	At       time.Time
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RocketID string
+		At       time.Time
+	}
+	mock.lockUnassign.RLock()
+	calls = mock.calls.Unassign
+	mock.lockUnassign.RUnlock()
+	return calls
+}
@@ -0,0 +1,37 @@
+package missiondomain
+
+import (
+	"time"
+)
+
+type MissionPrimitives struct {
+	Code      string
+	Name      string
+	Target    string
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func primitivesFromDomain(m *Mission) MissionPrimitives {
+	return MissionPrimitives{
+		Code:      m.code.String(),
+		Name:      m.name.String(),
+		Target:    m.target.String(),
+		Status:    m.status.String(),
+		CreatedAt: m.createdAt,
+		UpdatedAt: m.updatedAt,
+	}
+}
+
+// MissionFromPrimitives rebuilds a mission aggregate from its persisted primitives.
+func MissionFromPrimitives(p MissionPrimitives) *Mission {
+	return &Mission{
+		code:      MissionCode(p.Code),
+		name:      MissionName(p.Name),
+		target:    MissionTarget(p.Target),
+		status:    MissionStatus(p.Status),
+		createdAt: p.CreatedAt,
+		updatedAt: p.UpdatedAt,
+	}
+}
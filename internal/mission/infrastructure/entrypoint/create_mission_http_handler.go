@@ -0,0 +1,48 @@
+package missionentrypoint
+
+import (
+	"encoding/json"
+	"net/http"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+type createMissionRequestV1 struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Target string `json:"target"`
+}
+
+func HandleCreateMissionV1HTTP(
+	creator *missiondomain.MissionCreator,
+	timeProvider utils.DateTimeProvider,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request createMissionRequestV1
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"failed to parse request body"}, http.StatusBadRequest)
+			return
+		}
+
+		_, err := creator.Create(r.Context(), missiondomain.MissionCreateParams{
+			Code:   request.Code,
+			Name:   request.Name,
+			Target: request.Target,
+			At:     timeProvider.Now(),
+		})
+
+		switch {
+		case err == nil:
+			responseWriter.WriteResponse(r.Context(), w, nil, http.StatusCreated)
+		case missiondomain.IsInvalidMissionError(err):
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusBadRequest)
+		case missiondomain.IsMissionAlreadyExistsError(err):
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"mission already exists"}, http.StatusConflict)
+		default:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package missionentrypoint
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	missionqueries "github.com/soulcodex/rockets-message-processor/internal/mission/application/queries"
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+func HandleFindMissionV1HTTP(
+	queryBus querybus.Bus,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		findQuery := &missionqueries.FindMissionQuery{Code: mux.Vars(r)["mission_code"]}
+
+		resp, err := bus.DispatchWithResponse[*missionqueries.FindMissionQuery, missionqueries.MissionResponse](
+			queryBus,
+		)(r.Context(), findQuery)
+
+		switch {
+		case err == nil:
+			responseWriter.WriteResponse(r.Context(), w, newMissionResponseV1(resp), http.StatusOK)
+		case missiondomain.IsMissionNotFoundError(err):
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"mission not found"}, http.StatusNotFound)
+		default:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+		}
+	}
+}
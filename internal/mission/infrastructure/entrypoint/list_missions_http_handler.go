@@ -0,0 +1,27 @@
+package missionentrypoint
+
+import (
+	"net/http"
+
+	missionqueries "github.com/soulcodex/rockets-message-processor/internal/mission/application/queries"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+func HandleListMissionsV1HTTP(
+	queryBus querybus.Bus,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := bus.DispatchWithResponse[*missionqueries.ListMissionsQuery, missionqueries.MissionsResponse](
+			queryBus,
+		)(r.Context(), &missionqueries.ListMissionsQuery{})
+		if err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+			return
+		}
+
+		responseWriter.WriteResponse(r.Context(), w, newMissionsResponseV1(resp), http.StatusOK)
+	}
+}
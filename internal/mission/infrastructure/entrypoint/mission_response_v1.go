@@ -0,0 +1,32 @@
+package missionentrypoint
+
+import (
+	"time"
+
+	missionqueries "github.com/soulcodex/rockets-message-processor/internal/mission/application/queries"
+)
+
+type MissionsResponseV1 []MissionResponseV1
+
+func newMissionsResponseV1(missions missionqueries.MissionsResponse) MissionsResponseV1 {
+	response := make(MissionsResponseV1, len(missions))
+	for i, mission := range missions {
+		response[i] = newMissionResponseV1(mission)
+	}
+
+	return response
+}
+
+type MissionResponseV1 struct {
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Target      string    `json:"target"`
+	Status      string    `json:"status"`
+	RocketCount int       `json:"rocket_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newMissionResponseV1(mission missionqueries.MissionResponse) MissionResponseV1 {
+	return MissionResponseV1(mission)
+}
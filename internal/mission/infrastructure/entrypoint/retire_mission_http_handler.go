@@ -0,0 +1,32 @@
+package missionentrypoint
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+func HandleRetireMissionV1HTTP(
+	retirer *missiondomain.MissionRetirer,
+	timeProvider utils.DateTimeProvider,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, err := retirer.Retire(r.Context(), mux.Vars(r)["mission_code"], timeProvider.Now())
+
+		switch {
+		case err == nil:
+			responseWriter.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+		case missiondomain.IsMissionNotFoundError(err):
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"mission not found"}, http.StatusNotFound)
+		case missiondomain.IsMissionAlreadyRetiredError(err):
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"mission already retired"}, http.StatusConflict)
+		default:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+		}
+	}
+}
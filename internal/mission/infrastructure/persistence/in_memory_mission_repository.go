@@ -0,0 +1,64 @@
+package missionpersistence
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+)
+
+type InMemoryMissionRepository struct {
+	mutex    sync.RWMutex
+	missions map[missiondomain.MissionCode]missiondomain.MissionPrimitives
+}
+
+func NewInMemoryMissionRepository() *InMemoryMissionRepository {
+	return &InMemoryMissionRepository{
+		mutex:    sync.RWMutex{},
+		missions: make(map[missiondomain.MissionCode]missiondomain.MissionPrimitives),
+	}
+}
+
+func (r *InMemoryMissionRepository) Find(_ context.Context, code missiondomain.MissionCode) (*missiondomain.Mission, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	mission, exists := r.missions[code]
+	if !exists {
+		return nil, missiondomain.NewMissionNotFoundError(code)
+	}
+
+	return missiondomain.MissionFromPrimitives(mission), nil
+}
+
+func (r *InMemoryMissionRepository) All(_ context.Context) ([]*missiondomain.Mission, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	primitives := slices.SortedFunc(func(yield func(missiondomain.MissionPrimitives) bool) {
+		for _, mission := range r.missions {
+			if !yield(mission) {
+				return
+			}
+		}
+	}, func(left, right missiondomain.MissionPrimitives) int {
+		return strings.Compare(left.Code, right.Code)
+	})
+
+	missions := make([]*missiondomain.Mission, len(primitives))
+	for i, mission := range primitives {
+		missions[i] = missiondomain.MissionFromPrimitives(mission)
+	}
+
+	return missions, nil
+}
+
+func (r *InMemoryMissionRepository) Save(_ context.Context, mission *missiondomain.Mission) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.missions[mission.Code()] = mission.Primitives()
+	return nil
+}
@@ -0,0 +1,90 @@
+package missionpersistence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+)
+
+// rocketAssignment is the last change seen for a rocket, an unassigned rocket has no mission.
+type rocketAssignment struct {
+	mission    missiondomain.MissionCode
+	unassigned bool
+	at         time.Time
+}
+
+// InMemoryMissionRocketCounter remembers the last change seen for every rocket, so a rocket
+// moving between missions is only ever counted once.
+type InMemoryMissionRocketCounter struct {
+	mutex       sync.RWMutex
+	assignments map[string]rocketAssignment
+	counts      map[missiondomain.MissionCode]int
+}
+
+func NewInMemoryMissionRocketCounter() *InMemoryMissionRocketCounter {
+	return &InMemoryMissionRocketCounter{
+		mutex:       sync.RWMutex{},
+		assignments: make(map[string]rocketAssignment),
+		counts:      make(map[missiondomain.MissionCode]int),
+	}
+}
+
+func (c *InMemoryMissionRocketCounter) Assign(
+	_ context.Context,
+	rocketID string,
+	code missiondomain.MissionCode,
+	at time.Time,
+) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.leave(rocketID, at) {
+		return nil
+	}
+
+	c.assignments[rocketID] = rocketAssignment{mission: code, unassigned: false, at: at}
+	c.counts[code]++
+
+	return nil
+}
+
+func (c *InMemoryMissionRocketCounter) Unassign(_ context.Context, rocketID string, at time.Time) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.leave(rocketID, at) {
+		c.assignments[rocketID] = rocketAssignment{mission: "", unassigned: true, at: at}
+	}
+
+	return nil
+}
+
+func (c *InMemoryMissionRocketCounter) Count(_ context.Context, code missiondomain.MissionCode) (int, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.counts[code], nil
+}
+
+// leave takes the rocket out of its current mission unless the change is older than the last one seen.
+func (c *InMemoryMissionRocketCounter) leave(rocketID string, at time.Time) bool {
+	previous, exists := c.assignments[rocketID]
+	if !exists {
+		return true
+	}
+
+	if previous.at.After(at) {
+		return false
+	}
+
+	if !previous.unassigned {
+		c.counts[previous.mission]--
+		if c.counts[previous.mission] <= 0 {
+			delete(c.counts, previous.mission)
+		}
+	}
+
+	return true
+}
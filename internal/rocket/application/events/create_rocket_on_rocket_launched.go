@@ -8,28 +8,39 @@ import (
 )
 
 type CreateRocketOnRocketLaunched struct {
-	creator *rocketdomain.RocketCreator
+	creator      *rocketdomain.RocketCreator
+	missionGuard rocketdomain.MissionGuard
 }
 
-func NewCreateRocketOnRocketLaunched(creator *rocketdomain.RocketCreator) *CreateRocketOnRocketLaunched {
+func NewCreateRocketOnRocketLaunched(
+	creator *rocketdomain.RocketCreator,
+	missionGuard rocketdomain.MissionGuard,
+) *CreateRocketOnRocketLaunched {
 	return &CreateRocketOnRocketLaunched{
-		creator: creator,
+		creator:      creator,
+		missionGuard: missionGuard,
 	}
 }
 
 func (e *CreateRocketOnRocketLaunched) Handle(ctx context.Context, evt *RocketLaunched) (interface{}, error) {
+	if err := e.missionGuard.EnsureAssignable(ctx, rocketdomain.Mission(evt.Mission)); err != nil {
+		return nil, fmt.Errorf("rocket mission rejected: %w", err)
+	}
+
 	input := rocketdomain.RocketCreateParams{
 		ID:          evt.RocketID,
 		RocketType:  evt.RocketType,
 		LaunchSpeed: evt.LaunchSpeed,
 		Mission:     evt.Mission,
 		At:          evt.OccurredOn,
+
+		MessageNumber: evt.MessageNumber,
 	}
 
-	_, err := e.creator.Create(ctx, input)
+	launch, err := e.creator.Create(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("error creating rocket: %w", err)
 	}
 
-	return struct{}{}, nil
+	return newRocketEventReceipt(evt.EventID, launch.Rocket, launch.Applied), nil
 }
@@ -18,10 +18,15 @@ func NewDeleteRocketOnRocketExploded(updater *rocketdomain.RocketUpdater) *Delet
 }
 
 func (e *DeleteRocketOnRocketExploded) Handle(ctx context.Context, evt *RocketExploded) (interface{}, error) {
-	_, err := e.updater.Update(ctx, evt.RocketID, rocketdomain.WithSoftDeletion(evt.OccurredOn))
+	update, err := e.updater.Update(
+		ctx,
+		evt.RocketID,
+		rocketdomain.WithMessage(evt.MessageNumber),
+		rocketdomain.WithSoftDeletion(evt.OccurredOn),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error updating rocket: %w", err)
 	}
 
-	return struct{}{}, nil
+	return newRocketEventReceipt(evt.EventID, update.Rocket, update.Applied), nil
 }
@@ -8,16 +8,18 @@ var (
 	ErrRocketEventBuilderNotFound = errutil.NewError("rocket event builder not found")
 )
 
-var rocketEventBuilders = map[string]RocketEvent{
-	RocketExplodedType:       new(RocketExploded),
-	RocketLaunchedType:       new(RocketLaunched),
-	RocketMissionChangedType: new(RocketMissionChanged),
-	RocketSpeedIncreasedType: new(RocketSpeedIncreased),
-	RocketSpeedDecreasedType: new(RocketSpeedDecreased),
+// rocketEventBuilders build a fresh event for every message, as the events outlive the request they're parsed in.
+var rocketEventBuilders = map[string]func() RocketEvent{
+	RocketExplodedType:       func() RocketEvent { return new(RocketExploded) },
+	RocketLaunchedType:       func() RocketEvent { return new(RocketLaunched) },
+	RocketMissionChangedType: func() RocketEvent { return new(RocketMissionChanged) },
+	RocketSpeedIncreasedType: func() RocketEvent { return new(RocketSpeedIncreased) },
+	RocketSpeedDecreasedType: func() RocketEvent { return new(RocketSpeedDecreased) },
 }
 
 func ResolveRocketEvent(eventType string, raw *RocketEventRaw) (RocketEvent, error) {
-	if rocketEvent, ok := rocketEventBuilders[eventType]; ok && raw != nil {
+	if build, ok := rocketEventBuilders[eventType]; ok && raw != nil {
+		rocketEvent := build()
 		if parseErr := rocketEvent.FromRawEvent(raw); parseErr != nil {
 			return nil, errutil.NewError("failed to parse rocket event").Wrap(parseErr)
 		}
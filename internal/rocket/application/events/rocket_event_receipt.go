@@ -0,0 +1,105 @@
+package rocketevents
+
+import (
+	"time"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+// RocketEventOutcome tells what processing a rocket event did to the rocket.
+type RocketEventOutcome string
+
+const (
+	// RocketEventApplied means the event changed the rocket.
+	RocketEventApplied RocketEventOutcome = "applied"
+	// RocketEventStaleIgnored means the rocket had already applied a newer change, so the event was left out.
+	RocketEventStaleIgnored RocketEventOutcome = "stale_ignored"
+	// RocketEventDuplicate means the event had already been processed.
+	RocketEventDuplicate RocketEventOutcome = "duplicate"
+	// RocketEventRocketExploded means the rocket had exploded, so it can't change anymore.
+	RocketEventRocketExploded RocketEventOutcome = "rocket_exploded"
+	// RocketEventFailed means the event could not be processed, it is only found in the message ledger.
+	RocketEventFailed RocketEventOutcome = "failed"
+)
+
+// RocketEventReceipt is the result of processing a rocket event, along with the resulting rocket version and status.
+type RocketEventReceipt struct {
+	EventID       string
+	Outcome       RocketEventOutcome
+	RocketID      string
+	RocketVersion uint64
+	RocketStatus  string
+}
+
+func newRocketEventReceipt(eventID string, rocket *rocketdomain.Rocket, applied bool) RocketEventReceipt {
+	outcome := RocketEventApplied
+	if !applied {
+		outcome = RocketEventStaleIgnored
+	}
+
+	return RocketEventReceipt{
+		EventID:       eventID,
+		Outcome:       outcome,
+		RocketID:      rocket.ID().String(),
+		RocketVersion: rocket.Version(),
+		RocketStatus:  rocket.Status().String(),
+	}
+}
+
+// NewDuplicateRocketEventReceipt builds the receipt of an event already processed, it carries no rocket version
+// nor status as the rocket is left untouched.
+func NewDuplicateRocketEventReceipt(eventID, rocketID string) RocketEventReceipt {
+	return RocketEventReceipt{
+		EventID:       eventID,
+		Outcome:       RocketEventDuplicate,
+		RocketID:      rocketID,
+		RocketVersion: 0,
+		RocketStatus:  "",
+	}
+}
+
+// NewRocketEventReceiptFromError builds the receipt of an event rejected for targeting a rocket that can't change
+// anymore, false when the error is any other failure.
+func NewRocketEventReceiptFromError(eventID, rocketID string, err error) (RocketEventReceipt, bool) {
+	transitionErr, rejected := rocketdomain.AsRocketTransitionNotAllowedError(err)
+	if !rejected || !transitionErr.From().IsTerminal() {
+		return RocketEventReceipt{}, false
+	}
+
+	return RocketEventReceipt{
+		EventID:       eventID,
+		Outcome:       RocketEventRocketExploded,
+		RocketID:      rocketID,
+		RocketVersion: transitionErr.Version(),
+		RocketStatus:  transitionErr.From().String(),
+	}, true
+}
+
+// NewRocketMessageRecord builds the ledger record of a received rocket event out of its receipt, or out of the
+// error that prevented processing it.
+func NewRocketMessageRecord(
+	raw *RocketEventRaw,
+	receivedAt time.Time,
+	receipt RocketEventReceipt,
+	err error,
+) rocketdomain.RocketMessageRecord {
+	record := rocketdomain.RocketMessageRecord{
+		EventID:    raw.EventID(),
+		RocketID:   raw.Metadata.Channel,
+		ReceivedAt: receivedAt,
+		Outcome:    string(receipt.Outcome),
+		Error:      "",
+	}
+
+	if err == nil {
+		return record
+	}
+
+	record.Error = err.Error()
+	record.Outcome = string(RocketEventFailed)
+	if rejected, match := NewRocketEventReceiptFromError(record.EventID, record.RocketID, err); match {
+		record.Outcome = string(rejected.Outcome)
+	}
+
+	return record
+}
@@ -11,16 +11,25 @@ const (
 )
 
 type RocketExploded struct {
-	EventID    string
-	RocketID   string
-	Reason     string
-	OccurredOn time.Time
+	EventID       string
+	RocketID      string
+	Reason        string
+	MessageNumber uint64
+	OccurredOn    time.Time
 }
 
 func (e *RocketExploded) Identifier() string {
 	return e.EventID
 }
 
+func (e *RocketExploded) Channel() string {
+	return e.RocketID
+}
+
+func (e *RocketExploded) SequenceNumber() uint64 {
+	return e.MessageNumber
+}
+
 func (e *RocketExploded) BlockingKey() string {
 	return "rocket" + ":" + e.RocketID
 }
@@ -43,6 +52,7 @@ func (e *RocketExploded) FromRawEvent(rm *RocketEventRaw) error {
 	e.EventID = rm.EventID()
 	e.RocketID = rm.Metadata.Channel
 	e.Reason = content.Reason
+	e.MessageNumber = rm.Metadata.MessageNumber
 	e.OccurredOn = rm.Metadata.MessageTime
 
 	return nil
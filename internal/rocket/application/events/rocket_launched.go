@@ -11,18 +11,27 @@ const (
 )
 
 type RocketLaunched struct {
-	EventID     string
-	RocketID    string
-	RocketType  string
-	LaunchSpeed int64
-	Mission     string
-	OccurredOn  time.Time
+	EventID       string
+	RocketID      string
+	RocketType    string
+	LaunchSpeed   int64
+	Mission       string
+	MessageNumber uint64
+	OccurredOn    time.Time
 }
 
 func (e *RocketLaunched) Identifier() string {
 	return e.EventID
 }
 
+func (e *RocketLaunched) Channel() string {
+	return e.RocketID
+}
+
+func (e *RocketLaunched) SequenceNumber() uint64 {
+	return e.MessageNumber
+}
+
 func (e *RocketLaunched) BlockingKey() string {
 	return "rocket" + ":" + e.RocketID
 }
@@ -49,6 +58,7 @@ func (e *RocketLaunched) FromRawEvent(rm *RocketEventRaw) error {
 	e.RocketType = content.RocketType
 	e.LaunchSpeed = content.LaunchSpeed
 	e.Mission = content.Mission
+	e.MessageNumber = rm.Metadata.MessageNumber
 	e.OccurredOn = rm.Metadata.MessageTime
 
 	return nil
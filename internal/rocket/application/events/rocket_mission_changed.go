@@ -11,16 +11,25 @@ const (
 )
 
 type RocketMissionChanged struct {
-	EventID    string
-	RocketID   string
-	NewMission string
-	OccurredOn time.Time
+	EventID       string
+	RocketID      string
+	NewMission    string
+	MessageNumber uint64
+	OccurredOn    time.Time
 }
 
 func (e *RocketMissionChanged) Identifier() string {
 	return e.EventID
 }
 
+func (e *RocketMissionChanged) Channel() string {
+	return e.RocketID
+}
+
+func (e *RocketMissionChanged) SequenceNumber() uint64 {
+	return e.MessageNumber
+}
+
 func (e *RocketMissionChanged) BlockingKey() string {
 	return "rocket" + ":" + e.RocketID
 }
@@ -43,6 +52,7 @@ func (e *RocketMissionChanged) FromRawEvent(rm *RocketEventRaw) error {
 	e.EventID = rm.EventID()
 	e.RocketID = rm.Metadata.Channel
 	e.NewMission = content.NewMission
+	e.MessageNumber = rm.Metadata.MessageNumber
 	e.OccurredOn = rm.Metadata.MessageTime
 
 	return nil
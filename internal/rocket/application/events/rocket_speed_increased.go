@@ -11,16 +11,25 @@ const (
 )
 
 type RocketSpeedIncreased struct {
-	EventID    string
-	RocketID   string
-	Amount     float64
-	OccurredOn time.Time
+	EventID       string
+	RocketID      string
+	Amount        float64
+	MessageNumber uint64
+	OccurredOn    time.Time
 }
 
 func (e *RocketSpeedIncreased) Identifier() string {
 	return e.EventID
 }
 
+func (e *RocketSpeedIncreased) Channel() string {
+	return e.RocketID
+}
+
+func (e *RocketSpeedIncreased) SequenceNumber() uint64 {
+	return e.MessageNumber
+}
+
 func (e *RocketSpeedIncreased) BlockingKey() string {
 	return "rocket" + ":" + e.RocketID
 }
@@ -43,6 +52,7 @@ func (e *RocketSpeedIncreased) FromRawEvent(rm *RocketEventRaw) error {
 	e.EventID = rm.EventID()
 	e.RocketID = rm.Metadata.Channel
 	e.Amount = content.Amount
+	e.MessageNumber = rm.Metadata.MessageNumber
 	e.OccurredOn = rm.Metadata.MessageTime
 
 	return nil
@@ -3,17 +3,30 @@ package rocketevents
 import (
 	"context"
 	"fmt"
+	"time"
 
 	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
 )
 
 type UpdateRocketOnRocketParamsChanged struct {
-	updater *rocketdomain.RocketUpdater
+	updater      *rocketdomain.RocketUpdater
+	speedHistory rocketdomain.RocketSpeedHistory
+	missionGuard rocketdomain.MissionGuard
+	logger       logger.ZerologLogger
 }
 
-func NewUpdateRocketOnRocketParamsChanged(updater *rocketdomain.RocketUpdater) *UpdateRocketOnRocketParamsChanged {
+func NewUpdateRocketOnRocketParamsChanged(
+	updater *rocketdomain.RocketUpdater,
+	speedHistory rocketdomain.RocketSpeedHistory,
+	missionGuard rocketdomain.MissionGuard,
+	logger logger.ZerologLogger,
+) *UpdateRocketOnRocketParamsChanged {
 	return &UpdateRocketOnRocketParamsChanged{
-		updater: updater,
+		updater:      updater,
+		speedHistory: speedHistory,
+		missionGuard: missionGuard,
+		logger:       logger,
 	}
 }
 
@@ -31,28 +44,72 @@ func (e *UpdateRocketOnRocketParamsChanged) Handle(ctx context.Context, evt Rock
 }
 
 func (e *UpdateRocketOnRocketParamsChanged) handleSpeedIncreased(ctx context.Context, evt *RocketSpeedIncreased) (interface{}, error) {
-	_, err := e.updater.Update(ctx, evt.RocketID, rocketdomain.WithLaunchSpeedDelta(int64(evt.Amount), evt.OccurredOn))
-	if err != nil {
-		return nil, fmt.Errorf("error updating rocket: %w", err)
-	}
-
-	return struct{}{}, nil
+	return e.changeSpeed(ctx, evt.EventID, evt.RocketID, int64(evt.Amount), evt.OccurredOn, evt.MessageNumber)
 }
 
 func (e *UpdateRocketOnRocketParamsChanged) handleSpeedDecreased(ctx context.Context, evt *RocketSpeedDecreased) (interface{}, error) {
-	_, err := e.updater.Update(ctx, evt.RocketID, rocketdomain.WithLaunchSpeedDelta(int64(-evt.Amount), evt.OccurredOn))
+	return e.changeSpeed(ctx, evt.EventID, evt.RocketID, int64(-evt.Amount), evt.OccurredOn, evt.MessageNumber)
+}
+
+// changeSpeed applies the speed delta recording it in the speed history, out of order changes
+// ignored by the rocket are left out of the history as well. The rocket is already saved once the
+// speed is recorded, so failing to record it is only logged as failing the message would get the
+// delta applied again on retry.
+func (e *UpdateRocketOnRocketParamsChanged) changeSpeed(
+	ctx context.Context,
+	eventID string,
+	rocketID string,
+	delta int64,
+	at time.Time,
+	messageNumber uint64,
+) (interface{}, error) {
+	update, err := e.updater.Update(
+		ctx,
+		rocketID,
+		rocketdomain.WithMessage(messageNumber),
+		rocketdomain.WithLaunchSpeedDelta(delta, at),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error updating rocket: %w", err)
 	}
 
-	return struct{}{}, nil
+	receipt := newRocketEventReceipt(eventID, update.Rocket, update.Applied)
+	if !update.Applied {
+		return receipt, nil
+	}
+
+	point := rocketdomain.RocketSpeedPoint{
+		At:            at,
+		Value:         update.Rocket.Primitives().LaunchSpeed,
+		Delta:         delta,
+		MessageNumber: messageNumber,
+	}
+
+	if recordErr := e.speedHistory.Record(ctx, update.Rocket.ID(), point); recordErr != nil {
+		e.logger.Error().
+			Err(recordErr).
+			Str("rocket_id", rocketID).
+			Uint64("message_number", messageNumber).
+			Msg("failed to record rocket speed")
+	}
+
+	return receipt, nil
 }
 
 func (e *UpdateRocketOnRocketParamsChanged) handleMissionChanged(ctx context.Context, evt *RocketMissionChanged) (interface{}, error) {
-	_, err := e.updater.Update(ctx, evt.RocketID, rocketdomain.WithMission(evt.NewMission, evt.OccurredOn))
+	if err := e.missionGuard.EnsureAssignable(ctx, rocketdomain.Mission(evt.NewMission)); err != nil {
+		return nil, fmt.Errorf("rocket mission rejected: %w", err)
+	}
+
+	update, err := e.updater.Update(
+		ctx,
+		evt.RocketID,
+		rocketdomain.WithMessage(evt.MessageNumber),
+		rocketdomain.WithMission(evt.NewMission, evt.OccurredOn),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error updating rocket: %w", err)
 	}
 
-	return struct{}{}, nil
+	return newRocketEventReceipt(evt.EventID, update.Rocket, update.Applied), nil
 }
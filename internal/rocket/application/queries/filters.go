@@ -0,0 +1,32 @@
+package rocketqueries
+
+import (
+	"time"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+// RocketFilters gathers the rocket filters shared by the queries going through the fleet.
+type RocketFilters struct {
+	RocketType     string
+	Mission        string
+	MissionPrefix  string
+	LaunchSpeedMin *int64
+	LaunchSpeedMax *int64
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+	UpdatedAfter   *time.Time
+	Filter         string
+}
+
+func (f RocketFilters) criteriaOpts() []rocketdomain.RocketSearchCriteriaOpt {
+	return []rocketdomain.RocketSearchCriteriaOpt{
+		rocketdomain.FilterByRocketType(f.RocketType),
+		rocketdomain.FilterByMission(f.Mission),
+		rocketdomain.FilterByMissionPrefix(f.MissionPrefix),
+		rocketdomain.FilterByLaunchSpeedRange(f.LaunchSpeedMin, f.LaunchSpeedMax),
+		rocketdomain.FilterByCreatedBetween(f.CreatedAfter, f.CreatedBefore),
+		rocketdomain.FilterByUpdatedAfter(f.UpdatedAfter),
+		rocketdomain.FilterByExpression(f.Filter),
+	}
+}
@@ -0,0 +1,54 @@
+package rocketqueries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+// FindMissionRocketsQuery lists the rockets flying a mission at the given time, now when no time is given.
+type FindMissionRocketsQuery struct {
+	Mission string
+	At      *time.Time
+}
+
+func (q *FindMissionRocketsQuery) Type() string {
+	return "find_mission_rockets_query"
+}
+
+type FindMissionRocketsQueryHandler struct {
+	repository   rocketdomain.RocketRepository
+	timeProvider utils.DateTimeProvider
+}
+
+func NewFindMissionRocketsQueryHandler(
+	repository rocketdomain.RocketRepository,
+	timeProvider utils.DateTimeProvider,
+) *FindMissionRocketsQueryHandler {
+	return &FindMissionRocketsQueryHandler{
+		repository:   repository,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *FindMissionRocketsQueryHandler) Handle(ctx context.Context, q *FindMissionRocketsQuery) (MissionRocketsResponse, error) {
+	mission, err := rocketdomain.NewMission(q.Mission)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mission provided: %w", err)
+	}
+
+	at := h.timeProvider.Now()
+	if q.At != nil {
+		at = *q.At
+	}
+
+	rockets, err := h.repository.FindByMissionAt(ctx, mission, at)
+	if err != nil {
+		return nil, fmt.Errorf("error while finding rockets by mission: %w", err)
+	}
+
+	return newMissionRocketsResponse(rockets, at), nil
+}
@@ -36,5 +36,9 @@ func (h *FindRocketByIDQueryHandler) Handle(ctx context.Context, q *FindRocketBy
 		return RocketResponse{}, fmt.Errorf("error while finding rocket by ID: %w", err)
 	}
 
+	if rocket.Status().IsTerminal() {
+		return RocketResponse{}, rocketdomain.NewRocketNotFoundError(rocketID)
+	}
+
 	return newRocketResponseFromPrimitives(rocket.Primitives()), nil
 }
@@ -0,0 +1,35 @@
+package rocketqueries
+
+import (
+	"context"
+	"fmt"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+type FindRocketMessageQuery struct {
+	EventID string
+}
+
+func (q *FindRocketMessageQuery) Type() string {
+	return "find_rocket_message_query"
+}
+
+type FindRocketMessageQueryHandler struct {
+	ledger rocketdomain.RocketMessageLedger
+}
+
+func NewFindRocketMessageQueryHandler(ledger rocketdomain.RocketMessageLedger) *FindRocketMessageQueryHandler {
+	return &FindRocketMessageQueryHandler{
+		ledger: ledger,
+	}
+}
+
+func (h *FindRocketMessageQueryHandler) Handle(ctx context.Context, q *FindRocketMessageQuery) (RocketMessageResponse, error) {
+	record, err := h.ledger.Find(ctx, q.EventID)
+	if err != nil {
+		return RocketMessageResponse{}, fmt.Errorf("error while finding rocket message: %w", err)
+	}
+
+	return RocketMessageResponse(record), nil
+}
@@ -0,0 +1,44 @@
+package rocketqueries
+
+import (
+	"context"
+	"fmt"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+type FindRocketMissionsQuery struct {
+	RocketID string
+}
+
+func (q *FindRocketMissionsQuery) Type() string {
+	return "find_rocket_missions_query"
+}
+
+type FindRocketMissionsQueryHandler struct {
+	repository rocketdomain.RocketRepository
+}
+
+func NewFindRocketMissionsQueryHandler(repository rocketdomain.RocketRepository) *FindRocketMissionsQueryHandler {
+	return &FindRocketMissionsQueryHandler{
+		repository: repository,
+	}
+}
+
+func (h *FindRocketMissionsQueryHandler) Handle(ctx context.Context, q *FindRocketMissionsQuery) (MissionAssignmentsResponse, error) {
+	rocketID, err := rocketdomain.NewRocketID(q.RocketID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rocket ID provided: %w", err)
+	}
+
+	rocket, err := h.repository.Find(ctx, rocketID)
+	if err != nil {
+		return nil, fmt.Errorf("error while finding rocket by ID: %w", err)
+	}
+
+	if rocket.Status().IsTerminal() {
+		return nil, rocketdomain.NewRocketNotFoundError(rocketID)
+	}
+
+	return newMissionAssignmentsResponse(rocket.MissionHistory()), nil
+}
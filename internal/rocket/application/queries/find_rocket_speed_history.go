@@ -0,0 +1,74 @@
+package rocketqueries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+const defaultRocketSpeedHistoryWindow = 24 * time.Hour
+
+// FindRocketSpeedHistoryQuery reads the speed history of a rocket within [From, To], raw points
+// and compacted buckets are returned as they are unless a Step is given to downsample them.
+type FindRocketSpeedHistoryQuery struct {
+	RocketID string
+	From     *time.Time
+	To       *time.Time
+	Step     *time.Duration
+}
+
+func (q *FindRocketSpeedHistoryQuery) Type() string {
+	return "find_rocket_speed_history_query"
+}
+
+type FindRocketSpeedHistoryQueryHandler struct {
+	history      rocketdomain.RocketSpeedHistory
+	timeProvider utils.DateTimeProvider
+}
+
+func NewFindRocketSpeedHistoryQueryHandler(
+	history rocketdomain.RocketSpeedHistory,
+	timeProvider utils.DateTimeProvider,
+) *FindRocketSpeedHistoryQueryHandler {
+	return &FindRocketSpeedHistoryQueryHandler{
+		history:      history,
+		timeProvider: timeProvider,
+	}
+}
+
+func (h *FindRocketSpeedHistoryQueryHandler) Handle(
+	ctx context.Context,
+	q *FindRocketSpeedHistoryQuery,
+) (RocketSpeedHistoryResponse, error) {
+	rocketID, err := rocketdomain.NewRocketID(q.RocketID)
+	if err != nil {
+		return RocketSpeedHistoryResponse{}, fmt.Errorf("invalid rocket ID provided: %w", err)
+	}
+
+	to := h.timeProvider.Now()
+	if q.To != nil {
+		to = *q.To
+	}
+
+	from := to.Add(-defaultRocketSpeedHistoryWindow)
+	if q.From != nil {
+		from = *q.From
+	}
+
+	timeline, err := h.history.Range(ctx, rocketID, from, to)
+	if err != nil {
+		return RocketSpeedHistoryResponse{}, fmt.Errorf("failed to read rocket speed history: %w", err)
+	}
+
+	if q.Step != nil {
+		return RocketSpeedHistoryResponse{
+			Points:  make([]RocketSpeedPointResponse, 0),
+			Buckets: newRocketSpeedBucketsResponse(timeline.Downsample(from, *q.Step)),
+		}, nil
+	}
+
+	return newRocketSpeedHistoryResponse(timeline), nil
+}
@@ -0,0 +1,43 @@
+package rocketqueries
+
+import (
+	"context"
+	"fmt"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+type ListRocketTypesQuery struct{}
+
+func (q *ListRocketTypesQuery) Type() string {
+	return "list_rocket_types_query"
+}
+
+type ListRocketTypesQueryHandler struct {
+	registry rocketdomain.RocketTypeRegistry
+}
+
+func NewListRocketTypesQueryHandler(registry rocketdomain.RocketTypeRegistry) *ListRocketTypesQueryHandler {
+	return &ListRocketTypesQueryHandler{
+		registry: registry,
+	}
+}
+
+func (h *ListRocketTypesQueryHandler) Handle(ctx context.Context, _ *ListRocketTypesQuery) (RocketTypesResponse, error) {
+	specs, err := h.registry.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing rocket types: %w", err)
+	}
+
+	response := make(RocketTypesResponse, len(specs))
+	for i, spec := range specs {
+		response[i] = RocketTypeResponse{
+			Name:     spec.Name.String(),
+			Aliases:  spec.Aliases,
+			MinSpeed: spec.Envelope.Min.Value(),
+			MaxSpeed: spec.Envelope.Max.Value(),
+		}
+	}
+
+	return response, nil
+}
@@ -2,6 +2,8 @@ package rocketqueries
 
 import (
 	"iter"
+	"maps"
+	"slices"
 	"time"
 
 	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
@@ -17,10 +19,31 @@ func newRocketsResponseFromPrimitives(rockets iter.Seq[rocketdomain.RocketPrimit
 	return response
 }
 
+type RocketsPageResponse struct {
+	Rockets    RocketsResponse
+	HasMore    bool
+	NextCursor string
+}
+
+func newRocketsPageResponse(page rocketdomain.RocketPage) RocketsPageResponse {
+	response := RocketsPageResponse{
+		Rockets: newRocketsResponseFromPrimitives(page.Rockets().Primitives()),
+		HasMore: page.HasMore(),
+	}
+
+	if cursor := page.NextCursor(); cursor != nil {
+		response.NextCursor = cursor.String()
+	}
+
+	return response
+}
+
 type RocketResponse struct {
 	ID          string
 	RocketType  string
+	UnknownType bool
 	LaunchSpeed int64
+	Status      string
 	Mission     string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
@@ -30,9 +53,187 @@ func newRocketResponseFromPrimitives(p rocketdomain.RocketPrimitives) RocketResp
 	return RocketResponse{
 		ID:          p.ID,
 		RocketType:  p.RocketType,
+		UnknownType: p.UnknownType,
 		LaunchSpeed: p.LaunchSpeed,
+		Status:      p.Status,
 		Mission:     p.Mission,
 		CreatedAt:   p.CreatedAt,
 		UpdatedAt:   p.UpdatedAt,
 	}
 }
+
+type RocketStatsReportResponse struct {
+	Overall RocketStatsResponse
+	GroupBy string
+	Groups  []RocketStatsGroupResponse
+}
+
+type RocketStatsGroupResponse struct {
+	Key   string
+	Stats RocketStatsResponse
+}
+
+type RocketStatsResponse struct {
+	Active       int
+	Exploded     int
+	ByRocketType map[string]int
+	ByMission    map[string]int
+	LaunchSpeed  LaunchSpeedStatsResponse
+}
+
+type LaunchSpeedStatsResponse struct {
+	Min int64
+	Max int64
+	Avg float64
+	P50 int64
+	P90 int64
+	P95 int64
+	P99 int64
+}
+
+func newRocketStatsReportResponse(
+	groupBy rocketdomain.RocketStatsGroupBy,
+	report rocketdomain.RocketStatsReport,
+) RocketStatsReportResponse {
+	response := RocketStatsReportResponse{
+		Overall: newRocketStatsResponse(report.Overall),
+		GroupBy: string(groupBy),
+		Groups:  make([]RocketStatsGroupResponse, 0, len(report.Groups)),
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(report.Groups)) {
+		response.Groups = append(response.Groups, RocketStatsGroupResponse{
+			Key:   key,
+			Stats: newRocketStatsResponse(report.Groups[key]),
+		})
+	}
+
+	return response
+}
+
+func newRocketStatsResponse(stats rocketdomain.RocketStats) RocketStatsResponse {
+	return RocketStatsResponse{
+		Active:       stats.Active,
+		Exploded:     stats.Exploded,
+		ByRocketType: stats.ByRocketType,
+		ByMission:    stats.ByMission,
+		LaunchSpeed:  LaunchSpeedStatsResponse(stats.LaunchSpeed),
+	}
+}
+
+type RocketSpeedHistoryResponse struct {
+	Points  []RocketSpeedPointResponse
+	Buckets []RocketSpeedBucketResponse
+}
+
+type RocketSpeedPointResponse struct {
+	At            time.Time
+	Value         int64
+	Delta         int64
+	MessageNumber uint64
+}
+
+type RocketSpeedBucketResponse struct {
+	From  time.Time
+	To    time.Time
+	Min   int64
+	Max   int64
+	Avg   float64
+	Count int
+}
+
+func newRocketSpeedHistoryResponse(timeline rocketdomain.RocketSpeedTimeline) RocketSpeedHistoryResponse {
+	points := make([]RocketSpeedPointResponse, len(timeline.Points))
+	for i, point := range timeline.Points {
+		points[i] = RocketSpeedPointResponse(point)
+	}
+
+	return RocketSpeedHistoryResponse{
+		Points:  points,
+		Buckets: newRocketSpeedBucketsResponse(timeline.Buckets),
+	}
+}
+
+func newRocketSpeedBucketsResponse(buckets []rocketdomain.RocketSpeedBucket) []RocketSpeedBucketResponse {
+	response := make([]RocketSpeedBucketResponse, len(buckets))
+	for i, bucket := range buckets {
+		response[i] = RocketSpeedBucketResponse{
+			From:  bucket.From,
+			To:    bucket.From.Add(bucket.Step),
+			Min:   bucket.Min,
+			Max:   bucket.Max,
+			Avg:   bucket.Avg(),
+			Count: bucket.Count,
+		}
+	}
+
+	return response
+}
+
+type MissionAssignmentsResponse []MissionAssignmentResponse
+
+type MissionAssignmentResponse struct {
+	Mission       string
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+}
+
+func newMissionAssignmentsResponse(history rocketdomain.MissionHistory) MissionAssignmentsResponse {
+	response := make(MissionAssignmentsResponse, len(history))
+	for i, assignment := range history {
+		response[i] = newMissionAssignmentResponse(assignment)
+	}
+
+	return response
+}
+
+func newMissionAssignmentResponse(assignment rocketdomain.MissionAssignment) MissionAssignmentResponse {
+	return MissionAssignmentResponse{
+		Mission:       assignment.Mission.String(),
+		EffectiveFrom: assignment.From,
+		EffectiveTo:   assignment.To,
+	}
+}
+
+type MissionRocketsResponse []MissionRocketResponse
+
+type MissionRocketResponse struct {
+	RocketID   string
+	RocketType string
+	Assignment MissionAssignmentResponse
+}
+
+func newMissionRocketsResponse(rockets rocketdomain.RocketCollection, at time.Time) MissionRocketsResponse {
+	response := make(MissionRocketsResponse, 0, len(rockets.All()))
+	for _, rocket := range rockets.All() {
+		assignment, found := rocket.MissionHistory().At(at)
+		if !found {
+			continue
+		}
+
+		response = append(response, MissionRocketResponse{
+			RocketID:   rocket.ID().String(),
+			RocketType: rocket.Primitives().RocketType,
+			Assignment: newMissionAssignmentResponse(assignment),
+		})
+	}
+
+	return response
+}
+
+type RocketTypesResponse []RocketTypeResponse
+
+type RocketTypeResponse struct {
+	Name     string
+	Aliases  []string
+	MinSpeed int64
+	MaxSpeed int64
+}
+
+type RocketMessageResponse struct {
+	EventID    string
+	RocketID   string
+	ReceivedAt time.Time
+	Outcome    string
+	Error      string
+}
@@ -0,0 +1,41 @@
+package rocketqueries
+
+import (
+	"context"
+	"fmt"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+type RocketStatsQuery struct {
+	GroupBy string
+	RocketFilters
+}
+
+func (q *RocketStatsQuery) Type() string {
+	return "rocket_stats_query"
+}
+
+type RocketStatsQueryHandler struct {
+	repository rocketdomain.RocketRepository
+}
+
+func NewRocketStatsQueryHandler(repository rocketdomain.RocketRepository) *RocketStatsQueryHandler {
+	return &RocketStatsQueryHandler{
+		repository: repository,
+	}
+}
+
+func (h *RocketStatsQueryHandler) Handle(ctx context.Context, q *RocketStatsQuery) (RocketStatsReportResponse, error) {
+	criteria, err := rocketdomain.NewRocketStatsCriteria(q.GroupBy, q.criteriaOpts()...)
+	if err != nil {
+		return RocketStatsReportResponse{}, fmt.Errorf("invalid stats criteria provided: %w", err)
+	}
+
+	report, err := h.repository.Stats(ctx, criteria)
+	if err != nil {
+		return RocketStatsReportResponse{}, fmt.Errorf("failed to compute rocket stats: %w", err)
+	}
+
+	return newRocketStatsReportResponse(criteria.GroupBy, report), nil
+}
@@ -8,8 +8,10 @@ import (
 )
 
 type SearchRocketsQuery struct {
-	Sort string
-	Asc  bool
+	Sort   string
+	Limit  int
+	Cursor string
+	RocketFilters
 }
 
 func (q *SearchRocketsQuery) Type() string {
@@ -26,11 +28,34 @@ func NewSearchRocketsQueryHandler(repository rocketdomain.RocketRepository) *Sea
 	}
 }
 
-func (h *SearchRocketsQueryHandler) Handle(ctx context.Context, q *SearchRocketsQuery) (RocketsResponse, error) {
-	rockets, err := h.repository.Search(ctx, q.Sort, q.Asc)
+func (h *SearchRocketsQueryHandler) Handle(ctx context.Context, q *SearchRocketsQuery) (RocketsPageResponse, error) {
+	sort, err := rocketdomain.ParseRocketSort(q.Sort)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search rockets: %w", err)
+		return RocketsPageResponse{}, fmt.Errorf("invalid sort provided: %w", err)
 	}
 
-	return newRocketsResponseFromPrimitives(rockets.Primitives()), nil
+	var after *rocketdomain.RocketCursor
+	if q.Cursor != "" {
+		cursor, cursorErr := rocketdomain.ParseRocketCursor(q.Cursor)
+		if cursorErr != nil {
+			return RocketsPageResponse{}, fmt.Errorf("invalid cursor provided: %w", cursorErr)
+		}
+		after = &cursor
+	}
+
+	criteria, err := rocketdomain.NewRocketSearchCriteria(append(
+		q.criteriaOpts(),
+		rocketdomain.SortedBy(sort),
+		rocketdomain.Paginated(q.Limit, after),
+	)...)
+	if err != nil {
+		return RocketsPageResponse{}, fmt.Errorf("invalid search criteria provided: %w", err)
+	}
+
+	page, err := h.repository.Search(ctx, criteria)
+	if err != nil {
+		return RocketsPageResponse{}, fmt.Errorf("failed to search rockets: %w", err)
+	}
+
+	return newRocketsPageResponse(page), nil
 }
@@ -0,0 +1,132 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package rocketdomainmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"sync"
+)
+
+// Ensure, that RocketMessageLedgerMock does implement rocketdomain.RocketMessageLedger.
+// If this is not the case, regenerate this file with moq.
+var _ rocketdomain.RocketMessageLedger = &RocketMessageLedgerMock{}
+
+// RocketMessageLedgerMock is a mock implementation of rocketdomain.RocketMessageLedger.
+//
+//	func TestSomethingThatUsesRocketMessageLedger(t *testing.T) {
+//
+//		// make and configure a mocked rocketdomain.RocketMessageLedger
+//		mockedRocketMessageLedger := &RocketMessageLedgerMock{
+//			FindFunc: func(ctx context.Context, eventID string) (rocketdomain.RocketMessageRecord, error) {
+//				panic("mock out the Find method")
+//			},
+//			RecordFunc: func(ctx context.Context, record rocketdomain.RocketMessageRecord) error {
+//				panic("mock out the Record method")
+//			},
+//		}
+//
+//		// use mockedRocketMessageLedger in code that requires rocketdomain.RocketMessageLedger
+//		// and then make assertions.
+//
+//	}
+type RocketMessageLedgerMock struct {
+	// FindFunc mocks the Find method.
+	FindFunc func(ctx context.Context, eventID string) (rocketdomain.RocketMessageRecord, error)
+
+	// RecordFunc mocks the Record method.
+	RecordFunc func(ctx context.Context, record rocketdomain.RocketMessageRecord) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Find holds details about calls to the Find method.
+		Find []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EventID is the eventID argument value.
+			EventID string
+		}
+		// Record holds details about calls to the Record method.
+		Record []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Record is the record argument value.
+			Record rocketdomain.RocketMessageRecord
+		}
+	}
+	lockFind   sync.RWMutex
+	lockRecord sync.RWMutex
+}
+
+// Find calls FindFunc.
+func (mock *RocketMessageLedgerMock) Find(ctx context.Context, eventID string) (rocketdomain.RocketMessageRecord, error) {
+	if mock.FindFunc == nil {
+		panic("RocketMessageLedgerMock.FindFunc: method is nil but RocketMessageLedger.Find was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EventID string
+	}{
+		Ctx:     ctx,
+		EventID: eventID,
+	}
+	mock.lockFind.Lock()
+	mock.calls.Find = append(mock.calls.Find, callInfo)
+	mock.lockFind.Unlock()
+	return mock.FindFunc(ctx, eventID)
+}
+
+// FindCalls gets all the calls that were made to Find.
+// Check the length with:
+//
+//	len(mockedRocketMessageLedger.FindCalls())
+func (mock *RocketMessageLedgerMock) FindCalls() []struct {
+	Ctx     context.Context
+	EventID string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EventID string
+	}
+	mock.lockFind.RLock()
+	calls = mock.calls.Find
+	mock.lockFind.RUnlock()
+	return calls
+}
+
+// Record calls RecordFunc.
+func (mock *RocketMessageLedgerMock) Record(ctx context.Context, record rocketdomain.RocketMessageRecord) error {
+	if mock.RecordFunc == nil {
+		panic("RocketMessageLedgerMock.RecordFunc: method is nil but RocketMessageLedger.Record was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Record rocketdomain.RocketMessageRecord
+	}{
+		Ctx:    ctx,
+		Record: record,
+	}
+	mock.lockRecord.Lock()
+	mock.calls.Record = append(mock.calls.Record, callInfo)
+	mock.lockRecord.Unlock()
+	return mock.RecordFunc(ctx, record)
+}
+
+// RecordCalls gets all the calls that were made to Record.
+// Check the length with:
+//
+//	len(mockedRocketMessageLedger.RecordCalls())
+func (mock *RocketMessageLedgerMock) RecordCalls() []struct {
+	Ctx    context.Context
+	Record rocketdomain.RocketMessageRecord
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Record rocketdomain.RocketMessageRecord
+	}
+	mock.lockRecord.RLock()
+	calls = mock.calls.Record
+	mock.lockRecord.RUnlock()
+	return calls
+}
@@ -0,0 +1,82 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package rocketdomainmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"sync"
+)
+
+// Ensure, that MissionGuardMock does implement rocketdomain.MissionGuard.
+// If this is not the case, regenerate this file with moq.
+var _ rocketdomain.MissionGuard = &MissionGuardMock{}
+
+// MissionGuardMock is a mock implementation of rocketdomain.MissionGuard.
+//
+//	func TestSomethingThatUsesMissionGuard(t *testing.T) {
+//
+//		// make and configure a mocked rocketdomain.MissionGuard
+//		mockedMissionGuard := &MissionGuardMock{
+//			EnsureAssignableFunc: func(ctx context.Context, mission rocketdomain.Mission) error {
+//				panic("mock out the EnsureAssignable method")
+//			},
+//		}
+//
+//		// use mockedMissionGuard in code that requires rocketdomain.MissionGuard
+//		// and then make assertions.
+//
+//	}
+type MissionGuardMock struct {
+	// EnsureAssignableFunc mocks the EnsureAssignable method.
+	EnsureAssignableFunc func(ctx context.Context, mission rocketdomain.Mission) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// EnsureAssignable holds details about calls to the EnsureAssignable method.
+		EnsureAssignable []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Mission is the mission argument value.
+			Mission rocketdomain.Mission
+		}
+	}
+	lockEnsureAssignable sync.RWMutex
+}
+
+// EnsureAssignable calls EnsureAssignableFunc.
+func (mock *MissionGuardMock) EnsureAssignable(ctx context.Context, mission rocketdomain.Mission) error {
+	if mock.EnsureAssignableFunc == nil {
+		panic("MissionGuardMock.EnsureAssignableFunc: method is nil but MissionGuard.EnsureAssignable was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Mission rocketdomain.Mission
+	}{
+		Ctx:     ctx,
+		Mission: mission,
+	}
+	mock.lockEnsureAssignable.Lock()
+	mock.calls.EnsureAssignable = append(mock.calls.EnsureAssignable, callInfo)
+	mock.lockEnsureAssignable.Unlock()
+	return mock.EnsureAssignableFunc(ctx, mission)
+}
+
+// EnsureAssignableCalls gets all the calls that were made to EnsureAssignable.
+// Check the length with:
+//
+//	len(mockedMissionGuard.EnsureAssignableCalls())
+func (mock *MissionGuardMock) EnsureAssignableCalls() []struct {
+	Ctx     context.Context
+	Mission rocketdomain.Mission
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Mission rocketdomain.Mission
+	}
+	mock.lockEnsureAssignable.RLock()
+	calls = mock.calls.EnsureAssignable
+	mock.lockEnsureAssignable.RUnlock()
+	return calls
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
 	"sync"
+	"time"
 )
 
 // Ensure, that RocketRepositoryMock does implement rocketdomain.RocketRepository.
@@ -22,12 +23,18 @@ var _ rocketdomain.RocketRepository = &RocketRepositoryMock{}
 //			FindFunc: func(ctx context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
 //				panic("mock out the Find method")
 //			},
+//			FindByMissionAtFunc: func(ctx context.Context, mission rocketdomain.Mission, at time.Time) (rocketdomain.RocketCollection, error) {
+//				panic("mock out the FindByMissionAt method")
+//			},
 //			SaveFunc: func(ctx context.Context, r *rocketdomain.Rocket) error {
 //				panic("mock out the Save method")
 //			},
-//			SearchFunc: func(ctx context.Context, sortBy string, asc bool) (rocketdomain.RocketCollection, error) {
+//			SearchFunc: func(ctx context.Context, criteria rocketdomain.RocketSearchCriteria) (rocketdomain.RocketPage, error) {
 //				panic("mock out the Search method")
 //			},
+//			StatsFunc: func(ctx context.Context, criteria rocketdomain.RocketStatsCriteria) (rocketdomain.RocketStatsReport, error) {
+//				panic("mock out the Stats method")
+//			},
 //		}
 //
 //		// use mockedRocketRepository in code that requires rocketdomain.RocketRepository
@@ -38,11 +45,17 @@ type RocketRepositoryMock struct {
 	// FindFunc mocks the Find method.
 	FindFunc func(ctx context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error)
 
+	// FindByMissionAtFunc mocks the FindByMissionAt method.
+	FindByMissionAtFunc func(ctx context.Context, mission rocketdomain.Mission, at time.Time) (rocketdomain.RocketCollection, error)
+
 	// SaveFunc mocks the Save method.
 	SaveFunc func(ctx context.Context, r *rocketdomain.Rocket) error
 
 	// SearchFunc mocks the Search method.
-	SearchFunc func(ctx context.Context, sortBy string, asc bool) (rocketdomain.RocketCollection, error)
+	SearchFunc func(ctx context.Context, criteria rocketdomain.RocketSearchCriteria) (rocketdomain.RocketPage, error)
+
+	// StatsFunc mocks the Stats method.
+	StatsFunc func(ctx context.Context, criteria rocketdomain.RocketStatsCriteria) (rocketdomain.RocketStatsReport, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -53,6 +66,15 @@ type RocketRepositoryMock struct {
 			// ID is the id argument value.
 			ID rocketdomain.RocketID
 		}
+		// FindByMissionAt holds details about calls to the FindByMissionAt method.
+		FindByMissionAt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Mission is the mission argument value.
+			Mission rocketdomain.Mission
+			// At is the at argument value.
+			At time.Time
+		}
 		// Save holds details about calls to the Save method.
 		Save []struct {
 			// Ctx is the ctx argument value.
@@ -64,15 +86,22 @@ type RocketRepositoryMock struct {
 		Search []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// SortBy is the sortBy argument value.
-			SortBy string
-			// Asc is the asc argument value.
-			Asc bool
+			// Criteria is the criteria argument value.
+			Criteria rocketdomain.RocketSearchCriteria
+		}
+		// Stats holds details about calls to the Stats method.
+		Stats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Criteria is the criteria argument value.
+			Criteria rocketdomain.RocketStatsCriteria
 		}
 	}
-	lockFind   sync.RWMutex
-	lockSave   sync.RWMutex
-	lockSearch sync.RWMutex
+	lockFind            sync.RWMutex
+	lockFindByMissionAt sync.RWMutex
+	lockSave            sync.RWMutex
+	lockSearch          sync.RWMutex
+	lockStats           sync.RWMutex
 }
 
 // Find calls FindFunc.
@@ -111,6 +140,46 @@ func (mock *RocketRepositoryMock) FindCalls() []struct {
 	return calls
 }
 
+// FindByMissionAt calls FindByMissionAtFunc.
+func (mock *RocketRepositoryMock) FindByMissionAt(ctx context.Context, mission rocketdomain.Mission, at time.Time) (rocketdomain.RocketCollection, error) {
+	if mock.FindByMissionAtFunc == nil {
+		panic("RocketRepositoryMock.FindByMissionAtFunc: method is nil but RocketRepository.FindByMissionAt was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Mission rocketdomain.Mission
+		At      time.Time
+	}{
+		Ctx:     ctx,
+		Mission: mission,
+		At:      at,
+	}
+	mock.lockFindByMissionAt.Lock()
+	mock.calls.FindByMissionAt = append(mock.calls.FindByMissionAt, callInfo)
+	mock.lockFindByMissionAt.Unlock()
+	return mock.FindByMissionAtFunc(ctx, mission, at)
+}
+
+// FindByMissionAtCalls gets all the calls that were made to FindByMissionAt.
+// Check the length with:
+//
+//	len(mockedRocketRepository.FindByMissionAtCalls())
+func (mock *RocketRepositoryMock) FindByMissionAtCalls() []struct {
+	Ctx     context.Context
+	Mission rocketdomain.Mission
+	At      time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Mission rocketdomain.Mission
+		At      time.Time
+	}
+	mock.lockFindByMissionAt.RLock()
+	calls = mock.calls.FindByMissionAt
+	mock.lockFindByMissionAt.RUnlock()
+	return calls
+}
+
 // Save calls SaveFunc.
 func (mock *RocketRepositoryMock) Save(ctx context.Context, r *rocketdomain.Rocket) error {
 	if mock.SaveFunc == nil {
@@ -148,23 +217,21 @@ func (mock *RocketRepositoryMock) SaveCalls() []struct {
 }
 
 // Search calls SearchFunc.
-func (mock *RocketRepositoryMock) Search(ctx context.Context, sortBy string, asc bool) (rocketdomain.RocketCollection, error) {
+func (mock *RocketRepositoryMock) Search(ctx context.Context, criteria rocketdomain.RocketSearchCriteria) (rocketdomain.RocketPage, error) {
 	if mock.SearchFunc == nil {
 		panic("RocketRepositoryMock.SearchFunc: method is nil but RocketRepository.Search was just called")
 	}
 	callInfo := struct {
-		Ctx    context.Context
-		SortBy string
-		Asc    bool
+		Ctx      context.Context
+		Criteria rocketdomain.RocketSearchCriteria
 	}{
-		Ctx:    ctx,
-		SortBy: sortBy,
-		Asc:    asc,
+		Ctx:      ctx,
+		Criteria: criteria,
 	}
 	mock.lockSearch.Lock()
 	mock.calls.Search = append(mock.calls.Search, callInfo)
 	mock.lockSearch.Unlock()
-	return mock.SearchFunc(ctx, sortBy, asc)
+	return mock.SearchFunc(ctx, criteria)
 }
 
 // SearchCalls gets all the calls that were made to Search.
@@ -172,17 +239,51 @@ func (mock *RocketRepositoryMock) Search(ctx context.Context, sortBy string, asc
 //
 //	len(mockedRocketRepository.SearchCalls())
 func (mock *RocketRepositoryMock) SearchCalls() []struct {
-	Ctx    context.Context
-	SortBy string
-	Asc    bool
+	Ctx      context.Context
+	Criteria rocketdomain.RocketSearchCriteria
 } {
 	var calls []struct {
-		Ctx    context.Context
-		SortBy string
-		Asc    bool
+		Ctx      context.Context
+		Criteria rocketdomain.RocketSearchCriteria
 	}
 	mock.lockSearch.RLock()
 	calls = mock.calls.Search
 	mock.lockSearch.RUnlock()
 	return calls
 }
+
+// Stats calls StatsFunc.
+func (mock *RocketRepositoryMock) Stats(ctx context.Context, criteria rocketdomain.RocketStatsCriteria) (rocketdomain.RocketStatsReport, error) {
+	if mock.StatsFunc == nil {
+		panic("RocketRepositoryMock.StatsFunc: method is nil but RocketRepository.Stats was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Criteria rocketdomain.RocketStatsCriteria
+	}{
+		Ctx:      ctx,
+		Criteria: criteria,
+	}
+	mock.lockStats.Lock()
+	mock.calls.Stats = append(mock.calls.Stats, callInfo)
+	mock.lockStats.Unlock()
+	return mock.StatsFunc(ctx, criteria)
+}
+
+// StatsCalls gets all the calls that were made to Stats.
+// Check the length with:
+//
+//	len(mockedRocketRepository.StatsCalls())
+func (mock *RocketRepositoryMock) StatsCalls() []struct {
+	Ctx      context.Context
+	Criteria rocketdomain.RocketStatsCriteria
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Criteria rocketdomain.RocketStatsCriteria
+	}
+	mock.lockStats.RLock()
+	calls = mock.calls.Stats
+	mock.lockStats.RUnlock()
+	return calls
+}
@@ -0,0 +1,207 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package rocketdomainmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"sync"
+	"time"
+)
+
+// Ensure, that RocketSpeedHistoryMock does implement rocketdomain.RocketSpeedHistory.
+// If this is not the case, regenerate this file with moq.
+var _ rocketdomain.RocketSpeedHistory = &RocketSpeedHistoryMock{}
+
+// RocketSpeedHistoryMock is a mock implementation of rocketdomain.RocketSpeedHistory.
+//
+//	func TestSomethingThatUsesRocketSpeedHistory(t *testing.T) {
+//
+//		// make and configure a mocked rocketdomain.RocketSpeedHistory
+//		mockedRocketSpeedHistory := &RocketSpeedHistoryMock{
+//			CompactFunc: func(ctx context.Context, policy rocketdomain.RocketSpeedRetentionPolicy, now time.Time) error {
+//				panic("mock out the Compact method")
+//			},
+//			RangeFunc: func(ctx context.Context, id rocketdomain.RocketID, from time.Time, to time.Time) (rocketdomain.RocketSpeedTimeline, error) {
+//				panic("mock out the Range method")
+//			},
+//			RecordFunc: func(ctx context.Context, id rocketdomain.RocketID, point rocketdomain.RocketSpeedPoint) error {
+//				panic("mock out the Record method")
+//			},
+//		}
+//
+//		// use mockedRocketSpeedHistory in code that requires rocketdomain.RocketSpeedHistory
+//		// and then make assertions.
+//
+//	}
+type RocketSpeedHistoryMock struct {
+	// CompactFunc mocks the Compact method.
+	CompactFunc func(ctx context.Context, policy rocketdomain.RocketSpeedRetentionPolicy, now time.Time) error
+
+	// RangeFunc mocks the Range method.
+	RangeFunc func(ctx context.Context, id rocketdomain.RocketID, from time.Time, to time.Time) (rocketdomain.RocketSpeedTimeline, error)
+
+	// RecordFunc mocks the Record method.
+	RecordFunc func(ctx context.Context, id rocketdomain.RocketID, point rocketdomain.RocketSpeedPoint) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Compact holds details about calls to the Compact method.
+		Compact []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Policy is the policy argument value.
+			Policy rocketdomain.RocketSpeedRetentionPolicy
+			// Now is the now argument value.
+			Now time.Time
+		}
+		// Range holds details about calls to the Range method.
+		Range []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID rocketdomain.RocketID
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// Record holds details about calls to the Record method.
+		Record []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID rocketdomain.RocketID
+			// Point is the point argument value.
+			Point rocketdomain.RocketSpeedPoint
+		}
+	}
+	lockCompact sync.RWMutex
+	lockRange   sync.RWMutex
+	lockRecord  sync.RWMutex
+}
+
+// Compact calls CompactFunc.
+func (mock *RocketSpeedHistoryMock) Compact(ctx context.Context, policy rocketdomain.RocketSpeedRetentionPolicy, now time.Time) error {
+	if mock.CompactFunc == nil {
+		panic("RocketSpeedHistoryMock.CompactFunc: method is nil but RocketSpeedHistory.Compact was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Policy rocketdomain.RocketSpeedRetentionPolicy
+		Now    time.Time
+	}{
+		Ctx:    ctx,
+		Policy: policy,
+		Now:    now,
+	}
+	mock.lockCompact.Lock()
+	mock.calls.Compact = append(mock.calls.Compact, callInfo)
+	mock.lockCompact.Unlock()
+	return mock.CompactFunc(ctx, policy, now)
+}
+
+// CompactCalls gets all the calls that were made to Compact.
+// Check the length with:
+//
+//	len(mockedRocketSpeedHistory.CompactCalls())
+func (mock *RocketSpeedHistoryMock) CompactCalls() []struct {
+	Ctx    context.Context
+	Policy rocketdomain.RocketSpeedRetentionPolicy
+	Now    time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Policy rocketdomain.RocketSpeedRetentionPolicy
+		Now    time.Time
+	}
+	mock.lockCompact.RLock()
+	calls = mock.calls.Compact
+	mock.lockCompact.RUnlock()
+	return calls
+}
+
+// Range calls RangeFunc.
+func (mock *RocketSpeedHistoryMock) Range(ctx context.Context, id rocketdomain.RocketID, from time.Time, to time.Time) (rocketdomain.RocketSpeedTimeline, error) {
+	if mock.RangeFunc == nil {
+		panic("RocketSpeedHistoryMock.RangeFunc: method is nil but RocketSpeedHistory.Range was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		ID   rocketdomain.RocketID
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		From: from,
+		To:   to,
+	}
+	mock.lockRange.Lock()
+	mock.calls.Range = append(mock.calls.Range, callInfo)
+	mock.lockRange.Unlock()
+	return mock.RangeFunc(ctx, id, from, to)
+}
+
+// RangeCalls gets all the calls that were made to Range.
+// Check the length with:
+//
+//	len(mockedRocketSpeedHistory.RangeCalls())
+func (mock *RocketSpeedHistoryMock) RangeCalls() []struct {
+	Ctx  context.Context
+	ID   rocketdomain.RocketID
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   rocketdomain.RocketID
+		From time.Time
+		To   time.Time
+	}
+	mock.lockRange.RLock()
+	calls = mock.calls.Range
+	mock.lockRange.RUnlock()
+	return calls
+}
+
+// Record calls RecordFunc.
+func (mock *RocketSpeedHistoryMock) Record(ctx context.Context, id rocketdomain.RocketID, point rocketdomain.RocketSpeedPoint) error {
+	if mock.RecordFunc == nil {
+		panic("RocketSpeedHistoryMock.RecordFunc: method is nil but RocketSpeedHistory.Record was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ID    rocketdomain.RocketID
+		Point rocketdomain.RocketSpeedPoint
+	}{
+		Ctx:   ctx,
+		ID:    id,
+		Point: point,
+	}
+	mock.lockRecord.Lock()
+	mock.calls.Record = append(mock.calls.Record, callInfo)
+	mock.lockRecord.Unlock()
+	return mock.RecordFunc(ctx, id, point)
+}
+
+// RecordCalls gets all the calls that were made to Record.
+// Check the length with:
+//
+//	len(mockedRocketSpeedHistory.RecordCalls())
+func (mock *RocketSpeedHistoryMock) RecordCalls() []struct {
+	Ctx   context.Context
+	ID    rocketdomain.RocketID
+	Point rocketdomain.RocketSpeedPoint
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ID    rocketdomain.RocketID
+		Point rocketdomain.RocketSpeedPoint
+	}
+	mock.lockRecord.RLock()
+	calls = mock.calls.Record
+	mock.lockRecord.RUnlock()
+	return calls
+}
@@ -0,0 +1,176 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package rocketdomainmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"sync"
+)
+
+// Ensure, that RocketTypeRegistryMock does implement rocketdomain.RocketTypeRegistry.
+// If this is not the case, regenerate this file with moq.
+var _ rocketdomain.RocketTypeRegistry = &RocketTypeRegistryMock{}
+
+// RocketTypeRegistryMock is a mock implementation of rocketdomain.RocketTypeRegistry.
+//
+//	func TestSomethingThatUsesRocketTypeRegistry(t *testing.T) {
+//
+//		// make and configure a mocked rocketdomain.RocketTypeRegistry
+//		mockedRocketTypeRegistry := &RocketTypeRegistryMock{
+//			AllFunc: func(ctx context.Context) ([]rocketdomain.RocketTypeSpec, error) {
+//				panic("mock out the All method")
+//			},
+//			RegisterFunc: func(ctx context.Context, spec rocketdomain.RocketTypeSpec) error {
+//				panic("mock out the Register method")
+//			},
+//			ResolveFunc: func(ctx context.Context, name string) (rocketdomain.RocketTypeSpec, error) {
+//				panic("mock out the Resolve method")
+//			},
+//		}
+//
+//		// use mockedRocketTypeRegistry in code that requires rocketdomain.RocketTypeRegistry
+//		// and then make assertions.
+//
+//	}
+type RocketTypeRegistryMock struct {
+	// AllFunc mocks the All method.
+	AllFunc func(ctx context.Context) ([]rocketdomain.RocketTypeSpec, error)
+
+	// RegisterFunc mocks the Register method.
+	RegisterFunc func(ctx context.Context, spec rocketdomain.RocketTypeSpec) error
+
+	// ResolveFunc mocks the Resolve method.
+	ResolveFunc func(ctx context.Context, name string) (rocketdomain.RocketTypeSpec, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// All holds details about calls to the All method.
+		All []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Register holds details about calls to the Register method.
+		Register []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Spec is the spec argument value.
+			Spec rocketdomain.RocketTypeSpec
+		}
+		// Resolve holds details about calls to the Resolve method.
+		Resolve []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+	}
+	lockAll      sync.RWMutex
+	lockRegister sync.RWMutex
+	lockResolve  sync.RWMutex
+}
+
+// All calls AllFunc.
+func (mock *RocketTypeRegistryMock) All(ctx context.Context) ([]rocketdomain.RocketTypeSpec, error) {
+	if mock.AllFunc == nil {
+		panic("RocketTypeRegistryMock.AllFunc: method is nil but RocketTypeRegistry.All was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockAll.Lock()
+	mock.calls.All = append(mock.calls.All, callInfo)
+	mock.lockAll.Unlock()
+	return mock.AllFunc(ctx)
+}
+
+// AllCalls gets all the calls that were made to All.
+// Check the length with:
+//
+//	len(mockedRocketTypeRegistry.AllCalls())
+func (mock *RocketTypeRegistryMock) AllCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockAll.RLock()
+	calls = mock.calls.All
+	mock.lockAll.RUnlock()
+	return calls
+}
+
+// Register calls RegisterFunc.
+func (mock *RocketTypeRegistryMock) Register(ctx context.Context, spec rocketdomain.RocketTypeSpec) error {
+	if mock.RegisterFunc == nil {
+		panic("RocketTypeRegistryMock.RegisterFunc: method is nil but RocketTypeRegistry.Register was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Spec rocketdomain.RocketTypeSpec
+	}{
+		Ctx:  ctx,
+		Spec: spec,
+	}
+	mock.lockRegister.Lock()
+	mock.calls.Register = append(mock.calls.Register, callInfo)
+	mock.lockRegister.Unlock()
+	return mock.RegisterFunc(ctx, spec)
+}
+
+// RegisterCalls gets all the calls that were made to Register.
+// Check the length with:
+//
+//	len(mockedRocketTypeRegistry.RegisterCalls())
+func (mock *RocketTypeRegistryMock) RegisterCalls() []struct {
+	Ctx  context.Context
+	Spec rocketdomain.RocketTypeSpec
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Spec rocketdomain.RocketTypeSpec
+	}
+	mock.lockRegister.RLock()
+	calls = mock.calls.Register
+	mock.lockRegister.RUnlock()
+	return calls
+}
+
+// Resolve calls ResolveFunc.
+func (mock *RocketTypeRegistryMock) Resolve(ctx context.Context, name string) (rocketdomain.RocketTypeSpec, error) {
+	if mock.ResolveFunc == nil {
+		panic("RocketTypeRegistryMock.ResolveFunc: method is nil but RocketTypeRegistry.Resolve was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockResolve.Lock()
+	mock.calls.Resolve = append(mock.calls.Resolve, callInfo)
+	mock.lockResolve.Unlock()
+	return mock.ResolveFunc(ctx, name)
+}
+
+// ResolveCalls gets all the calls that were made to Resolve.
+// Check the length with:
+//
+//	len(mockedRocketTypeRegistry.ResolveCalls())
+func (mock *RocketTypeRegistryMock) ResolveCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockResolve.RLock()
+	calls = mock.calls.Resolve
+	mock.lockResolve.RUnlock()
+	return calls
+}
@@ -7,21 +7,112 @@ import (
 type RocketPrimitives struct {
 	ID          string
 	RocketType  string
+	UnknownType bool
 	LaunchSpeed int64
+	Status      string
+	Version     uint64
 	Mission     string
+	Missions    []MissionAssignmentPrimitives
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   *time.Time
+
+	// MessageNumber is the number of the last message applied to the rocket, zero when unknown.
+	MessageNumber uint64
+}
+
+type MissionAssignmentPrimitives struct {
+	Mission string
+	From    time.Time
+	To      *time.Time
 }
 
 func primitivesFromDomain(r *Rocket) RocketPrimitives {
 	return RocketPrimitives{
 		ID:          r.id.String(),
 		RocketType:  r.rocketType.String(),
+		UnknownType: r.unknownType,
 		LaunchSpeed: r.launchSpeed.Value(),
+		Status:      r.status.String(),
+		Version:     r.version,
 		Mission:     r.mission.String(),
+		Missions:    missionHistoryToPrimitives(r.missions),
 		CreatedAt:   r.createdAt,
 		UpdatedAt:   r.updatedAt,
 		DeletedAt:   r.deletedAt,
+
+		MessageNumber: r.messageNumber,
+	}
+}
+
+// RocketFromPrimitives rebuilds a rocket aggregate from its persisted primitives.
+func RocketFromPrimitives(p RocketPrimitives) *Rocket {
+	return &Rocket{
+		id:          RocketID(p.ID),
+		rocketType:  RocketType(p.RocketType),
+		unknownType: p.UnknownType,
+		launchSpeed: LaunchSpeed(p.LaunchSpeed),
+		status:      rocketStatusFromPrimitives(p),
+		version:     max(p.Version, 1),
+		mission:     Mission(p.Mission),
+		missions:    missionHistoryFromPrimitives(p),
+		createdAt:   p.CreatedAt,
+		updatedAt:   p.UpdatedAt,
+		deletedAt:   p.DeletedAt,
+
+		messageNumber: p.MessageNumber,
+	}
+}
+
+// rocketStatusFromPrimitives rebuilds the rocket status, rockets persisted without one are considered
+// exploded when deleted and in flight once changed after their launch.
+func rocketStatusFromPrimitives(p RocketPrimitives) RocketStatus {
+	switch {
+	case p.Status != "":
+		return RocketStatus(p.Status)
+	case p.DeletedAt != nil:
+		return RocketStatusExploded
+	case p.UpdatedAt.After(p.CreatedAt):
+		return RocketStatusInFlight
+	default:
+		return RocketStatusLaunched
 	}
 }
+
+// missionHistoryToPrimitives copies every assignment, so snapshots never share the end dates with the aggregate.
+func missionHistoryToPrimitives(history MissionHistory) []MissionAssignmentPrimitives {
+	primitives := make([]MissionAssignmentPrimitives, len(history))
+	for i, assignment := range history {
+		primitives[i] = MissionAssignmentPrimitives{Mission: assignment.Mission.String(), From: assignment.From}
+		if assignment.To != nil {
+			to := *assignment.To
+			primitives[i].To = &to
+		}
+	}
+
+	return primitives
+}
+
+// missionHistoryFromPrimitives rebuilds the mission history, rockets persisted without one are
+// considered to have flown their current mission since they were created.
+func missionHistoryFromPrimitives(p RocketPrimitives) MissionHistory {
+	if len(p.Missions) == 0 {
+		history := newMissionHistory(Mission(p.Mission), p.CreatedAt)
+		if p.DeletedAt != nil {
+			return history.Close(*p.DeletedAt)
+		}
+
+		return history
+	}
+
+	history := make(MissionHistory, len(p.Missions))
+	for i, assignment := range p.Missions {
+		history[i] = MissionAssignment{Mission: Mission(assignment.Mission), From: assignment.From}
+		if assignment.To != nil {
+			to := *assignment.To
+			history[i].To = &to
+		}
+	}
+
+	return history
+}
@@ -1,17 +1,36 @@
 package rocketdomain
 
 import (
+	"slices"
 	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
 )
 
 type Rocket struct {
 	id          RocketID
 	rocketType  RocketType
+	unknownType bool
 	launchSpeed LaunchSpeed
+	status      RocketStatus
+	version     uint64
 	mission     Mission
+	missions    MissionHistory
 	createdAt   time.Time
 	updatedAt   time.Time
 	deletedAt   *time.Time
+
+	// messageNumber is the number of the last message applied to the rocket, message tells the one its
+	// changes come from while being changed and replayed whether the rocket had already applied it.
+	messageNumber uint64
+	message       uint64
+	replayed      bool
+
+	// envelope bounds the launch speed while the rocket is being changed, it comes from the
+	// rocket type catalog and is never persisted along with the rocket.
+	envelope *SpeedEnvelope
+
+	events domain.EventRecorder
 }
 
 func NewRocket(
@@ -21,47 +40,141 @@ func NewRocket(
 	mission Mission,
 	at time.Time,
 ) *Rocket {
-	return &Rocket{
+	rocket := &Rocket{
 		id:          id,
 		rocketType:  rocketType,
 		launchSpeed: launchSpeed,
+		status:      RocketStatusLaunched,
+		version:     1,
 		mission:     mission,
+		missions:    newMissionHistory(mission, at),
 		createdAt:   at,
 		updatedAt:   at,
 	}
+
+	rocket.events.Record(&RocketCreated{
+		RocketID:    id,
+		RocketType:  rocketType,
+		LaunchSpeed: launchSpeed,
+		Mission:     mission,
+		At:          at,
+	})
+
+	return rocket
 }
 
 func (r *Rocket) Primitives() RocketPrimitives {
 	return primitivesFromDomain(r)
 }
 
+// PullEvents returns the domain events raised since the last pull, meant to be published once the rocket is saved.
+func (r *Rocket) PullEvents() []domain.Event {
+	return r.events.PullEvents()
+}
+
 func (r *Rocket) ID() RocketID {
 	return r.id
 }
 
-func (r *Rocket) ChangeLaunchSpeed(speed LaunchSpeed, at time.Time) {
-	if r.deletedAt != nil || at.IsZero() || r.updatedAt.After(at) {
-		return
+func (r *Rocket) Status() RocketStatus {
+	return r.status
+}
+
+// Version counts the changes applied to the rocket, its launch included.
+func (r *Rocket) Version() uint64 {
+	return r.version
+}
+
+// HasUnknownType reports whether the rocket was launched with a type missing from the rocket type catalog.
+func (r *Rocket) HasUnknownType() bool {
+	return r.unknownType
+}
+
+// SpeedEnvelope returns the launch speed limits the rocket is held to, the default ones when unconstrained.
+func (r *Rocket) SpeedEnvelope() SpeedEnvelope {
+	if r.envelope == nil {
+		return DefaultSpeedEnvelope()
+	}
+
+	return *r.envelope
+}
+
+func (r *Rocket) constrainSpeed(envelope SpeedEnvelope) {
+	r.envelope = &envelope
+}
+
+func (r *Rocket) flagUnknownType() {
+	r.unknownType = true
+}
+
+// MissionHistory returns every mission the rocket has been assigned to, the current one being the last.
+func (r *Rocket) MissionHistory() MissionHistory {
+	return slices.Clone(r.missions)
+}
+
+func (r *Rocket) ChangeLaunchSpeed(speed LaunchSpeed, at time.Time) error {
+	applied, err := r.transition(RocketStatusInFlight, at)
+	if err != nil || !applied {
+		return err
+	}
+
+	if speed != r.launchSpeed {
+		r.events.Record(&RocketSpeedChanged{RocketID: r.id, From: r.launchSpeed, To: speed, At: at})
 	}
 
 	r.launchSpeed = speed
-	r.updatedAt = at
+	return nil
 }
 
-func (r *Rocket) ChangeMission(newMission Mission, at time.Time) {
-	if r.deletedAt != nil || at.IsZero() || r.updatedAt.After(at) {
-		return
+func (r *Rocket) ChangeMission(newMission Mission, at time.Time) error {
+	applied, err := r.transition(RocketStatusInFlight, at)
+	if err != nil || !applied {
+		return err
+	}
+
+	if newMission != r.mission {
+		r.events.Record(&RocketMissionReassigned{RocketID: r.id, From: r.mission, To: newMission, At: at})
 	}
 
 	r.mission = newMission
-	r.updatedAt = at
+	r.missions = r.missions.Reassign(newMission, at)
+	return nil
 }
 
-func (r *Rocket) Delete(at time.Time) {
-	if r.deletedAt != nil || at.IsZero() || r.updatedAt.After(at) {
-		return
+func (r *Rocket) Delete(at time.Time) error {
+	applied, err := r.transition(RocketStatusExploded, at)
+	if err != nil || !applied {
+		return err
 	}
 
-	r.updatedAt = at
+	r.missions = r.missions.Close(at)
 	r.deletedAt = &at
+	r.events.Record(&RocketDestroyed{RocketID: r.id, At: at})
+	return nil
+}
+
+// receiveMessage tells the rocket the message its next changes come from, messages without number are never
+// considered applied.
+func (r *Rocket) receiveMessage(number uint64) {
+	r.message = number
+	r.replayed = number != 0 && number <= r.messageNumber
+}
+
+// transition moves the rocket to the given status, RocketTransitionNotAllowedError when the current
+// status doesn't allow it. Changes older than the last applied one are left out without failing, as
+// well as changes from a message already applied so redelivering it never applies them twice.
+func (r *Rocket) transition(next RocketStatus, at time.Time) (bool, error) {
+	if !r.status.CanTransitionTo(next) {
+		return false, NewRocketTransitionNotAllowedError(r.id, r.version, r.status, next)
+	}
+
+	if at.IsZero() || r.updatedAt.After(at) || r.replayed {
+		return false, nil
+	}
+
+	r.status = next
+	r.version++
+	r.updatedAt = at
+	r.messageNumber = max(r.messageNumber, r.message)
+	return true, nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
 )
 
 type RocketCreateParams struct {
@@ -12,24 +14,62 @@ type RocketCreateParams struct {
 	LaunchSpeed int64
 	Mission     string
 	At          time.Time
+
+	// MessageNumber is the number of the message launching the rocket, zero when unknown.
+	MessageNumber uint64
+}
+
+type RocketCreatorOpt func(creator *RocketCreator)
+
+// WithCreatorRocketTypes resolves the rocket types through the catalog, holding the launch speed to their limits.
+func WithCreatorRocketTypes(resolver *RocketTypeResolver) RocketCreatorOpt {
+	return func(creator *RocketCreator) {
+		creator.rocketTypes = resolver
+	}
 }
+
+// WithCreatorEvents publishes the domain events raised by the rocket once it's saved.
+func WithCreatorEvents(publisher domain.EventPublisher) RocketCreatorOpt {
+	return func(creator *RocketCreator) {
+		creator.publisher = publisher
+	}
+}
+
 type RocketCreator struct {
-	repository RocketRepository
+	repository  RocketRepository
+	rocketTypes *RocketTypeResolver
+	publisher   domain.EventPublisher
 }
 
-func NewRocketCreator(repository RocketRepository) *RocketCreator {
-	return &RocketCreator{
+func NewRocketCreator(repository RocketRepository, opts ...RocketCreatorOpt) *RocketCreator {
+	creator := &RocketCreator{
 		repository: repository,
 	}
+
+	for _, opt := range opts {
+		opt(creator)
+	}
+
+	return creator
 }
 
-func (r *RocketCreator) Create(ctx context.Context, dto RocketCreateParams) (*Rocket, error) {
+// Create launches the rocket, Applied being false when the launch message was already applied to it.
+func (r *RocketCreator) Create(ctx context.Context, dto RocketCreateParams) (*RocketUpdate, error) {
 	id, err := NewRocketID(dto.ID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid rocket id: %w", err)
 	}
 
-	rocketType, err := NewRocketType(dto.RocketType)
+	launched, exists, err := r.findLaunched(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return relaunch(launched, dto.MessageNumber)
+	}
+
+	rocketType, err := r.resolveRocketType(ctx, dto.RocketType)
 	if err != nil {
 		return nil, fmt.Errorf("invalid rocket type: %w", err)
 	}
@@ -39,16 +79,68 @@ func (r *RocketCreator) Create(ctx context.Context, dto RocketCreateParams) (*Ro
 		return nil, fmt.Errorf("invalid rocket launch speed: %w", err)
 	}
 
+	if !rocketType.Spec.Envelope.Contains(launchSpeed) {
+		return nil, NewLaunchSpeedOutOfEnvelopeError(rocketType.Spec.Name, launchSpeed, rocketType.Spec.Envelope)
+	}
+
 	mission, err := NewMission(dto.Mission)
 	if err != nil {
 		return nil, fmt.Errorf("invalid mission: %w", err)
 	}
 
-	rocket := NewRocket(id, rocketType, launchSpeed, mission, dto.At)
+	rocket := NewRocket(id, rocketType.Spec.Name, launchSpeed, mission, dto.At)
+	rocket.messageNumber = dto.MessageNumber
+	rocket.constrainSpeed(rocketType.Spec.Envelope)
+	if rocketType.Unknown {
+		rocket.flagUnknownType()
+	}
 
 	if saveErr := r.repository.Save(ctx, rocket); saveErr != nil {
 		return nil, fmt.Errorf("failed to save rocket: %w", saveErr)
 	}
 
-	return rocket, nil
+	if publishErr := publishRocketEvents(ctx, r.publisher, rocket); publishErr != nil {
+		return nil, publishErr
+	}
+
+	return &RocketUpdate{Rocket: rocket, Applied: true}, nil
+}
+
+// findLaunched finds the rocket when it was already launched, false when it doesn't exist yet.
+func (r *RocketCreator) findLaunched(ctx context.Context, id RocketID) (*Rocket, bool, error) {
+	rocket, err := r.repository.Find(ctx, id)
+	if IsRocketNotFoundError(err) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find rocket: %w", err)
+	}
+
+	return rocket, true, nil
+}
+
+// relaunch leaves the rocket untouched, redelivering the launch message it already applied is left out while any
+// other launch fails with RocketTransitionNotAllowedError from its current status.
+func relaunch(rocket *Rocket, messageNumber uint64) (*RocketUpdate, error) {
+	rocket.receiveMessage(messageNumber)
+	if rocket.status.IsTerminal() || !rocket.replayed {
+		return nil, NewRocketTransitionNotAllowedError(rocket.id, rocket.version, rocket.status, RocketStatusLaunched)
+	}
+
+	return &RocketUpdate{Rocket: rocket, Applied: false}, nil
+}
+
+// resolveRocketType goes through the rocket type catalog when there's one, any valid rocket type is accepted otherwise.
+func (r *RocketCreator) resolveRocketType(ctx context.Context, name string) (ResolvedRocketType, error) {
+	if r.rocketTypes != nil {
+		return r.rocketTypes.Resolve(ctx, name)
+	}
+
+	rocketType, err := NewRocketType(name)
+	if err != nil {
+		return ResolvedRocketType{}, err
+	}
+
+	return ResolvedRocketType{Spec: newUnlimitedRocketTypeSpec(rocketType)}, nil
 }
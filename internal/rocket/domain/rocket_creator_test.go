@@ -36,6 +36,9 @@ func TestRocketCreator_Create(t *testing.T) {
 			name:  "should create rocket successfully",
 			input: validRocketCreateParams(),
 			setupMock: func(repo *rocketmock.RocketRepositoryMock) {
+				repo.FindFunc = func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+					return nil, rocketdomain.NewRocketNotFoundError(id)
+				}
 				repo.SaveFunc = func(ctx context.Context, r *rocketdomain.Rocket) error {
 					return nil
 				}
@@ -59,7 +62,11 @@ func TestRocketCreator_Create(t *testing.T) {
 				in.RocketType = ""
 				return in
 			}(),
-			setupMock:     func(repo *rocketmock.RocketRepositoryMock) {},
+			setupMock: func(repo *rocketmock.RocketRepositoryMock) {
+				repo.FindFunc = func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+					return nil, rocketdomain.NewRocketNotFoundError(id)
+				}
+			},
 			expectedError: "invalid rocket type",
 		},
 		{
@@ -69,19 +76,46 @@ func TestRocketCreator_Create(t *testing.T) {
 				in.Mission = ""
 				return in
 			}(),
-			setupMock:     func(repo *rocketmock.RocketRepositoryMock) {},
+			setupMock: func(repo *rocketmock.RocketRepositoryMock) {
+				repo.FindFunc = func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+					return nil, rocketdomain.NewRocketNotFoundError(id)
+				}
+			},
 			expectedError: "invalid mission",
 		},
 		{
 			name:  "should fail when repository save fails",
 			input: validRocketCreateParams(),
 			setupMock: func(repo *rocketmock.RocketRepositoryMock) {
+				repo.FindFunc = func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+					return nil, rocketdomain.NewRocketNotFoundError(id)
+				}
 				repo.SaveFunc = func(ctx context.Context, r *rocketdomain.Rocket) error {
 					return errors.New("db error")
 				}
 			},
 			expectedError: "failed to save rocket: db error",
 		},
+		{
+			name:  "should fail when rocket was already launched",
+			input: validRocketCreateParams(),
+			setupMock: func(repo *rocketmock.RocketRepositoryMock) {
+				repo.FindFunc = func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+					return rocketdomain.NewRocket(id, "Falcon9", 27000, "Satellite Deployment", time.Now()), nil
+				}
+			},
+			expectedError: "rocket status transition not allowed: launched -> launched",
+		},
+		{
+			name:  "should fail when repository find fails",
+			input: validRocketCreateParams(),
+			setupMock: func(repo *rocketmock.RocketRepositoryMock) {
+				repo.FindFunc = func(_ context.Context, _ rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+					return nil, errors.New("db error")
+				}
+			},
+			expectedError: "failed to find rocket: db error",
+		},
 	}
 
 	for _, tt := range tests {
@@ -90,14 +124,15 @@ func TestRocketCreator_Create(t *testing.T) {
 			tt.setupMock(mockRepo)
 
 			creator := rocketdomain.NewRocketCreator(mockRepo)
-			rocket, err := creator.Create(ctx, tt.input)
+			launch, err := creator.Create(ctx, tt.input)
 
 			if tt.expectedError == "" {
 				require.NoError(t, err)
-				require.NotNil(t, rocket)
+				require.NotNil(t, launch)
+				assert.True(t, launch.Applied)
 			} else {
 				require.Error(t, err)
-				require.Nil(t, rocket)
+				require.Nil(t, launch)
 				assert.Contains(t, err.Error(), tt.expectedError)
 			}
 		})
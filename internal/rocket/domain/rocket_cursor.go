@@ -0,0 +1,128 @@
+package rocketdomain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const invalidCursorReason = "cursor is malformed or expired"
+
+// rocketCursorKeyEncoders knows how to serialise and restore the value of every
+// sortable field so a cursor can point to a position without holding the rocket.
+var rocketCursorKeyEncoders = map[string]struct {
+	encode func(p RocketPrimitives) string
+	decode func(raw string, p *RocketPrimitives) error
+}{
+	"created_at": {
+		encode: func(p RocketPrimitives) string { return p.CreatedAt.Format(time.RFC3339Nano) },
+		decode: func(raw string, p *RocketPrimitives) error {
+			at, err := time.Parse(time.RFC3339Nano, raw)
+			p.CreatedAt = at
+			return err
+		},
+	},
+	"updated_at": {
+		encode: func(p RocketPrimitives) string { return p.UpdatedAt.Format(time.RFC3339Nano) },
+		decode: func(raw string, p *RocketPrimitives) error {
+			at, err := time.Parse(time.RFC3339Nano, raw)
+			p.UpdatedAt = at
+			return err
+		},
+	},
+	"launch_speed": {
+		encode: func(p RocketPrimitives) string { return strconv.FormatInt(p.LaunchSpeed, 10) },
+		decode: func(raw string, p *RocketPrimitives) error {
+			speed, err := strconv.ParseInt(raw, 10, 64)
+			p.LaunchSpeed = speed
+			return err
+		},
+	},
+	"mission": {
+		encode: func(p RocketPrimitives) string { return p.Mission },
+		decode: func(raw string, p *RocketPrimitives) error {
+			p.Mission = raw
+			return nil
+		},
+	},
+	"rocket_type": {
+		encode: func(p RocketPrimitives) string { return p.RocketType },
+		decode: func(raw string, p *RocketPrimitives) error {
+			p.RocketType = raw
+			return nil
+		},
+	},
+	RocketIDSortField: {
+		encode: func(p RocketPrimitives) string { return p.ID },
+		decode: func(raw string, p *RocketPrimitives) error {
+			if raw == "" {
+				return NewInvalidRocketSearchCriteriaError(invalidCursorReason)
+			}
+			p.ID = raw
+			return nil
+		},
+	},
+}
+
+// RocketCursor is an opaque pointer to the last rocket of a page built from
+// the values of every sort key, the rocket ID always being the last one.
+type RocketCursor struct {
+	sort     RocketSort
+	position RocketPrimitives
+}
+
+type rocketCursorPayload struct {
+	Sort   string   `json:"s"`
+	Values []string `json:"v"`
+}
+
+func NewRocketCursor(sort RocketSort, last RocketPrimitives) RocketCursor {
+	return RocketCursor{sort: sort, position: last}
+}
+
+func ParseRocketCursor(raw string) (RocketCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return RocketCursor{}, NewInvalidRocketSearchCriteriaError(invalidCursorReason)
+	}
+
+	var payload rocketCursorPayload
+	if unmarshalErr := json.Unmarshal(decoded, &payload); unmarshalErr != nil {
+		return RocketCursor{}, NewInvalidRocketSearchCriteriaError(invalidCursorReason)
+	}
+
+	sort, err := ParseRocketSort(payload.Sort)
+	if err != nil || !sort.Has(RocketIDSortField) || len(sort) != len(payload.Values) {
+		return RocketCursor{}, NewInvalidRocketSearchCriteriaError(invalidCursorReason)
+	}
+
+	var position RocketPrimitives
+	for i, key := range sort {
+		if decodeErr := rocketCursorKeyEncoders[key.Field].decode(payload.Values[i], &position); decodeErr != nil {
+			return RocketCursor{}, NewInvalidRocketSearchCriteriaError(invalidCursorReason)
+		}
+	}
+
+	return RocketCursor{sort: sort, position: position}, nil
+}
+
+// Position returns the primitives holding the sort key values the cursor points to.
+func (c RocketCursor) Position() RocketPrimitives {
+	return c.position
+}
+
+// MatchesSort reports whether the cursor was built for the given sorting.
+func (c RocketCursor) MatchesSort(sort RocketSort) bool {
+	return c.sort.String() == sort.String()
+}
+
+func (c RocketCursor) String() string {
+	payload := rocketCursorPayload{Sort: c.sort.String(), Values: make([]string, len(c.sort))}
+	for i, key := range c.sort {
+		payload.Values[i] = rocketCursorKeyEncoders[key.Field].encode(c.position)
+	}
+
+	raw, _ := json.Marshal(payload)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,39 @@
+package rocketdomain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rockettest "github.com/soulcodex/rockets-message-processor/test/rocket"
+)
+
+func TestRocketCursor_RoundTrip(t *testing.T) {
+	rocket := rockettest.NewRocketMother(rockettest.WithLaunchSpeed(4200)).Build(t).Primitives()
+
+	for _, raw := range []string{"created_at,id", "-updated_at,id", "-launch_speed,mission,rocket_type,id"} {
+		t.Run("should restore the position sorting by "+raw, func(t *testing.T) {
+			sort, err := rocketdomain.ParseRocketSort(raw)
+			require.NoError(t, err)
+			cursor := rocketdomain.NewRocketCursor(sort, rocket)
+
+			parsed, err := rocketdomain.ParseRocketCursor(cursor.String())
+			require.NoError(t, err)
+
+			assert.True(t, parsed.MatchesSort(sort))
+			assert.False(t, parsed.MatchesSort(sort[1:]))
+			assert.Equal(t, rocket.ID, parsed.Position().ID)
+			assert.Equal(t, cursor.String(), parsed.String())
+		})
+	}
+}
+
+func TestRocketCursor_ParseMalformed(t *testing.T) {
+	for _, raw := range []string{"not-a-cursor", "e30", "eyJzIjoibGF1bmNoX3NwZWVkIiwidiI6ImZhc3QiLCJpZCI6IngifQ"} {
+		_, err := rocketdomain.ParseRocketCursor(raw)
+		_, isCriteriaErr := rocketdomain.AsInvalidRocketSearchCriteriaError(err)
+		assert.True(t, isCriteriaErr, "expected %q to be rejected", raw)
+	}
+}
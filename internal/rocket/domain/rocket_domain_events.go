@@ -0,0 +1,112 @@
+package rocketdomain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+)
+
+const (
+	RocketCreatedEventType           = "rocket.created"
+	RocketSpeedChangedEventType      = "rocket.speed_changed"
+	RocketMissionReassignedEventType = "rocket.mission_reassigned"
+	RocketDestroyedEventType         = "rocket.destroyed"
+)
+
+var (
+	_ domain.Event = (*RocketCreated)(nil)
+	_ domain.Event = (*RocketSpeedChanged)(nil)
+	_ domain.Event = (*RocketMissionReassigned)(nil)
+	_ domain.Event = (*RocketDestroyed)(nil)
+)
+
+type RocketCreated struct {
+	RocketID    RocketID
+	RocketType  RocketType
+	LaunchSpeed LaunchSpeed
+	Mission     Mission
+	At          time.Time
+}
+
+func (e *RocketCreated) Type() string {
+	return RocketCreatedEventType
+}
+
+func (e *RocketCreated) AggregateID() string {
+	return e.RocketID.String()
+}
+
+func (e *RocketCreated) OccurredOn() time.Time {
+	return e.At
+}
+
+type RocketSpeedChanged struct {
+	RocketID RocketID
+	From     LaunchSpeed
+	To       LaunchSpeed
+	At       time.Time
+}
+
+func (e *RocketSpeedChanged) Type() string {
+	return RocketSpeedChangedEventType
+}
+
+func (e *RocketSpeedChanged) AggregateID() string {
+	return e.RocketID.String()
+}
+
+func (e *RocketSpeedChanged) OccurredOn() time.Time {
+	return e.At
+}
+
+type RocketMissionReassigned struct {
+	RocketID RocketID
+	From     Mission
+	To       Mission
+	At       time.Time
+}
+
+func (e *RocketMissionReassigned) Type() string {
+	return RocketMissionReassignedEventType
+}
+
+func (e *RocketMissionReassigned) AggregateID() string {
+	return e.RocketID.String()
+}
+
+func (e *RocketMissionReassigned) OccurredOn() time.Time {
+	return e.At
+}
+
+type RocketDestroyed struct {
+	RocketID RocketID
+	At       time.Time
+}
+
+func (e *RocketDestroyed) Type() string {
+	return RocketDestroyedEventType
+}
+
+func (e *RocketDestroyed) AggregateID() string {
+	return e.RocketID.String()
+}
+
+func (e *RocketDestroyed) OccurredOn() time.Time {
+	return e.At
+}
+
+// publishRocketEvents pulls the events raised by the rocket, publishing them when there's a publisher.
+func publishRocketEvents(ctx context.Context, publisher domain.EventPublisher, rocket *Rocket) error {
+	events := rocket.PullEvents()
+	if publisher == nil || len(events) == 0 {
+		return nil
+	}
+
+	if err := publisher.Publish(ctx, events...); err != nil {
+		return fmt.Errorf("failed to publish rocket events: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package rocketdomain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rocketmock "github.com/soulcodex/rockets-message-processor/internal/rocket/domain/mock"
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	domainmock "github.com/soulcodex/rockets-message-processor/pkg/domain/mock"
+)
+
+func TestRocket_PullEvents(t *testing.T) {
+	launchedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	rocketID := rocketdomain.RocketID("122c31b0-a3c4-411a-bc07-5f342f0d78e4")
+
+	rocket := rocketdomain.NewRocket(rocketID, "Falcon 9", 5000, "ARTEMIS", launchedAt)
+	require.NoError(t, rocket.ChangeLaunchSpeed(6000, launchedAt.Add(time.Minute)))
+	require.NoError(t, rocket.ChangeLaunchSpeed(6000, launchedAt.Add(2*time.Minute)))
+	require.NoError(t, rocket.ChangeLaunchSpeed(7000, launchedAt), "Expected out of order changes to be ignored")
+	require.NoError(t, rocket.ChangeMission("ARTEMIS", launchedAt.Add(3*time.Minute)))
+	require.NoError(t, rocket.ChangeMission("LUNAR", launchedAt.Add(4*time.Minute)))
+	require.NoError(t, rocket.Delete(launchedAt.Add(5*time.Minute)))
+
+	assert.Equal(t, []domain.Event{
+		&rocketdomain.RocketCreated{RocketID: rocketID, RocketType: "Falcon 9", LaunchSpeed: 5000, Mission: "ARTEMIS", At: launchedAt},
+		&rocketdomain.RocketSpeedChanged{RocketID: rocketID, From: 5000, To: 6000, At: launchedAt.Add(time.Minute)},
+		&rocketdomain.RocketMissionReassigned{RocketID: rocketID, From: "ARTEMIS", To: "LUNAR", At: launchedAt.Add(4 * time.Minute)},
+		&rocketdomain.RocketDestroyed{RocketID: rocketID, At: launchedAt.Add(5 * time.Minute)},
+	}, rocket.PullEvents(), "Expected only actual changes to raise events")
+	assert.Empty(t, rocket.PullEvents(), "Expected pulled events to be forgotten")
+
+	restored := rocketdomain.RocketFromPrimitives(rocket.Primitives())
+	assert.Empty(t, restored.PullEvents(), "Expected rebuilt rockets not to raise events")
+}
+
+func TestRocketUpdater_PublishesEventsAfterSave(t *testing.T) {
+	ctx := context.Background()
+	launchedAt := time.Now()
+	calls := make([]string, 0)
+
+	repo := &rocketmock.RocketRepositoryMock{
+		FindFunc: func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+			return rocketdomain.RocketFromPrimitives(rocketdomain.NewRocket(id, "Falcon 9", 5000, "ARTEMIS", launchedAt).Primitives()), nil
+		},
+		SaveFunc: func(_ context.Context, _ *rocketdomain.Rocket) error {
+			calls = append(calls, "save")
+			return nil
+		},
+	}
+	publisher := &domainmock.EventPublisherMock{
+		PublishFunc: func(_ context.Context, events ...domain.Event) error {
+			for _, event := range events {
+				calls = append(calls, event.Type())
+			}
+			return nil
+		},
+	}
+
+	updater := rocketdomain.NewRocketUpdater(repo, rocketdomain.WithUpdaterEvents(publisher))
+	_, err := updater.Update(ctx, validRocketCreateParams().ID, rocketdomain.WithLaunchSpeedDelta(100, launchedAt.Add(time.Minute)))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"save", rocketdomain.RocketSpeedChangedEventType}, calls)
+
+	repo.SaveFunc = func(_ context.Context, _ *rocketdomain.Rocket) error {
+		return errors.New("db error")
+	}
+	_, err = updater.Update(ctx, validRocketCreateParams().ID, rocketdomain.WithLaunchSpeedDelta(100, launchedAt.Add(time.Minute)))
+	require.Error(t, err)
+	assert.Len(t, publisher.PublishCalls(), 1, "Expected events of rockets failing to be saved not to be published")
+}
+
+func TestRocketChanges_RetriedAfterPublishFailure(t *testing.T) {
+	ctx := context.Background()
+	launchedAt := time.Now().Truncate(time.Second)
+	params := validRocketCreateParams()
+	params.LaunchSpeed, params.At, params.MessageNumber = 5000, launchedAt, 1
+
+	var stored *rocketdomain.RocketPrimitives
+	repo := &rocketmock.RocketRepositoryMock{
+		FindFunc: func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+			if stored == nil {
+				return nil, rocketdomain.NewRocketNotFoundError(id)
+			}
+			return rocketdomain.RocketFromPrimitives(*stored), nil
+		},
+		SaveFunc: func(_ context.Context, rocket *rocketdomain.Rocket) error {
+			primitives := rocket.Primitives()
+			stored = &primitives
+			return nil
+		},
+	}
+	failures := 0
+	publisher := &domainmock.EventPublisherMock{
+		PublishFunc: func(_ context.Context, _ ...domain.Event) error {
+			if failures == 0 {
+				failures++
+				return errors.New("publisher unavailable")
+			}
+			return nil
+		},
+	}
+
+	creator := rocketdomain.NewRocketCreator(repo, rocketdomain.WithCreatorEvents(publisher))
+	_, err := creator.Create(ctx, params)
+	require.Error(t, err, "Expected the launch to fail while its events can't be published")
+
+	launch, err := creator.Create(ctx, params)
+	require.NoError(t, err, "Expected the redelivered launch not to be refused")
+	assert.False(t, launch.Applied, "Expected the redelivered launch to be left out")
+	assert.Equal(t, uint64(1), launch.Rocket.Version())
+
+	failures = 0
+	updater := rocketdomain.NewRocketUpdater(repo, rocketdomain.WithUpdaterEvents(publisher))
+	speedIncreased := []rocketdomain.RocketUpdaterFunc{
+		rocketdomain.WithMessage(2),
+		rocketdomain.WithLaunchSpeedDelta(100, launchedAt.Add(time.Minute)),
+	}
+	_, err = updater.Update(ctx, params.ID, speedIncreased...)
+	require.Error(t, err, "Expected the update to fail while its events can't be published")
+	assert.Equal(t, int64(5100), stored.LaunchSpeed, "Expected the rocket to be saved before publishing")
+
+	update, err := updater.Update(ctx, params.ID, speedIncreased...)
+	require.NoError(t, err)
+	assert.False(t, update.Applied, "Expected the retried update to be left out")
+	assert.Equal(t, int64(5100), stored.LaunchSpeed, "Expected the speed delta not to be applied twice")
+	assert.Equal(t, uint64(2), update.Rocket.Version())
+
+	speedIncreased[0] = rocketdomain.WithMessage(3)
+	update, err = updater.Update(ctx, params.ID, speedIncreased...)
+	require.NoError(t, err)
+	assert.True(t, update.Applied, "Expected the next message to be applied")
+	assert.Equal(t, int64(5200), stored.LaunchSpeed)
+}
@@ -0,0 +1,24 @@
+package rocketdomain
+
+import (
+	"github.com/soulcodex/rockets-message-processor/pkg/filter"
+)
+
+// RocketFilterSchema lists the rocket primitives that can be referenced from a filter expression.
+var RocketFilterSchema = filter.Schema{
+	"id":           filter.FieldString,
+	"rocket_type":  filter.FieldString,
+	"mission":      filter.FieldString,
+	"launch_speed": filter.FieldNumber,
+	"created_at":   filter.FieldTime,
+	"updated_at":   filter.FieldTime,
+}
+
+func compileRocketFilter(expression string) (filter.Criteria, error) {
+	criteria, err := RocketFilterSchema.Compile(expression)
+	if err != nil {
+		return nil, NewInvalidRocketSearchCriteriaError(err.Error())
+	}
+
+	return criteria, nil
+}
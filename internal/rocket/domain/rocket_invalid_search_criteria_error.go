@@ -0,0 +1,43 @@
+package rocketdomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const invalidRocketSearchCriteriaErrorMessage = "invalid rocket search criteria provided"
+
+type InvalidRocketSearchCriteriaError struct {
+	domain.BaseError
+
+	reason string
+}
+
+func NewInvalidRocketSearchCriteriaError(reason string) *InvalidRocketSearchCriteriaError {
+	return &InvalidRocketSearchCriteriaError{
+		BaseError: domain.NewError(
+			invalidRocketSearchCriteriaErrorMessage,
+			errutil.WithMetadataKeyValue("rocket.search.reason", reason),
+		),
+		reason: reason,
+	}
+}
+
+func (e *InvalidRocketSearchCriteriaError) Error() string {
+	return invalidRocketSearchCriteriaErrorMessage + ": " + e.reason
+}
+
+func (e *InvalidRocketSearchCriteriaError) Reason() string {
+	return e.reason
+}
+
+func AsInvalidRocketSearchCriteriaError(err error) (*InvalidRocketSearchCriteriaError, bool) {
+	var self *InvalidRocketSearchCriteriaError
+	if errors.As(err, &self) {
+		return self, true
+	}
+
+	return nil, false
+}
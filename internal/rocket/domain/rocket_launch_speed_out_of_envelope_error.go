@@ -0,0 +1,48 @@
+package rocketdomain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const launchSpeedOutOfEnvelopeErrorMessage = "launch speed out of the rocket type limits"
+
+type LaunchSpeedOutOfEnvelopeError struct {
+	domain.BaseError
+
+	rocketType RocketType
+	speed      LaunchSpeed
+	envelope   SpeedEnvelope
+}
+
+func NewLaunchSpeedOutOfEnvelopeError(rocketType RocketType, speed LaunchSpeed, envelope SpeedEnvelope) *LaunchSpeedOutOfEnvelopeError {
+	return &LaunchSpeedOutOfEnvelopeError{
+		BaseError: domain.NewError(
+			launchSpeedOutOfEnvelopeErrorMessage,
+			errutil.WithMetadataKeyValue("rocket.type", rocketType.String()),
+			errutil.WithMetadataKeyValue("rocket.launch_speed", speed.String()),
+		),
+		rocketType: rocketType,
+		speed:      speed,
+		envelope:   envelope,
+	}
+}
+
+func (e *LaunchSpeedOutOfEnvelopeError) Error() string {
+	return fmt.Sprintf(
+		"%s: %s must fly between %s and %s, got %s",
+		launchSpeedOutOfEnvelopeErrorMessage,
+		e.rocketType,
+		e.envelope.Min,
+		e.envelope.Max,
+		e.speed,
+	)
+}
+
+func IsLaunchSpeedOutOfEnvelopeError(err error) bool {
+	var self *LaunchSpeedOutOfEnvelopeError
+	return errors.As(err, &self)
+}
@@ -0,0 +1,25 @@
+package rocketdomain
+
+import (
+	"context"
+	"time"
+)
+
+// RocketMessageRecord tells what happened to a received rocket message, Error is empty unless it failed.
+type RocketMessageRecord struct {
+	EventID    string
+	RocketID   string
+	ReceivedAt time.Time
+	Outcome    string
+	Error      string
+}
+
+// RocketMessageLedger keeps a record of every processed rocket message for a retention window.
+//
+//go:generate moq -pkg rocketdomainmock -out mock/rocket_message_ledger_moq.go . RocketMessageLedger
+type RocketMessageLedger interface {
+	// Record stores the record replacing any previous one of the same event.
+	Record(ctx context.Context, record RocketMessageRecord) error
+	// Find returns the record of the given event, RocketMessageNotFoundError when unknown or expired.
+	Find(ctx context.Context, eventID string) (RocketMessageRecord, error)
+}
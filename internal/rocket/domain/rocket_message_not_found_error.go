@@ -0,0 +1,32 @@
+package rocketdomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const rocketMessageNotFoundErrorMessage = "rocket message not found"
+
+type RocketMessageNotFoundError struct {
+	domain.BaseError
+}
+
+func NewRocketMessageNotFoundError(eventID string) *RocketMessageNotFoundError {
+	return &RocketMessageNotFoundError{
+		BaseError: domain.NewError(
+			rocketMessageNotFoundErrorMessage,
+			errutil.WithMetadataKeyValue("rocket.message.event_id", eventID),
+		),
+	}
+}
+
+func (e *RocketMessageNotFoundError) Error() string {
+	return rocketMessageNotFoundErrorMessage
+}
+
+func IsRocketMessageNotFoundError(err error) bool {
+	var self *RocketMessageNotFoundError
+	return errors.As(err, &self)
+}
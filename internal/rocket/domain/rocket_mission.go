@@ -5,6 +5,9 @@ import (
 	domainvalidation "github.com/soulcodex/rockets-message-processor/pkg/domain/validation"
 )
 
+// maxMissionLength matches the longest mission code the missions module accepts, so every rocket mission is countable.
+const maxMissionLength = 64
+
 var (
 	ErrInvalidMissionProvided = domain.NewError("invalid rocket mission provided")
 )
@@ -16,6 +19,7 @@ func NewMission(id string) (Mission, error) {
 
 	validation := domainvalidation.NewValidator(
 		domainvalidation.NotEmpty[string](),
+		domainvalidation.MaxLength(maxMissionLength),
 	)
 
 	if err := validation.Validate(id); err != nil {
@@ -0,0 +1,24 @@
+package rocketdomain
+
+import (
+	"context"
+)
+
+// MissionGuard tells whether rockets can be assigned to a mission, it's the rocket side view of the mission catalog.
+//
+//go:generate moq -pkg rocketdomainmock -out mock/rocket_mission_guard_moq.go . MissionGuard
+type MissionGuard interface {
+	// EnsureAssignable returns a MissionNotAssignableError when the mission can't take rockets.
+	EnsureAssignable(ctx context.Context, mission Mission) error
+}
+
+// PermissiveMissionGuard accepts any mission, it's used when missions aren't validated against the catalog.
+type PermissiveMissionGuard struct{}
+
+func NewPermissiveMissionGuard() PermissiveMissionGuard {
+	return PermissiveMissionGuard{}
+}
+
+func (PermissiveMissionGuard) EnsureAssignable(context.Context, Mission) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+package rocketdomain
+
+import (
+	"slices"
+	"time"
+)
+
+// MissionAssignment is a period a rocket flew a mission, effective from From (inclusive)
+// until To (exclusive), To stays nil while the rocket keeps flying it.
+type MissionAssignment struct {
+	Mission Mission
+	From    time.Time
+	To      *time.Time
+}
+
+// CoversAt reports whether the rocket was flying the mission at the given time.
+func (a MissionAssignment) CoversAt(at time.Time) bool {
+	return !at.Before(a.From) && (a.To == nil || at.Before(*a.To))
+}
+
+func (a MissionAssignment) closedAt(at time.Time) MissionAssignment {
+	a.To = &at
+	return a
+}
+
+// MissionHistory lists the missions assigned to a rocket sorted by the time they became effective,
+// only the last assignment can still be open.
+type MissionHistory []MissionAssignment
+
+func newMissionHistory(mission Mission, at time.Time) MissionHistory {
+	return MissionHistory{{Mission: mission, From: at}}
+}
+
+// Reassign closes the current assignment and opens a new one for the given mission, assigning
+// the mission being flown already keeps the history as it is.
+func (h MissionHistory) Reassign(mission Mission, at time.Time) MissionHistory {
+	current, open := h.current()
+	if open && current.Mission == mission {
+		return h
+	}
+
+	return append(h.Close(at), MissionAssignment{Mission: mission, From: at})
+}
+
+// Close ends the current assignment, if any, at the given time.
+func (h MissionHistory) Close(at time.Time) MissionHistory {
+	closed := slices.Clone(h)
+	if _, open := h.current(); open {
+		closed[len(closed)-1] = closed[len(closed)-1].closedAt(at)
+	}
+
+	return closed
+}
+
+// At returns the assignment effective at the given time, false when the rocket flew no mission then.
+func (h MissionHistory) At(at time.Time) (MissionAssignment, bool) {
+	for _, assignment := range h {
+		if assignment.CoversAt(at) {
+			return assignment, true
+		}
+	}
+
+	return MissionAssignment{}, false
+}
+
+func (h MissionHistory) current() (MissionAssignment, bool) {
+	if len(h) == 0 || h[len(h)-1].To != nil {
+		return MissionAssignment{}, false
+	}
+
+	return h[len(h)-1], true
+}
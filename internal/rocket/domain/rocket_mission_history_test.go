@@ -0,0 +1,52 @@
+package rocketdomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+func TestRocket_MissionHistory(t *testing.T) {
+	launchedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	reassignedAt, explodedAt := launchedAt.Add(time.Hour), launchedAt.Add(2*time.Hour)
+
+	rocket := rocketdomain.NewRocket("122c31b0-a3c4-411a-bc07-5f342f0d78e4", "Falcon 9", 5000, "ARTEMIS", launchedAt)
+	require.NoError(t, rocket.ChangeMission("LUNAR", reassignedAt))
+	require.NoError(t, rocket.ChangeMission("LUNAR", reassignedAt.Add(time.Minute)))
+	require.NoError(t, rocket.ChangeMission("ARTEMIS", launchedAt.Add(time.Minute)))
+	require.NoError(t, rocket.Delete(explodedAt))
+
+	history := rocket.MissionHistory()
+	require.Len(t, history, 2, "Expected repeated and out of order assignments to be ignored")
+	assert.Equal(t, rocketdomain.MissionAssignment{Mission: "ARTEMIS", From: launchedAt, To: &reassignedAt}, history[0])
+	assert.Equal(t, rocketdomain.MissionAssignment{Mission: "LUNAR", From: reassignedAt, To: &explodedAt}, history[1])
+
+	testCases := []struct {
+		name    string
+		at      time.Time
+		mission rocketdomain.Mission
+		found   bool
+	}{
+		{name: "before the launch", at: launchedAt.Add(-time.Second)},
+		{name: "at the launch", at: launchedAt, mission: "ARTEMIS", found: true},
+		{name: "at the reassignment", at: reassignedAt, mission: "LUNAR", found: true},
+		{name: "at the explosion", at: explodedAt},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assignment, found := history.At(tc.at)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.mission, assignment.Mission)
+		})
+	}
+
+	t.Run("should survive a round trip through primitives", func(t *testing.T) {
+		restored := rocketdomain.RocketFromPrimitives(rocket.Primitives())
+		assert.Equal(t, history, restored.MissionHistory())
+	})
+}
@@ -0,0 +1,46 @@
+package rocketdomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const missionNotAssignableErrorMessage = "mission can't be assigned to rockets"
+
+type MissionNotAssignableError struct {
+	domain.BaseError
+
+	mission Mission
+	reason  string
+}
+
+func NewMissionNotAssignableError(mission Mission, reason string) *MissionNotAssignableError {
+	return &MissionNotAssignableError{
+		BaseError: domain.NewError(
+			missionNotAssignableErrorMessage,
+			errutil.WithMetadataKeyValue("rocket.mission", mission.String()),
+			errutil.WithMetadataKeyValue("rocket.mission.reason", reason),
+		),
+		mission: mission,
+		reason:  reason,
+	}
+}
+
+func (e *MissionNotAssignableError) Error() string {
+	return missionNotAssignableErrorMessage + ": mission '" + e.mission.String() + "' " + e.reason
+}
+
+func (e *MissionNotAssignableError) Reason() string {
+	return e.reason
+}
+
+func AsMissionNotAssignableError(err error) (*MissionNotAssignableError, bool) {
+	var self *MissionNotAssignableError
+	if errors.As(err, &self) {
+		return self, true
+	}
+
+	return nil, false
+}
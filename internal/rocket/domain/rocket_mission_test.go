@@ -0,0 +1,37 @@
+package rocketdomain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+func TestNewMission(t *testing.T) {
+	tests := []struct {
+		name        string
+		mission     string
+		expectedErr bool
+	}{
+		{name: "should accept a mission code", mission: "ARTEMIS"},
+		{name: "should accept the longest mission code", mission: strings.Repeat("A", 64)},
+		{name: "should reject an empty mission", mission: "", expectedErr: true},
+		{name: "should reject a mission longer than any mission code", mission: strings.Repeat("A", 65), expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mission, err := rocketdomain.NewMission(tt.mission)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.mission, mission.String())
+		})
+	}
+}
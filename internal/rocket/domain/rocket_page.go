@@ -0,0 +1,32 @@
+package rocketdomain
+
+// RocketPage is a bounded slice of a rockets search alongside the information
+// needed to keep paginating from its last rocket.
+type RocketPage struct {
+	rockets    RocketCollection
+	hasMore    bool
+	nextCursor *RocketCursor
+}
+
+func NewRocketPage(criteria RocketSearchCriteria, hasMore bool, rockets ...*Rocket) RocketPage {
+	page := RocketPage{rockets: NewRocketCollection(rockets...), hasMore: hasMore}
+	if hasMore && len(rockets) > 0 {
+		cursor := NewRocketCursor(criteria.Sort, rockets[len(rockets)-1].Primitives())
+		page.nextCursor = &cursor
+	}
+
+	return page
+}
+
+func (p RocketPage) Rockets() RocketCollection {
+	return p.rockets
+}
+
+func (p RocketPage) HasMore() bool {
+	return p.hasMore
+}
+
+// NextCursor returns the cursor pointing to the last rocket of the page, nil when there are no more pages.
+func (p RocketPage) NextCursor() *RocketCursor {
+	return p.nextCursor
+}
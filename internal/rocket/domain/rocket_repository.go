@@ -2,11 +2,16 @@ package rocketdomain
 
 import (
 	"context"
+	"time"
 )
 
 //go:generate moq -pkg rocketdomainmock -out mock/rocket_repository_moq.go . RocketRepository
 type RocketRepository interface {
+	// Find returns the rocket whatever its status, exploded ones included.
 	Find(ctx context.Context, id RocketID) (*Rocket, error)
-	Search(ctx context.Context, sortBy string, asc bool) (RocketCollection, error)
+	Search(ctx context.Context, criteria RocketSearchCriteria) (RocketPage, error)
+	Stats(ctx context.Context, criteria RocketStatsCriteria) (RocketStatsReport, error)
+	// FindByMissionAt returns the rockets flying the given mission at the given time, exploded ones included.
+	FindByMissionAt(ctx context.Context, mission Mission, at time.Time) (RocketCollection, error)
 	Save(ctx context.Context, r *Rocket) error
 }
@@ -0,0 +1,185 @@
+package rocketdomain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/filter"
+)
+
+const (
+	DefaultRocketSearchLimit = 50
+	MaxRocketSearchLimit     = 500
+)
+
+var defaultRocketSort = RocketSort{{Field: "created_at", Asc: false}}
+
+type RocketSearchCriteriaOpt func(*RocketSearchCriteria)
+
+// RocketSearchCriteria holds the filters, sorting and paging applied when searching rockets.
+type RocketSearchCriteria struct {
+	Sort           RocketSort
+	Limit          int
+	After          *RocketCursor
+	RocketType     string
+	Mission        string
+	MissionPrefix  string
+	LaunchSpeedMin *int64
+	LaunchSpeedMax *int64
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+	UpdatedAfter   *time.Time
+	Expression     string
+	Filter         filter.Criteria
+}
+
+func NewRocketSearchCriteria(opts ...RocketSearchCriteriaOpt) (RocketSearchCriteria, error) {
+	criteria := RocketSearchCriteria{Limit: DefaultRocketSearchLimit}
+	for _, opt := range opts {
+		opt(&criteria)
+	}
+
+	if len(criteria.Sort) == 0 {
+		criteria.Sort = defaultRocketSort
+	}
+	criteria.Sort = criteria.Sort.WithTieBreaker()
+
+	if err := criteria.validatePaging(); err != nil {
+		return RocketSearchCriteria{}, err
+	}
+
+	if err := criteria.validateRanges(); err != nil {
+		return RocketSearchCriteria{}, err
+	}
+
+	if criteria.Expression != "" {
+		compiled, err := compileRocketFilter(criteria.Expression)
+		if err != nil {
+			return RocketSearchCriteria{}, err
+		}
+		criteria.Filter = compiled
+	}
+
+	return criteria, nil
+}
+
+// SortedBy orders the search by the given keys, the rocket ID is appended as tie-breaker when missing.
+func SortedBy(sort RocketSort) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		c.Sort = sort
+	}
+}
+
+// Paginated bounds the search to limit rockets placed after the given cursor, a zero limit keeps the default one.
+func Paginated(limit int, after *RocketCursor) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		if limit != 0 {
+			c.Limit = limit
+		}
+		c.After = after
+	}
+}
+
+func FilterByRocketType(rocketType string) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		c.RocketType = rocketType
+	}
+}
+
+func FilterByMission(mission string) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		c.Mission = mission
+	}
+}
+
+func FilterByMissionPrefix(prefix string) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		c.MissionPrefix = prefix
+	}
+}
+
+func FilterByLaunchSpeedRange(minSpeed, maxSpeed *int64) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		c.LaunchSpeedMin = minSpeed
+		c.LaunchSpeedMax = maxSpeed
+	}
+}
+
+func FilterByCreatedBetween(after, before *time.Time) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		c.CreatedAfter = after
+		c.CreatedBefore = before
+	}
+}
+
+func FilterByUpdatedAfter(after *time.Time) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		c.UpdatedAfter = after
+	}
+}
+
+// FilterByExpression narrows the search with a filter expression such as `launch_speed > 5000 and mission in ("ARTEMIS")`,
+// it is checked against RocketFilterSchema and exposed as a Filter criteria tree backends can push down.
+func FilterByExpression(expression string) RocketSearchCriteriaOpt {
+	return func(c *RocketSearchCriteria) {
+		c.Expression = expression
+	}
+}
+
+func (c RocketSearchCriteria) validatePaging() error {
+	if c.Limit < 1 || c.Limit > MaxRocketSearchLimit {
+		return NewInvalidRocketSearchCriteriaError(fmt.Sprintf("limit must be between 1 and %d", MaxRocketSearchLimit))
+	}
+
+	if c.After != nil && !c.After.MatchesSort(c.Sort) {
+		return NewInvalidRocketSearchCriteriaError("cursor does not match the requested sort")
+	}
+
+	return nil
+}
+
+func (c RocketSearchCriteria) validateRanges() error {
+	if c.LaunchSpeedMin != nil && c.LaunchSpeedMax != nil && *c.LaunchSpeedMin > *c.LaunchSpeedMax {
+		return NewInvalidRocketSearchCriteriaError("launch_speed_min cannot be greater than launch_speed_max")
+	}
+
+	if c.CreatedAfter != nil && c.CreatedBefore != nil && c.CreatedAfter.After(*c.CreatedBefore) {
+		return NewInvalidRocketSearchCriteriaError("created_after cannot be later than created_before")
+	}
+
+	return nil
+}
+
+// HasFilters reports whether any filter has been set, sorting and paging aside.
+func (c RocketSearchCriteria) HasFilters() bool {
+	byFields := c.RocketType != "" || c.Mission != "" || c.MissionPrefix != "" || c.Expression != ""
+	byRanges := c.LaunchSpeedMin != nil || c.LaunchSpeedMax != nil || c.CreatedAfter != nil || c.CreatedBefore != nil || c.UpdatedAfter != nil
+
+	return byFields || byRanges
+}
+
+// Matches reports whether the given rocket primitives satisfy every field filter of the criteria,
+// the Filter tree is left to the repositories so they can push it down to their storage.
+func (c RocketSearchCriteria) Matches(p RocketPrimitives) bool {
+	switch {
+	case c.RocketType != "" && p.RocketType != c.RocketType:
+		return false
+	case c.Mission != "" && p.Mission != c.Mission:
+		return false
+	case c.MissionPrefix != "" && !strings.HasPrefix(p.Mission, c.MissionPrefix):
+		return false
+	case c.LaunchSpeedMin != nil && p.LaunchSpeed < *c.LaunchSpeedMin:
+		return false
+	case c.LaunchSpeedMax != nil && p.LaunchSpeed > *c.LaunchSpeedMax:
+		return false
+	case c.CreatedAfter != nil && !p.CreatedAt.After(*c.CreatedAfter):
+		return false
+	case c.CreatedBefore != nil && !p.CreatedAt.Before(*c.CreatedBefore):
+		return false
+	case c.UpdatedAfter != nil && !p.UpdatedAt.After(*c.UpdatedAfter):
+		return false
+	default:
+		return true
+	}
+}
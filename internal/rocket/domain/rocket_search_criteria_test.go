@@ -0,0 +1,99 @@
+package rocketdomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rockettest "github.com/soulcodex/rockets-message-processor/test/rocket"
+)
+
+func TestRocketSearchCriteria_Matches(t *testing.T) {
+	now := time.Now()
+	rocket := rockettest.NewRocketMother(rockettest.WithLaunchSpeed(5000)).Build(t).Primitives()
+	int64Ptr := func(v int64) *int64 { return &v }
+	timePtr := func(v time.Time) *time.Time { return &v }
+
+	tests := []struct {
+		name     string
+		opts     []rocketdomain.RocketSearchCriteriaOpt
+		expected bool
+	}{
+		{
+			name:     "should match without filters",
+			expected: true,
+		},
+		{
+			name:     "should match by exact rocket type",
+			opts:     []rocketdomain.RocketSearchCriteriaOpt{rocketdomain.FilterByRocketType("Falcon 9")},
+			expected: true,
+		},
+		{
+			name:     "should not match by different rocket type",
+			opts:     []rocketdomain.RocketSearchCriteriaOpt{rocketdomain.FilterByRocketType("Falcon")},
+			expected: false,
+		},
+		{
+			name:     "should match by mission prefix",
+			opts:     []rocketdomain.RocketSearchCriteriaOpt{rocketdomain.FilterByMissionPrefix("ART")},
+			expected: true,
+		},
+		{
+			name:     "should not match by exact mission when only prefix matches",
+			opts:     []rocketdomain.RocketSearchCriteriaOpt{rocketdomain.FilterByMission("ART")},
+			expected: false,
+		},
+		{
+			name: "should match within launch speed range",
+			opts: []rocketdomain.RocketSearchCriteriaOpt{
+				rocketdomain.FilterByLaunchSpeedRange(int64Ptr(5000), int64Ptr(6000)),
+			},
+			expected: true,
+		},
+		{
+			name: "should not match over launch speed max",
+			opts: []rocketdomain.RocketSearchCriteriaOpt{
+				rocketdomain.FilterByLaunchSpeedRange(nil, int64Ptr(4999)),
+			},
+			expected: false,
+		},
+		{
+			name: "should match created between dates",
+			opts: []rocketdomain.RocketSearchCriteriaOpt{
+				rocketdomain.FilterByCreatedBetween(timePtr(now.Add(-time.Hour)), timePtr(now.Add(time.Hour))),
+			},
+			expected: true,
+		},
+		{
+			name: "should not match updated after a future date",
+			opts: []rocketdomain.RocketSearchCriteriaOpt{
+				rocketdomain.FilterByUpdatedAfter(timePtr(now.Add(time.Hour))),
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, err := rocketdomain.NewRocketSearchCriteria(tt.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, criteria.Matches(rocket))
+		})
+	}
+}
+
+func TestRocketSearchCriteria_InvalidRanges(t *testing.T) {
+	minSpeed, maxSpeed := int64(6000), int64(5000)
+	after, before := time.Now(), time.Now().Add(-time.Hour)
+
+	_, err := rocketdomain.NewRocketSearchCriteria(rocketdomain.FilterByLaunchSpeedRange(&minSpeed, &maxSpeed))
+	_, isCriteriaErr := rocketdomain.AsInvalidRocketSearchCriteriaError(err)
+	assert.True(t, isCriteriaErr)
+
+	_, err = rocketdomain.NewRocketSearchCriteria(rocketdomain.FilterByCreatedBetween(&after, &before))
+	_, isCriteriaErr = rocketdomain.AsInvalidRocketSearchCriteriaError(err)
+	assert.True(t, isCriteriaErr)
+}
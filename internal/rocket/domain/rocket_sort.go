@@ -0,0 +1,76 @@
+package rocketdomain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const RocketIDSortField = "id"
+
+// RocketSortableFields lists the rocket primitives a search can be sorted by.
+var RocketSortableFields = []string{"created_at", "updated_at", "launch_speed", "mission", "rocket_type", RocketIDSortField}
+
+type RocketSortKey struct {
+	Field string
+	Asc   bool
+}
+
+// RocketSort is an ordered list of sort keys, every key after the first one only breaks ties of the previous ones.
+type RocketSort []RocketSortKey
+
+// ParseRocketSort reads a comma separated list of fields such as `-launch_speed,created_at,id`,
+// a leading '-' sorts the field in descending order.
+func ParseRocketSort(raw string) (RocketSort, error) {
+	fields := strings.Split(raw, ",")
+	sort := make(RocketSort, 0, len(fields))
+
+	for _, field := range fields {
+		key := RocketSortKey{Field: strings.TrimSpace(field), Asc: true}
+		if strings.HasPrefix(key.Field, "-") {
+			key = RocketSortKey{Field: strings.TrimPrefix(key.Field, "-"), Asc: false}
+		}
+
+		if !slices.Contains(RocketSortableFields, key.Field) {
+			return nil, NewInvalidRocketSearchCriteriaError(fmt.Sprintf(
+				"unknown sort field '%s', allowed fields are: %s",
+				key.Field,
+				strings.Join(RocketSortableFields, ", "),
+			))
+		}
+
+		if sort.Has(key.Field) {
+			return nil, NewInvalidRocketSearchCriteriaError(fmt.Sprintf("sort field '%s' is repeated", key.Field))
+		}
+
+		sort = append(sort, key)
+	}
+
+	return sort, nil
+}
+
+// Has reports whether the given field is one of the sort keys.
+func (s RocketSort) Has(field string) bool {
+	return slices.ContainsFunc(s, func(key RocketSortKey) bool { return key.Field == field })
+}
+
+// WithTieBreaker appends the rocket ID as last key when missing, so the resulting order is total.
+func (s RocketSort) WithTieBreaker() RocketSort {
+	if s.Has(RocketIDSortField) {
+		return s
+	}
+
+	return append(slices.Clone(s), RocketSortKey{Field: RocketIDSortField, Asc: true})
+}
+
+func (s RocketSort) String() string {
+	fields := make([]string, len(s))
+	for i, key := range s {
+		fields[i] = key.Field
+		if !key.Asc {
+			fields[i] = "-" + key.Field
+		}
+	}
+
+	return strings.Join(fields, ",")
+}
@@ -0,0 +1,33 @@
+package rocketdomain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+func TestParseRocketSort(t *testing.T) {
+	sort, err := rocketdomain.ParseRocketSort("-launch_speed, created_at")
+	require.NoError(t, err)
+
+	assert.Equal(t, rocketdomain.RocketSort{
+		{Field: "launch_speed", Asc: false},
+		{Field: "created_at", Asc: true},
+	}, sort)
+	assert.Equal(t, "-launch_speed,created_at,id", sort.WithTieBreaker().String())
+	assert.Equal(t, "mission,-id", rocketdomain.RocketSort{
+		{Field: "mission", Asc: true},
+		{Field: "id", Asc: false},
+	}.WithTieBreaker().String())
+}
+
+func TestParseRocketSort_Invalid(t *testing.T) {
+	for _, raw := range []string{"", "speed", "-created_at,", "mission,-mission"} {
+		_, err := rocketdomain.ParseRocketSort(raw)
+		_, isCriteriaErr := rocketdomain.AsInvalidRocketSearchCriteriaError(err)
+		assert.True(t, isCriteriaErr, "expected %q to be rejected", raw)
+	}
+}
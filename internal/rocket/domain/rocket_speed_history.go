@@ -0,0 +1,154 @@
+package rocketdomain
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+var (
+	errSpeedRetentionDurations = errutil.NewError("invalid speed retention policy, durations must be positive")
+	errSpeedRetentionExpiry    = errutil.NewError("invalid speed retention policy, buckets can't expire before raw points")
+)
+
+// RocketSpeedHistory stores every applied launch speed change of a rocket as a time series.
+//
+//go:generate moq -pkg rocketdomainmock -out mock/rocket_speed_history_moq.go . RocketSpeedHistory
+type RocketSpeedHistory interface {
+	Record(ctx context.Context, id RocketID, point RocketSpeedPoint) error
+	// Range returns the raw points and compacted buckets placed within [from, to], both sorted by time.
+	Range(ctx context.Context, id RocketID, from, to time.Time) (RocketSpeedTimeline, error)
+	// Compact folds the raw points older than the policy allows into buckets and drops expired buckets.
+	Compact(ctx context.Context, policy RocketSpeedRetentionPolicy, now time.Time) error
+}
+
+type RocketSpeedPoint struct {
+	At            time.Time
+	Value         int64
+	Delta         int64
+	MessageNumber uint64
+}
+
+// RocketSpeedBucket summarises the points recorded within [From, From+Step).
+type RocketSpeedBucket struct {
+	From  time.Time
+	Step  time.Duration
+	Min   int64
+	Max   int64
+	Sum   int64
+	Count int
+}
+
+func newRocketSpeedBucket(from time.Time, step time.Duration, point RocketSpeedPoint) RocketSpeedBucket {
+	return RocketSpeedBucket{From: from, Step: step, Min: point.Value, Max: point.Value, Sum: point.Value, Count: 1}
+}
+
+func (b RocketSpeedBucket) Avg() float64 {
+	if b.Count == 0 {
+		return 0
+	}
+
+	return float64(b.Sum) / float64(b.Count)
+}
+
+// Merge combines both buckets keeping the start and width of the receiver.
+func (b RocketSpeedBucket) Merge(other RocketSpeedBucket) RocketSpeedBucket {
+	b.Min = min(b.Min, other.Min)
+	b.Max = max(b.Max, other.Max)
+	b.Sum += other.Sum
+	b.Count += other.Count
+
+	return b
+}
+
+// RocketSpeedTimeline is a slice of the speed history made of raw points and, for the
+// periods already compacted, the buckets summarising them.
+type RocketSpeedTimeline struct {
+	Points  []RocketSpeedPoint
+	Buckets []RocketSpeedBucket
+}
+
+// Downsample aggregates points and compacted buckets into buckets of the given step aligned to from,
+// compacted buckets wider than the step are accounted in the bucket their start falls in.
+func (t RocketSpeedTimeline) Downsample(from time.Time, step time.Duration) []RocketSpeedBucket {
+	buckets := make(map[time.Time]RocketSpeedBucket)
+	add := func(bucket RocketSpeedBucket) {
+		start := from.Add(bucket.From.Sub(from) / step * step)
+		bucket.From, bucket.Step = start, step
+		if existing, exists := buckets[start]; exists {
+			bucket = existing.Merge(bucket)
+		}
+		buckets[start] = bucket
+	}
+
+	for _, bucket := range t.Buckets {
+		add(bucket)
+	}
+
+	for _, point := range t.Points {
+		add(newRocketSpeedBucket(point.At, step, point))
+	}
+
+	return sortedRocketSpeedBuckets(buckets)
+}
+
+// CompactRocketSpeedPoints folds points into buckets of the given size aligned to the unix epoch.
+func CompactRocketSpeedPoints(points []RocketSpeedPoint, size time.Duration) []RocketSpeedBucket {
+	buckets := make(map[time.Time]RocketSpeedBucket)
+	for _, point := range points {
+		start := point.At.Truncate(size)
+		bucket := newRocketSpeedBucket(start, size, point)
+		if existing, exists := buckets[start]; exists {
+			bucket = existing.Merge(bucket)
+		}
+		buckets[start] = bucket
+	}
+
+	return sortedRocketSpeedBuckets(buckets)
+}
+
+func sortedRocketSpeedBuckets(buckets map[time.Time]RocketSpeedBucket) []RocketSpeedBucket {
+	sorted := make([]RocketSpeedBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		sorted = append(sorted, bucket)
+	}
+	slices.SortFunc(sorted, func(left, right RocketSpeedBucket) int { return left.From.Compare(right.From) })
+
+	return sorted
+}
+
+// RocketSpeedRetentionPolicy keeps raw points for RawFor, compacting older ones into buckets of
+// BucketSize which are dropped once older than KeepFor, a zero KeepFor keeps them forever.
+type RocketSpeedRetentionPolicy struct {
+	RawFor     time.Duration
+	BucketSize time.Duration
+	KeepFor    time.Duration
+}
+
+func NewRocketSpeedRetentionPolicy(rawFor, bucketSize, keepFor time.Duration) (RocketSpeedRetentionPolicy, error) {
+	if rawFor < 0 || bucketSize <= 0 || keepFor < 0 {
+		return RocketSpeedRetentionPolicy{}, errSpeedRetentionDurations
+	}
+
+	if keepFor != 0 && keepFor < rawFor {
+		return RocketSpeedRetentionPolicy{}, errSpeedRetentionExpiry
+	}
+
+	return RocketSpeedRetentionPolicy{RawFor: rawFor, BucketSize: bucketSize, KeepFor: keepFor}, nil
+}
+
+// RawCutoff returns the time before which raw points must be compacted.
+func (p RocketSpeedRetentionPolicy) RawCutoff(now time.Time) time.Time {
+	return now.Add(-p.RawFor).Truncate(p.BucketSize)
+}
+
+// BucketsCutoff returns the time before which buckets must be dropped, false when they never expire.
+func (p RocketSpeedRetentionPolicy) BucketsCutoff(now time.Time) (time.Time, bool) {
+	if p.KeepFor == 0 {
+		return time.Time{}, false
+	}
+
+	return now.Add(-p.KeepFor), true
+}
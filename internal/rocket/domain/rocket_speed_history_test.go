@@ -0,0 +1,82 @@
+package rocketdomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+func TestCompactRocketSpeedPoints(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	points := []rocketdomain.RocketSpeedPoint{
+		{At: start.Add(time.Minute), Value: 100},
+		{At: start.Add(2 * time.Minute), Value: 300},
+		{At: start.Add(7 * time.Minute), Value: 200},
+	}
+
+	buckets := rocketdomain.CompactRocketSpeedPoints(points, 5*time.Minute)
+	require.Len(t, buckets, 2)
+	assert.Equal(t, rocketdomain.RocketSpeedBucket{
+		From: start, Step: 5 * time.Minute, Min: 100, Max: 300, Sum: 400, Count: 2,
+	}, buckets[0])
+	assert.Equal(t, start.Add(5*time.Minute), buckets[1].From)
+	assert.InDelta(t, 200, buckets[1].Avg(), 0)
+}
+
+func TestRocketSpeedTimeline_Downsample(t *testing.T) {
+	from := time.Date(2025, 1, 1, 10, 0, 30, 0, time.UTC)
+	timeline := rocketdomain.RocketSpeedTimeline{
+		Buckets: []rocketdomain.RocketSpeedBucket{
+			{From: from.Add(30 * time.Second), Step: 5 * time.Minute, Min: 50, Max: 150, Sum: 200, Count: 2},
+		},
+		Points: []rocketdomain.RocketSpeedPoint{
+			{At: from.Add(9 * time.Minute), Value: 400},
+			{At: from.Add(11 * time.Minute), Value: 600},
+			{At: from.Add(19 * time.Minute), Value: 800},
+		},
+	}
+
+	buckets := timeline.Downsample(from, 10*time.Minute)
+	require.Len(t, buckets, 2)
+	assert.Equal(t, rocketdomain.RocketSpeedBucket{
+		From: from, Step: 10 * time.Minute, Min: 50, Max: 400, Sum: 600, Count: 3,
+	}, buckets[0])
+	assert.Equal(t, rocketdomain.RocketSpeedBucket{
+		From: from.Add(10 * time.Minute), Step: 10 * time.Minute, Min: 600, Max: 800, Sum: 1400, Count: 2,
+	}, buckets[1])
+}
+
+func TestNewRocketSpeedRetentionPolicy(t *testing.T) {
+	testCases := []struct {
+		name       string
+		rawFor     time.Duration
+		bucketSize time.Duration
+		keepFor    time.Duration
+		wantErr    bool
+	}{
+		{name: "valid policy", rawFor: time.Hour, bucketSize: time.Minute, keepFor: 24 * time.Hour},
+		{name: "buckets kept forever", rawFor: time.Hour, bucketSize: time.Minute},
+		{name: "empty bucket size", rawFor: time.Hour, wantErr: true},
+		{name: "negative raw retention", rawFor: -time.Hour, bucketSize: time.Minute, wantErr: true},
+		{name: "buckets expiring before raw points", rawFor: time.Hour, bucketSize: time.Minute, keepFor: time.Minute, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := rocketdomain.NewRocketSpeedRetentionPolicy(tc.rawFor, tc.bucketSize, tc.keepFor)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+
+	policy, err := rocketdomain.NewRocketSpeedRetentionPolicy(time.Hour, 5*time.Minute, 0)
+	require.NoError(t, err)
+
+	now := time.Date(2025, 1, 1, 10, 7, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 1, 1, 9, 5, 0, 0, time.UTC), policy.RawCutoff(now))
+	_, expire := policy.BucketsCutoff(now)
+	assert.False(t, expire)
+}
@@ -0,0 +1,187 @@
+package rocketdomain
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+)
+
+const (
+	percentileScale = 100
+	p50             = 50
+	p90             = 90
+	p95             = 95
+	p99             = 99
+)
+
+type RocketStatsGroupBy string
+
+const (
+	RocketStatsUngrouped         RocketStatsGroupBy = ""
+	RocketStatsGroupByRocketType RocketStatsGroupBy = "rocket_type"
+	RocketStatsGroupByMission    RocketStatsGroupBy = "mission"
+)
+
+// RocketStatsGroupings lists every field the fleet statistics can be grouped by.
+var RocketStatsGroupings = []RocketStatsGroupBy{RocketStatsGroupByRocketType, RocketStatsGroupByMission}
+
+// KeyOf returns the group the given rocket belongs to.
+func (g RocketStatsGroupBy) KeyOf(p RocketPrimitives) string {
+	switch g {
+	case RocketStatsGroupByRocketType:
+		return p.RocketType
+	case RocketStatsGroupByMission:
+		return p.Mission
+	case RocketStatsUngrouped:
+		return ""
+	default:
+		return ""
+	}
+}
+
+// RocketStatsCriteria narrows the fleet statistics with the same filters a search accepts,
+// sorting and paging of the filters are ignored.
+type RocketStatsCriteria struct {
+	GroupBy RocketStatsGroupBy
+	Filters RocketSearchCriteria
+}
+
+func NewRocketStatsCriteria(groupBy string, opts ...RocketSearchCriteriaOpt) (RocketStatsCriteria, error) {
+	grouping := RocketStatsGroupBy(groupBy)
+	if grouping != RocketStatsUngrouped && !slices.Contains(RocketStatsGroupings, grouping) {
+		return RocketStatsCriteria{}, NewInvalidRocketSearchCriteriaError(fmt.Sprintf(
+			"unknown group_by '%s', allowed values are: %s, %s",
+			groupBy,
+			RocketStatsGroupByRocketType,
+			RocketStatsGroupByMission,
+		))
+	}
+
+	filters, err := NewRocketSearchCriteria(opts...)
+	if err != nil {
+		return RocketStatsCriteria{}, err
+	}
+
+	return RocketStatsCriteria{GroupBy: grouping, Filters: filters}, nil
+}
+
+// RocketStatsReport holds the statistics of the whole (filtered) fleet and, when grouped, the ones of every group.
+type RocketStatsReport struct {
+	Overall RocketStats
+	Groups  map[string]RocketStats
+}
+
+// RocketStats describes a set of rockets, counts per rocket type and mission as well as
+// launch speed figures only take active rockets into account.
+type RocketStats struct {
+	Active       int
+	Exploded     int
+	ByRocketType map[string]int
+	ByMission    map[string]int
+	LaunchSpeed  LaunchSpeedStats
+}
+
+type LaunchSpeedStats struct {
+	Min int64
+	Max int64
+	Avg float64
+	P50 int64
+	P90 int64
+	P95 int64
+	P99 int64
+}
+
+// RocketStatsAccumulator keeps the statistics of a set of rockets up to date as rockets are added
+// and removed, so they can be read at any time without going through every rocket again.
+type RocketStatsAccumulator struct {
+	active       int
+	exploded     int
+	byRocketType map[string]int
+	byMission    map[string]int
+	speeds       []int64
+	speedsSum    int64
+}
+
+func NewRocketStatsAccumulator() *RocketStatsAccumulator {
+	return &RocketStatsAccumulator{
+		byRocketType: make(map[string]int),
+		byMission:    make(map[string]int),
+		speeds:       make([]int64, 0),
+	}
+}
+
+func (a *RocketStatsAccumulator) Add(p RocketPrimitives) {
+	if p.DeletedAt != nil {
+		a.exploded++
+		return
+	}
+
+	a.active++
+	a.byRocketType[p.RocketType]++
+	a.byMission[p.Mission]++
+	a.speedsSum += p.LaunchSpeed
+
+	idx, _ := slices.BinarySearch(a.speeds, p.LaunchSpeed)
+	a.speeds = slices.Insert(a.speeds, idx, p.LaunchSpeed)
+}
+
+// Remove takes out a rocket previously added, the primitives must be the same ones given to Add.
+func (a *RocketStatsAccumulator) Remove(p RocketPrimitives) {
+	if p.DeletedAt != nil {
+		a.exploded--
+		return
+	}
+
+	a.active--
+	decrement(a.byRocketType, p.RocketType)
+	decrement(a.byMission, p.Mission)
+	a.speedsSum -= p.LaunchSpeed
+
+	if idx, found := slices.BinarySearch(a.speeds, p.LaunchSpeed); found {
+		a.speeds = slices.Delete(a.speeds, idx, idx+1)
+	}
+}
+
+// IsEmpty reports whether every rocket added has been removed.
+func (a *RocketStatsAccumulator) IsEmpty() bool {
+	return a.active == 0 && a.exploded == 0
+}
+
+func (a *RocketStatsAccumulator) Stats() RocketStats {
+	stats := RocketStats{
+		Active:       a.active,
+		Exploded:     a.exploded,
+		ByRocketType: maps.Clone(a.byRocketType),
+		ByMission:    maps.Clone(a.byMission),
+	}
+
+	if len(a.speeds) == 0 {
+		return stats
+	}
+
+	stats.LaunchSpeed = LaunchSpeedStats{
+		Min: a.speeds[0],
+		Max: a.speeds[len(a.speeds)-1],
+		Avg: float64(a.speedsSum) / float64(len(a.speeds)),
+		P50: a.percentile(p50),
+		P90: a.percentile(p90),
+		P95: a.percentile(p95),
+		P99: a.percentile(p99),
+	}
+
+	return stats
+}
+
+// percentile follows the nearest-rank method over the sorted launch speeds.
+func (a *RocketStatsAccumulator) percentile(p float64) int64 {
+	rank := int(math.Ceil(p / percentileScale * float64(len(a.speeds))))
+	return a.speeds[max(rank-1, 0)]
+}
+
+func decrement(counts map[string]int, key string) {
+	counts[key]--
+	if counts[key] <= 0 {
+		delete(counts, key)
+	}
+}
@@ -0,0 +1,56 @@
+package rocketdomain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rockettest "github.com/soulcodex/rockets-message-processor/test/rocket"
+)
+
+func TestRocketStatsAccumulator(t *testing.T) {
+	accumulator := rocketdomain.NewRocketStatsAccumulator()
+	for speed := int64(100); speed <= 1000; speed += 100 {
+		accumulator.Add(rockettest.NewRocketMother(rockettest.WithLaunchSpeed(speed)).Build(t).Primitives())
+	}
+	exploded := rockettest.NewRocketMother(rockettest.WithSoftDeletion(), rockettest.WithMission("LUNAR")).Build(t).Primitives()
+	accumulator.Add(exploded)
+
+	stats := accumulator.Stats()
+	assert.Equal(t, 10, stats.Active)
+	assert.Equal(t, 1, stats.Exploded)
+	assert.Equal(t, map[string]int{"ARTEMIS": 10}, stats.ByMission)
+	assert.Equal(t, rocketdomain.LaunchSpeedStats{
+		Min: 100, Max: 1000, Avg: 550, P50: 500, P90: 900, P95: 1000, P99: 1000,
+	}, stats.LaunchSpeed)
+
+	t.Run("should forget removed rockets", func(t *testing.T) {
+		for speed := int64(100); speed <= 900; speed += 100 {
+			accumulator.Remove(rockettest.NewRocketMother(rockettest.WithLaunchSpeed(speed)).Build(t).Primitives())
+		}
+		accumulator.Remove(exploded)
+
+		stats = accumulator.Stats()
+		assert.Equal(t, 1, stats.Active)
+		assert.Zero(t, stats.Exploded)
+		assert.Equal(t, int64(1000), stats.LaunchSpeed.P50)
+		assert.InDelta(t, 1000, stats.LaunchSpeed.Avg, 0)
+
+		accumulator.Remove(rockettest.NewRocketMother(rockettest.WithLaunchSpeed(1000)).Build(t).Primitives())
+		assert.True(t, accumulator.IsEmpty())
+		assert.Empty(t, accumulator.Stats().ByMission)
+	})
+}
+
+func TestNewRocketStatsCriteria(t *testing.T) {
+	criteria, err := rocketdomain.NewRocketStatsCriteria("mission", rocketdomain.FilterByRocketType("Falcon 9"))
+	require.NoError(t, err)
+	assert.Equal(t, rocketdomain.RocketStatsGroupByMission, criteria.GroupBy)
+	assert.True(t, criteria.Filters.HasFilters())
+
+	_, err = rocketdomain.NewRocketStatsCriteria("launch_speed")
+	_, isCriteriaErr := rocketdomain.AsInvalidRocketSearchCriteriaError(err)
+	assert.True(t, isCriteriaErr)
+}
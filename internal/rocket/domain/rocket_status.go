@@ -0,0 +1,33 @@
+package rocketdomain
+
+import (
+	"slices"
+)
+
+type RocketStatus string
+
+const (
+	RocketStatusLaunched RocketStatus = "launched"
+	RocketStatusInFlight RocketStatus = "in_flight"
+	RocketStatusExploded RocketStatus = "exploded"
+)
+
+// rocketStatusTransitions declares the statuses a rocket can move to from each status, terminal statuses have none.
+var rocketStatusTransitions = map[RocketStatus][]RocketStatus{
+	RocketStatusLaunched: {RocketStatusInFlight, RocketStatusExploded},
+	RocketStatusInFlight: {RocketStatusInFlight, RocketStatusExploded},
+	RocketStatusExploded: {},
+}
+
+func (s RocketStatus) CanTransitionTo(next RocketStatus) bool {
+	return slices.Contains(rocketStatusTransitions[s], next)
+}
+
+// IsTerminal reports whether the rocket can't change anymore.
+func (s RocketStatus) IsTerminal() bool {
+	return len(rocketStatusTransitions[s]) == 0
+}
+
+func (s RocketStatus) String() string {
+	return string(s)
+}
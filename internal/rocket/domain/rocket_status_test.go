@@ -0,0 +1,82 @@
+package rocketdomain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+func TestRocketStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from    rocketdomain.RocketStatus
+		to      rocketdomain.RocketStatus
+		allowed bool
+	}{
+		{from: rocketdomain.RocketStatusLaunched, to: rocketdomain.RocketStatusInFlight, allowed: true},
+		{from: rocketdomain.RocketStatusLaunched, to: rocketdomain.RocketStatusExploded, allowed: true},
+		{from: rocketdomain.RocketStatusInFlight, to: rocketdomain.RocketStatusInFlight, allowed: true},
+		{from: rocketdomain.RocketStatusInFlight, to: rocketdomain.RocketStatusExploded, allowed: true},
+		{from: rocketdomain.RocketStatusInFlight, to: rocketdomain.RocketStatusLaunched, allowed: false},
+		{from: rocketdomain.RocketStatusExploded, to: rocketdomain.RocketStatusInFlight, allowed: false},
+		{from: rocketdomain.RocketStatusExploded, to: rocketdomain.RocketStatusExploded, allowed: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.allowed, tt.from.CanTransitionTo(tt.to), "transition %s -> %s", tt.from, tt.to)
+	}
+}
+
+func TestRocket_Lifecycle(t *testing.T) {
+	launchedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	rocket := rocketdomain.NewRocket("122c31b0-a3c4-411a-bc07-5f342f0d78e4", "Falcon 9", 5000, "ARTEMIS", launchedAt)
+	assert.Equal(t, rocketdomain.RocketStatusLaunched, rocket.Status())
+
+	require.NoError(t, rocket.ChangeLaunchSpeed(6000, launchedAt.Add(time.Minute)))
+	assert.Equal(t, rocketdomain.RocketStatusInFlight, rocket.Status())
+
+	require.NoError(t, rocket.ChangeLaunchSpeed(7000, launchedAt), "Expected out of order changes to be ignored")
+	assert.Equal(t, int64(6000), rocket.Primitives().LaunchSpeed)
+
+	require.NoError(t, rocket.Delete(launchedAt.Add(time.Hour)))
+	assert.Equal(t, rocketdomain.RocketStatusExploded, rocket.Status())
+
+	for name, change := range map[string]func() error{
+		"speed":   func() error { return rocket.ChangeLaunchSpeed(8000, launchedAt.Add(2*time.Hour)) },
+		"mission": func() error { return rocket.ChangeMission("LUNAR", launchedAt.Add(2*time.Hour)) },
+		"explode": func() error { return rocket.Delete(launchedAt.Add(2 * time.Hour)) },
+		"late":    func() error { return rocket.ChangeLaunchSpeed(8000, launchedAt.Add(30*time.Minute)) },
+	} {
+		transitionErr, illegal := rocketdomain.AsRocketTransitionNotAllowedError(change())
+		require.True(t, illegal, "Expected %s change on an exploded rocket to be rejected", name)
+		assert.Equal(t, rocketdomain.RocketStatusExploded, transitionErr.From())
+	}
+}
+
+func TestRocketFromPrimitives_LegacyStatus(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := createdAt.Add(time.Minute)
+
+	tests := []struct {
+		name       string
+		primitives rocketdomain.RocketPrimitives
+		expected   rocketdomain.RocketStatus
+	}{
+		{name: "unchanged", primitives: rocketdomain.RocketPrimitives{CreatedAt: createdAt, UpdatedAt: createdAt}, expected: rocketdomain.RocketStatusLaunched},
+		{name: "changed", primitives: rocketdomain.RocketPrimitives{CreatedAt: createdAt, UpdatedAt: updatedAt}, expected: rocketdomain.RocketStatusInFlight},
+		{
+			name:       "deleted",
+			primitives: rocketdomain.RocketPrimitives{CreatedAt: createdAt, UpdatedAt: updatedAt, DeletedAt: &updatedAt},
+			expected:   rocketdomain.RocketStatusExploded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rocketdomain.RocketFromPrimitives(tt.primitives).Status())
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package rocketdomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const rocketTransitionNotAllowedErrorMessage = "rocket status transition not allowed"
+
+type RocketTransitionNotAllowedError struct {
+	domain.BaseError
+
+	version uint64
+	from    RocketStatus
+	to      RocketStatus
+}
+
+func NewRocketTransitionNotAllowedError(id RocketID, version uint64, from, to RocketStatus) *RocketTransitionNotAllowedError {
+	return &RocketTransitionNotAllowedError{
+		BaseError: domain.NewError(
+			rocketTransitionNotAllowedErrorMessage,
+			errutil.WithMetadataKeyValue("rocket.id", id.String()),
+			errutil.WithMetadataKeyValue("rocket.status.from", from.String()),
+			errutil.WithMetadataKeyValue("rocket.status.to", to.String()),
+		),
+		version: version,
+		from:    from,
+		to:      to,
+	}
+}
+
+func (e *RocketTransitionNotAllowedError) Error() string {
+	return rocketTransitionNotAllowedErrorMessage + ": " + e.from.String() + " -> " + e.to.String()
+}
+
+// Version is the version of the rocket refusing the transition.
+func (e *RocketTransitionNotAllowedError) Version() uint64 {
+	return e.version
+}
+
+func (e *RocketTransitionNotAllowedError) From() RocketStatus {
+	return e.from
+}
+
+func (e *RocketTransitionNotAllowedError) To() RocketStatus {
+	return e.to
+}
+
+func AsRocketTransitionNotAllowedError(err error) (*RocketTransitionNotAllowedError, bool) {
+	var self *RocketTransitionNotAllowedError
+	if errors.As(err, &self) {
+		return self, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,42 @@
+package rocketdomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const rocketTypeAliasConflictErrorMessage = "rocket type name already taken"
+
+type RocketTypeAliasConflictError struct {
+	domain.BaseError
+
+	alias string
+	owner RocketType
+}
+
+func NewRocketTypeAliasConflictError(alias string, owner RocketType) *RocketTypeAliasConflictError {
+	return &RocketTypeAliasConflictError{
+		BaseError: domain.NewError(
+			rocketTypeAliasConflictErrorMessage,
+			errutil.WithMetadataKeyValue("rocket.type.alias", alias),
+			errutil.WithMetadataKeyValue("rocket.type", owner.String()),
+		),
+		alias: alias,
+		owner: owner,
+	}
+}
+
+func (e *RocketTypeAliasConflictError) Error() string {
+	return rocketTypeAliasConflictErrorMessage + ": '" + e.alias + "' belongs to '" + e.owner.String() + "'"
+}
+
+func AsRocketTypeAliasConflictError(err error) (*RocketTypeAliasConflictError, bool) {
+	var self *RocketTypeAliasConflictError
+	if errors.As(err, &self) {
+		return self, true
+	}
+
+	return nil, false
+}
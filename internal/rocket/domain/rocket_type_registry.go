@@ -0,0 +1,17 @@
+package rocketdomain
+
+import (
+	"context"
+)
+
+// RocketTypeRegistry is the catalog of rocket types.
+//
+//go:generate moq -pkg rocketdomainmock -out mock/rocket_type_registry_moq.go . RocketTypeRegistry
+type RocketTypeRegistry interface {
+	// Resolve finds the rocket type known by the given name or alias, UnknownRocketTypeError when missing.
+	Resolve(ctx context.Context, name string) (RocketTypeSpec, error)
+	// Register adds or replaces a rocket type, RocketTypeAliasConflictError when any of its names belongs to another one.
+	Register(ctx context.Context, spec RocketTypeSpec) error
+	// All returns every rocket type sorted by name.
+	All(ctx context.Context) ([]RocketTypeSpec, error)
+}
@@ -0,0 +1,97 @@
+package rocketdomain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type UnknownRocketTypePolicy string
+
+const (
+	RejectUnknownRocketTypes   UnknownRocketTypePolicy = "reject"
+	FlagUnknownRocketTypes     UnknownRocketTypePolicy = "flag"
+	RegisterUnknownRocketTypes UnknownRocketTypePolicy = "register"
+)
+
+// UnknownRocketTypePolicies lists every policy available for rocket types missing from the catalog.
+var UnknownRocketTypePolicies = []UnknownRocketTypePolicy{
+	RejectUnknownRocketTypes,
+	FlagUnknownRocketTypes,
+	RegisterUnknownRocketTypes,
+}
+
+func NewUnknownRocketTypePolicy(policy string) (UnknownRocketTypePolicy, error) {
+	if !slices.Contains(UnknownRocketTypePolicies, UnknownRocketTypePolicy(policy)) {
+		return "", fmt.Errorf("unknown rocket type policy '%s', allowed values are: %s", policy, strings.Join([]string{
+			string(RejectUnknownRocketTypes), string(FlagUnknownRocketTypes), string(RegisterUnknownRocketTypes),
+		}, ", "))
+	}
+
+	return UnknownRocketTypePolicy(policy), nil
+}
+
+// ResolvedRocketType is the rocket type a rocket is launched with, Unknown when it's missing
+// from the catalog and has been accepted anyway.
+type ResolvedRocketType struct {
+	Spec    RocketTypeSpec
+	Unknown bool
+}
+
+// RocketTypeResolver looks rocket types up in the catalog applying the policy for unknown ones.
+type RocketTypeResolver struct {
+	registry RocketTypeRegistry
+	policy   UnknownRocketTypePolicy
+}
+
+func NewRocketTypeResolver(registry RocketTypeRegistry, policy UnknownRocketTypePolicy) *RocketTypeResolver {
+	return &RocketTypeResolver{
+		registry: registry,
+		policy:   policy,
+	}
+}
+
+func (r *RocketTypeResolver) Resolve(ctx context.Context, name string) (ResolvedRocketType, error) {
+	spec, err := r.registry.Resolve(ctx, name)
+	switch {
+	case err == nil:
+		return ResolvedRocketType{Spec: spec}, nil
+	case !IsUnknownRocketTypeError(err):
+		return ResolvedRocketType{}, fmt.Errorf("failed to resolve rocket type: %w", err)
+	}
+
+	rocketType, typeErr := NewRocketType(name)
+	if typeErr != nil {
+		return ResolvedRocketType{}, typeErr
+	}
+
+	switch r.policy {
+	case FlagUnknownRocketTypes:
+		return ResolvedRocketType{Spec: newUnlimitedRocketTypeSpec(rocketType), Unknown: true}, nil
+	case RegisterUnknownRocketTypes:
+		spec = newUnlimitedRocketTypeSpec(rocketType)
+		if registerErr := r.registry.Register(ctx, spec); registerErr != nil {
+			return ResolvedRocketType{}, fmt.Errorf("failed to register rocket type: %w", registerErr)
+		}
+
+		return ResolvedRocketType{Spec: spec}, nil
+	case RejectUnknownRocketTypes:
+		return ResolvedRocketType{}, err
+	default:
+		return ResolvedRocketType{}, err
+	}
+}
+
+// Envelope returns the speed limits of the given rocket type, the default ones when it's missing from the catalog.
+func (r *RocketTypeResolver) Envelope(ctx context.Context, rocketType RocketType) (SpeedEnvelope, error) {
+	spec, err := r.registry.Resolve(ctx, rocketType.String())
+	switch {
+	case err == nil:
+		return spec.Envelope, nil
+	case IsUnknownRocketTypeError(err):
+		return DefaultSpeedEnvelope(), nil
+	default:
+		return SpeedEnvelope{}, fmt.Errorf("failed to resolve rocket type: %w", err)
+	}
+}
@@ -0,0 +1,168 @@
+package rocketdomain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rocketmock "github.com/soulcodex/rockets-message-processor/internal/rocket/domain/mock"
+)
+
+func falcon9RocketTypeSpec(t *testing.T) rocketdomain.RocketTypeSpec {
+	t.Helper()
+
+	spec, err := rocketdomain.NewRocketTypeSpec("Falcon-9", []string{"F9"}, 100, 30000)
+	require.NoError(t, err)
+
+	return spec
+}
+
+// rocketTypeRegistryMock resolves the given rocket types by any of their names, recording every registration.
+func rocketTypeRegistryMock(specs ...rocketdomain.RocketTypeSpec) *rocketmock.RocketTypeRegistryMock {
+	return &rocketmock.RocketTypeRegistryMock{
+		ResolveFunc: func(_ context.Context, name string) (rocketdomain.RocketTypeSpec, error) {
+			for _, spec := range specs {
+				for _, known := range spec.Names() {
+					if known == rocketdomain.NormalizeRocketTypeName(name) {
+						return spec, nil
+					}
+				}
+			}
+
+			return rocketdomain.RocketTypeSpec{}, rocketdomain.NewUnknownRocketTypeError(name)
+		},
+		RegisterFunc: func(_ context.Context, _ rocketdomain.RocketTypeSpec) error {
+			return nil
+		},
+	}
+}
+
+func TestNormalizeRocketTypeName(t *testing.T) {
+	for _, name := range []string{"Falcon-9", "Falcon 9", "falcon_9", "  FALCON -- 9 "} {
+		assert.Equal(t, "falcon 9", rocketdomain.NormalizeRocketTypeName(name), "Expected %q to be normalized", name)
+	}
+}
+
+func TestNewRocketTypeSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		aliases  []string
+		minSpeed int64
+		maxSpeed int64
+		valid    bool
+	}{
+		{name: "Falcon-9", aliases: []string{"F9"}, minSpeed: 0, maxSpeed: 30000, valid: true},
+		{name: "F9", minSpeed: 0, maxSpeed: 30000, valid: false},
+		{name: "Falcon-9", minSpeed: -1, maxSpeed: 30000, valid: false},
+		{name: "Falcon-9", minSpeed: 30000, maxSpeed: 100, valid: false},
+		{name: "Falcon-9", minSpeed: 0, maxSpeed: 1000001, valid: false},
+		{name: "Falcon-9", aliases: []string{" - "}, minSpeed: 0, maxSpeed: 30000, valid: false},
+	}
+
+	for _, tt := range tests {
+		_, err := rocketdomain.NewRocketTypeSpec(tt.name, tt.aliases, tt.minSpeed, tt.maxSpeed)
+		if tt.valid {
+			require.NoError(t, err)
+			continue
+		}
+
+		require.ErrorIs(t, err, rocketdomain.ErrInvalidRocketTypeSpecProvided)
+	}
+}
+
+func TestRocketTypeResolver_Resolve(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		rocketType     string
+		policy         rocketdomain.UnknownRocketTypePolicy
+		expectedName   rocketdomain.RocketType
+		expectedFlag   bool
+		expectRegister bool
+		expectUnknown  bool
+	}{
+		{name: "should resolve by alias", rocketType: "f9", policy: rocketdomain.RejectUnknownRocketTypes, expectedName: "Falcon-9"},
+		{name: "should resolve by spelling", rocketType: "Falcon 9", policy: rocketdomain.RejectUnknownRocketTypes, expectedName: "Falcon-9"},
+		{name: "should reject unknown", rocketType: "Saturn V", policy: rocketdomain.RejectUnknownRocketTypes, expectUnknown: true},
+		{name: "should flag unknown", rocketType: "Saturn V", policy: rocketdomain.FlagUnknownRocketTypes, expectedName: "Saturn V", expectedFlag: true},
+		{
+			name:           "should register unknown",
+			rocketType:     "Saturn V",
+			policy:         rocketdomain.RegisterUnknownRocketTypes,
+			expectedName:   "Saturn V",
+			expectRegister: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := rocketTypeRegistryMock(falcon9RocketTypeSpec(t))
+			resolved, err := rocketdomain.NewRocketTypeResolver(registry, tt.policy).Resolve(ctx, tt.rocketType)
+
+			if tt.expectUnknown {
+				require.True(t, rocketdomain.IsUnknownRocketTypeError(err), "Expected unknown rocket type error, got %v", err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, resolved.Spec.Name)
+			assert.Equal(t, tt.expectedFlag, resolved.Unknown)
+			assert.Len(t, registry.RegisterCalls(), map[bool]int{true: 1, false: 0}[tt.expectRegister])
+		})
+	}
+}
+
+func TestRocketCreator_CreateWithinSpeedEnvelope(t *testing.T) {
+	ctx := context.Background()
+	repo := &rocketmock.RocketRepositoryMock{
+		FindFunc: func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+			return nil, rocketdomain.NewRocketNotFoundError(id)
+		},
+		SaveFunc: func(_ context.Context, _ *rocketdomain.Rocket) error { return nil },
+	}
+	resolver := rocketdomain.NewRocketTypeResolver(rocketTypeRegistryMock(falcon9RocketTypeSpec(t)), rocketdomain.FlagUnknownRocketTypes)
+	creator := rocketdomain.NewRocketCreator(repo, rocketdomain.WithCreatorRocketTypes(resolver))
+
+	input := validRocketCreateParams()
+	input.RocketType = "falcon 9"
+	launch, err := creator.Create(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, "Falcon-9", launch.Rocket.Primitives().RocketType, "Expected the canonical rocket type name")
+	assert.False(t, launch.Rocket.HasUnknownType())
+
+	input.LaunchSpeed = 50
+	_, err = creator.Create(ctx, input)
+	assert.True(t, rocketdomain.IsLaunchSpeedOutOfEnvelopeError(err), "Expected launch speed out of envelope, got %v", err)
+
+	input.RocketType, input.LaunchSpeed = "Saturn V", 900000
+	launch, err = creator.Create(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, launch.Rocket.HasUnknownType(), "Expected unknown rocket types to be flagged")
+}
+
+func TestRocketUpdater_UpdateWithinSpeedEnvelope(t *testing.T) {
+	ctx := context.Background()
+	launchedAt := time.Now()
+	repo := &rocketmock.RocketRepositoryMock{
+		FindFunc: func(_ context.Context, id rocketdomain.RocketID) (*rocketdomain.Rocket, error) {
+			return rocketdomain.NewRocket(id, "Falcon-9", 29000, "ARTEMIS", launchedAt), nil
+		},
+		SaveFunc: func(_ context.Context, _ *rocketdomain.Rocket) error { return nil },
+	}
+	resolver := rocketdomain.NewRocketTypeResolver(rocketTypeRegistryMock(falcon9RocketTypeSpec(t)), rocketdomain.RejectUnknownRocketTypes)
+	updater := rocketdomain.NewRocketUpdater(repo, rocketdomain.WithUpdaterRocketTypes(resolver))
+	rocketID := validRocketCreateParams().ID
+
+	update, err := updater.Update(ctx, rocketID, rocketdomain.WithLaunchSpeedDelta(1000, launchedAt.Add(time.Second)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(30000), update.Rocket.Primitives().LaunchSpeed)
+
+	_, err = updater.Update(ctx, rocketID, rocketdomain.WithLaunchSpeedDelta(1001, launchedAt.Add(time.Second)))
+	assert.True(t, rocketdomain.IsLaunchSpeedOutOfEnvelopeError(err), "Expected launch speed out of envelope, got %v", err)
+	assert.Len(t, repo.SaveCalls(), 1, "Expected rejected updates not to be saved")
+}
@@ -0,0 +1,87 @@
+package rocketdomain
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+var (
+	ErrInvalidRocketTypeSpecProvided = domain.NewError("invalid rocket type spec provided")
+
+	errSpeedEnvelopeBounds = errutil.NewError("speed limits must be within 0 and the maximum launch speed, min not above max")
+	errRocketTypeAlias     = errutil.NewError("aliases must not be empty")
+)
+
+// SpeedEnvelope bounds the launch speed a rocket type can fly at, both ends included.
+type SpeedEnvelope struct {
+	Min LaunchSpeed
+	Max LaunchSpeed
+}
+
+// DefaultSpeedEnvelope applies to rocket types without limits of their own.
+func DefaultSpeedEnvelope() SpeedEnvelope {
+	return SpeedEnvelope{Min: 0, Max: maximumLaunchSpeed}
+}
+
+func (e SpeedEnvelope) Contains(speed LaunchSpeed) bool {
+	return speed >= e.Min && speed <= e.Max
+}
+
+// RocketTypeSpec describes a rocket type of the catalog, it's known by its canonical name and any
+// of its aliases, names are compared once normalized so `Falcon-9` and `falcon 9` are the same one.
+type RocketTypeSpec struct {
+	Name     RocketType
+	Aliases  []string
+	Envelope SpeedEnvelope
+}
+
+func NewRocketTypeSpec(name string, aliases []string, minSpeed, maxSpeed int64) (RocketTypeSpec, error) {
+	rocketType, err := NewRocketType(name)
+	if err != nil {
+		return RocketTypeSpec{}, ErrInvalidRocketTypeSpecProvided.Wrap(err)
+	}
+
+	if minSpeed < 0 || maxSpeed > maximumLaunchSpeed || minSpeed > maxSpeed {
+		return RocketTypeSpec{}, ErrInvalidRocketTypeSpecProvided.Wrap(errSpeedEnvelopeBounds)
+	}
+
+	if slices.ContainsFunc(aliases, func(alias string) bool { return NormalizeRocketTypeName(alias) == "" }) {
+		return RocketTypeSpec{}, ErrInvalidRocketTypeSpecProvided.Wrap(errRocketTypeAlias)
+	}
+
+	return RocketTypeSpec{
+		Name:     rocketType,
+		Aliases:  append(make([]string, 0, len(aliases)), aliases...),
+		Envelope: SpeedEnvelope{Min: LaunchSpeed(minSpeed), Max: LaunchSpeed(maxSpeed)},
+	}, nil
+}
+
+// newUnlimitedRocketTypeSpec describes a rocket type missing from the catalog.
+func newUnlimitedRocketTypeSpec(name RocketType) RocketTypeSpec {
+	return RocketTypeSpec{Name: name, Aliases: make([]string, 0), Envelope: DefaultSpeedEnvelope()}
+}
+
+// Names returns the normalized canonical name followed by the normalized aliases, without repetitions.
+func (s RocketTypeSpec) Names() []string {
+	names := []string{NormalizeRocketTypeName(s.Name.String())}
+	for _, alias := range s.Aliases {
+		if normalized := NormalizeRocketTypeName(alias); !slices.Contains(names, normalized) {
+			names = append(names, normalized)
+		}
+	}
+
+	return names
+}
+
+// NormalizeRocketTypeName lowercases the name and turns every run of separators into a single space.
+func NormalizeRocketTypeName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, " ")
+}
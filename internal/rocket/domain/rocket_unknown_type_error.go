@@ -0,0 +1,35 @@
+package rocketdomain
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const unknownRocketTypeErrorMessage = "unknown rocket type"
+
+type UnknownRocketTypeError struct {
+	domain.BaseError
+
+	name string
+}
+
+func NewUnknownRocketTypeError(name string) *UnknownRocketTypeError {
+	return &UnknownRocketTypeError{
+		BaseError: domain.NewError(
+			unknownRocketTypeErrorMessage,
+			errutil.WithMetadataKeyValue("rocket.type", name),
+		),
+		name: name,
+	}
+}
+
+func (e *UnknownRocketTypeError) Error() string {
+	return unknownRocketTypeErrorMessage + " '" + e.name + "'"
+}
+
+func IsUnknownRocketTypeError(err error) bool {
+	var self *UnknownRocketTypeError
+	return errors.As(err, &self)
+}
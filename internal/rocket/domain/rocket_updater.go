@@ -4,12 +4,29 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
 )
 
 type RocketUpdaterFunc func(rocket *Rocket) error
 
+// WithMessage tells the rocket the message the following changes come from, they are left out when the rocket
+// already applied it so a message redelivered after failing once it was saved never applies its changes twice.
+func WithMessage(number uint64) RocketUpdaterFunc {
+	return func(rocket *Rocket) error {
+		rocket.receiveMessage(number)
+		return nil
+	}
+}
+
+// WithLaunchSpeedDelta changes the launch speed by the given delta, a replayed delta is already part of the
+// launch speed so it only goes through the status checks.
 func WithLaunchSpeedDelta(delta int64, at time.Time) RocketUpdaterFunc {
 	return func(rocket *Rocket) error {
+		if rocket.replayed {
+			return rocket.ChangeLaunchSpeed(rocket.launchSpeed, at)
+		}
+
 		current := rocket.launchSpeed.Value()
 		newSpeedValue := current + delta
 
@@ -18,8 +35,11 @@ func WithLaunchSpeedDelta(delta int64, at time.Time) RocketUpdaterFunc {
 			return fmt.Errorf("invalid rocket launch speed after delta: %w", err)
 		}
 
-		rocket.ChangeLaunchSpeed(speed, at)
-		return nil
+		if envelope := rocket.SpeedEnvelope(); !envelope.Contains(speed) {
+			return NewLaunchSpeedOutOfEnvelopeError(rocket.rocketType, speed, envelope)
+		}
+
+		return rocket.ChangeLaunchSpeed(speed, at)
 	}
 }
 
@@ -29,29 +49,58 @@ func WithMission(mission string, at time.Time) RocketUpdaterFunc {
 		if err != nil {
 			return fmt.Errorf("invalid mission: %w", err)
 		}
-		rocket.ChangeMission(newMission, at)
-		return nil
+		return rocket.ChangeMission(newMission, at)
 	}
 }
 
 func WithSoftDeletion(at time.Time) RocketUpdaterFunc {
 	return func(rocket *Rocket) error {
-		rocket.Delete(at)
-		return nil
+		return rocket.Delete(at)
+	}
+}
+
+// RocketUpdate is the rocket resulting from an update, Applied is false when every change was
+// left out for being older than the last applied one or coming from an already applied message.
+type RocketUpdate struct {
+	Rocket  *Rocket
+	Applied bool
+}
+
+type RocketUpdaterOpt func(updater *RocketUpdater)
+
+// WithUpdaterRocketTypes holds rockets to the launch speed limits of their type in the rocket type catalog.
+func WithUpdaterRocketTypes(resolver *RocketTypeResolver) RocketUpdaterOpt {
+	return func(updater *RocketUpdater) {
+		updater.rocketTypes = resolver
+	}
+}
+
+// WithUpdaterEvents publishes the domain events raised by the rocket once it's saved.
+func WithUpdaterEvents(publisher domain.EventPublisher) RocketUpdaterOpt {
+	return func(updater *RocketUpdater) {
+		updater.publisher = publisher
 	}
 }
 
 type RocketUpdater struct {
-	repository RocketRepository
+	repository  RocketRepository
+	rocketTypes *RocketTypeResolver
+	publisher   domain.EventPublisher
 }
 
-func NewRocketUpdater(repository RocketRepository) *RocketUpdater {
-	return &RocketUpdater{
+func NewRocketUpdater(repository RocketRepository, opts ...RocketUpdaterOpt) *RocketUpdater {
+	updater := &RocketUpdater{
 		repository: repository,
 	}
+
+	for _, opt := range opts {
+		opt(updater)
+	}
+
+	return updater
 }
 
-func (r *RocketUpdater) Update(ctx context.Context, rocketID string, updates ...RocketUpdaterFunc) (*Rocket, error) {
+func (r *RocketUpdater) Update(ctx context.Context, rocketID string, updates ...RocketUpdaterFunc) (*RocketUpdate, error) {
 	id, err := NewRocketID(rocketID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid rocket id: %w", err)
@@ -62,6 +111,16 @@ func (r *RocketUpdater) Update(ctx context.Context, rocketID string, updates ...
 		return nil, fmt.Errorf("failed to find rocket: %w", err)
 	}
 
+	if r.rocketTypes != nil {
+		envelope, envelopeErr := r.rocketTypes.Envelope(ctx, rocket.rocketType)
+		if envelopeErr != nil {
+			return nil, fmt.Errorf("failed to find rocket speed limits: %w", envelopeErr)
+		}
+
+		rocket.constrainSpeed(envelope)
+	}
+
+	version := rocket.version
 	for _, update := range updates {
 		if updateErr := update(rocket); updateErr != nil {
 			return nil, fmt.Errorf("failed to apply rocket update: %w", updateErr)
@@ -72,5 +131,9 @@ func (r *RocketUpdater) Update(ctx context.Context, rocketID string, updates ...
 		return nil, fmt.Errorf("failed to update rocket: %w", saveErr)
 	}
 
-	return rocket, nil
+	if publishErr := publishRocketEvents(ctx, r.publisher, rocket); publishErr != nil {
+		return nil, publishErr
+	}
+
+	return &RocketUpdate{Rocket: rocket, Applied: rocket.version != version}, nil
 }
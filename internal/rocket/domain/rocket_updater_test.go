@@ -120,18 +120,18 @@ func TestRocketUpdater_Update(t *testing.T) {
 			}
 
 			updater := rocketdomain.NewRocketUpdater(repo)
-			rocket, err := updater.Update(ctx, tt.id, tt.updates...)
+			update, err := updater.Update(ctx, tt.id, tt.updates...)
 
 			if tt.expectedError == "" {
 				require.NoError(t, err)
-				require.NotNil(t, rocket)
+				require.NotNil(t, update)
 
 				if tt.validation != nil {
-					tt.validation(t, rocket)
+					tt.validation(t, update.Rocket)
 				}
 			} else {
 				require.Error(t, err)
-				require.Nil(t, rocket)
+				require.Nil(t, update)
 				assert.Contains(t, err.Error(), tt.expectedError)
 			}
 		})
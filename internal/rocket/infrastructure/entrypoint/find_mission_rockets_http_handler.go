@@ -0,0 +1,42 @@
+package rocketentrypoint
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+const atQueryParam = "at"
+
+func HandleFindMissionRocketsV1HTTP(
+	queryBus querybus.Bus,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := httpserver.NewQueryParamsReader(r.URL.Query())
+		missionRocketsQuery := &rocketqueries.FindMissionRocketsQuery{
+			Mission: mux.Vars(r)["mission"],
+			At:      params.Time(atQueryParam),
+		}
+
+		if params.HasErrors() {
+			responseWriter.WriteErrorResponse(r.Context(), w, params.Errors(), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := bus.DispatchWithResponse[*rocketqueries.FindMissionRocketsQuery, rocketqueries.MissionRocketsResponse](
+			queryBus,
+		)(r.Context(), missionRocketsQuery)
+		if err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+			return
+		}
+
+		responseWriter.WriteResponse(r.Context(), w, newMissionRocketsResponseV1(resp), http.StatusOK)
+	}
+}
@@ -0,0 +1,41 @@
+package rocketentrypoint
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+func HandleFindRocketMessageV1HTTP(
+	queryBus querybus.Bus,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		eventID := mux.Vars(r)["event_id"]
+		if eventID == "" {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"event_id is required"}, http.StatusBadRequest)
+			return
+		}
+
+		findQuery := &rocketqueries.FindRocketMessageQuery{EventID: eventID}
+
+		resp, err := bus.DispatchWithResponse[*rocketqueries.FindRocketMessageQuery, rocketqueries.RocketMessageResponse](
+			queryBus,
+		)(r.Context(), findQuery)
+
+		switch {
+		case err == nil:
+			responseWriter.WriteResponse(r.Context(), w, newRocketMessageResponseV1(resp), http.StatusOK)
+		case rocketdomain.IsRocketMessageNotFoundError(err):
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"rocket message not found"}, http.StatusNotFound)
+		default:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package rocketentrypoint
+
+import (
+	"time"
+
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+)
+
+type MissionAssignmentsResponseV1 []MissionAssignmentResponseV1
+
+type MissionAssignmentResponseV1 struct {
+	Mission       string     `json:"mission"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to"`
+}
+
+func newMissionAssignmentsResponseV1(assignments rocketqueries.MissionAssignmentsResponse) MissionAssignmentsResponseV1 {
+	response := make(MissionAssignmentsResponseV1, len(assignments))
+	for i, assignment := range assignments {
+		response[i] = MissionAssignmentResponseV1(assignment)
+	}
+
+	return response
+}
+
+type MissionRocketsResponseV1 []MissionRocketResponseV1
+
+type MissionRocketResponseV1 struct {
+	RocketID      string     `json:"rocket_id"`
+	RocketType    string     `json:"rocket_type"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to"`
+}
+
+func newMissionRocketsResponseV1(rockets rocketqueries.MissionRocketsResponse) MissionRocketsResponseV1 {
+	response := make(MissionRocketsResponseV1, len(rockets))
+	for i, rocket := range rockets {
+		response[i] = MissionRocketResponseV1{
+			RocketID:      rocket.RocketID,
+			RocketType:    rocket.RocketType,
+			EffectiveFrom: rocket.Assignment.EffectiveFrom,
+			EffectiveTo:   rocket.Assignment.EffectiveTo,
+		}
+	}
+
+	return response
+}
@@ -3,26 +3,45 @@ package rocketentrypoint
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	rocketevents "github.com/soulcodex/rockets-message-processor/internal/rocket/application/events"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
 	"github.com/soulcodex/rockets-message-processor/pkg/bus"
 	eventbus "github.com/soulcodex/rockets-message-processor/pkg/bus/event"
 	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
 	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
 	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
 	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
 )
 
+// messageInProgressRetryAfter is the retry hint of deliveries rejected because the same message is being processed.
+const messageInProgressRetryAfter = time.Second
+
+type rocketEventDispatchFunc = bus.DispatchWithOutputFunc[bus.BlockingDto, rocketevents.RocketEventReceipt]
+
 func HandleReceiveRocketMessageV1HTTP(
 	eventBus eventbus.Bus,
 	mutex distributedsync.MutexService,
 	deduplicator messaging.Deduplicator,
+	ledger rocketdomain.RocketMessageLedger,
+	timeProvider utils.DateTimeProvider,
+	logger logger.ZerologLogger,
 	responseWriter *httpserver.JSONResponseWriter,
+	dispatchOptions ...bus.DispatchBlockingOptFunc,
 ) http.HandlerFunc {
+	dispatch := bus.DispatchBlockingWithResponse[bus.BlockingDto, rocketevents.RocketEventReceipt](eventBus, mutex, dispatchOptions...)
+
 	return func(w http.ResponseWriter, r *http.Request) {
+		receivedAt := timeProvider.Now()
 		body, readErr := io.ReadAll(r.Body)
 		if readErr != nil {
 			responseWriter.WriteErrorResponse(
@@ -57,93 +76,180 @@ func HandleReceiveRocketMessageV1HTTP(
 			return
 		}
 
-		if handleErr := handleEventWithDeduplication(
+		receipt, handleErr := handleEventWithDeduplication(
 			r.Context(),
-			eventBus,
-			mutex,
+			dispatch,
 			deduplicator,
+			logger,
+			&raw,
 			rocketEvent,
-		); handleErr != nil {
-			responseWriter.WriteErrorResponse(
-				r.Context(),
-				w,
-				[]string{handleErr.Error()},
-				http.StatusInternalServerError,
-			)
-			return
+		)
+
+		// The message is already processed and its claim settled at this point, so failing to record it is only
+		// logged as answering with an error would get an applied message retried.
+		if recordErr := recordRocketMessage(r.Context(), ledger, &raw, receivedAt, receipt, handleErr); recordErr != nil {
+			logger.Error().Err(recordErr).Str("event_id", raw.EventID()).Msg("failed to record rocket message")
 		}
 
-		responseWriter.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+		writeRocketEventReceipt(r.Context(), w, responseWriter, &raw, receipt, handleErr)
 	}
 }
 
-func handleEventWithDeduplication(
+// writeRocketEventReceipt answers with the receipt of the processed event, events targeting exploded
+// rockets get a receipt as well but with a conflict status. Events targeting a rocket being changed,
+// or delivered while the same message is being processed, tell when to retry them.
+func writeRocketEventReceipt(
 	ctx context.Context,
-	eventBus eventbus.Bus,
-	mutex distributedsync.MutexService,
-	deduplicator messaging.Deduplicator,
-	rocketEvent rocketevents.RocketEvent,
-) error {
-	if deduplicator == nil {
-		return errutil.NewError("deduplicator is not configured")
+	w http.ResponseWriter,
+	responseWriter *httpserver.JSONResponseWriter,
+	raw *rocketevents.RocketEventRaw,
+	receipt rocketevents.RocketEventReceipt,
+	err error,
+) {
+	if rejected, match := rocketevents.NewRocketEventReceiptFromError(raw.EventID(), raw.Metadata.Channel, err); match {
+		responseWriter.WriteResponse(ctx, w, newRocketEventReceiptResponseV1(rejected), http.StatusConflict)
+		return
 	}
 
-	if err := checkIfRocketEventIsDuplicated(ctx, deduplicator, rocketEvent); err != nil {
-		return fmt.Errorf("duplicated check failed: %w", err)
+	if contended, match := distributedsync.AsMutexContendedError(err); match {
+		w.Header().Set(httpserver.HeaderRetryAfter, strconv.Itoa(retryAfterSeconds(contended.RetryAfter)))
 	}
 
-	if blockingDto, match := rocketEvent.(bus.BlockingDto); match {
-		if err := bus.DispatchBlocking(eventBus, mutex)(ctx, blockingDto); err != nil {
-			return fmt.Errorf("failed to dispatch blocking event: %w", err)
-		}
+	if messaging.IsMessageInProgressError(err) {
+		w.Header().Set(httpserver.HeaderRetryAfter, strconv.Itoa(retryAfterSeconds(messageInProgressRetryAfter)))
 	}
 
-	if err := markRocketEventAsProcessed(ctx, deduplicator, rocketEvent); err != nil {
-		return fmt.Errorf("failed to mark event as processed: %w", err)
+	if err != nil {
+		responseWriter.WriteErrorResponse(ctx, w, []string{err.Error()}, rocketEventFailureStatus(err))
+		return
 	}
 
-	return nil
+	responseWriter.WriteResponse(ctx, w, newRocketEventReceiptResponseV1(receipt), http.StatusOK)
 }
 
-func checkIfRocketEventIsDuplicated(
+// recordRocketMessage keeps the outcome of the received event in the ledger, duplicates and deliveries of events
+// being processed are left out so the record of the original delivery is kept, as well as events rejected because
+// their rocket was being changed, which were not processed at all.
+func recordRocketMessage(
 	ctx context.Context,
-	deduplicator messaging.Deduplicator,
-	rocketEvent rocketevents.RocketEvent,
+	ledger rocketdomain.RocketMessageLedger,
+	raw *rocketevents.RocketEventRaw,
+	receivedAt time.Time,
+	receipt rocketevents.RocketEventReceipt,
+	err error,
 ) error {
-	if deduplicator == nil {
-		return errutil.NewError("deduplicator is not configured")
+	if err == nil && receipt.Outcome == rocketevents.RocketEventDuplicate || messaging.IsMessageInProgressError(err) {
+		return nil
 	}
 
-	message, match := rocketEvent.(messaging.Message)
-	if !match {
-		return errutil.NewError("rocket event is not a message")
+	if _, contended := distributedsync.AsMutexContendedError(err); contended {
+		return nil
 	}
 
-	if duplicated, err := deduplicator.IsDuplicate(ctx, message); err == nil && !duplicated {
-		return nil
+	if recordErr := ledger.Record(ctx, rocketevents.NewRocketMessageRecord(raw, receivedAt, receipt, err)); recordErr != nil {
+		return fmt.Errorf("failed to record rocket message: %w", recordErr)
 	}
 
-	return fmt.Errorf("rocket event is duplicated: %s", message.Identifier())
+	return nil
 }
 
-func markRocketEventAsProcessed(
+// rocketEventFailureStatus tells apart events rejected by the rocket rules from unexpected failures.
+func rocketEventFailureStatus(err error) int {
+	if _, rejected := rocketdomain.AsMissionNotAssignableError(err); rejected {
+		return http.StatusUnprocessableEntity
+	}
+
+	if rocketdomain.IsUnknownRocketTypeError(err) || rocketdomain.IsLaunchSpeedOutOfEnvelopeError(err) {
+		return http.StatusUnprocessableEntity
+	}
+
+	if _, illegal := rocketdomain.AsRocketTransitionNotAllowedError(err); illegal {
+		return http.StatusConflict
+	}
+
+	if messaging.IsMessageInProgressError(err) {
+		return http.StatusConflict
+	}
+
+	if messaging.IsDeduplicatorUnavailableError(err) {
+		return http.StatusServiceUnavailable
+	}
+
+	if _, contended := distributedsync.AsMutexContendedError(err); contended {
+		return http.StatusLocked
+	}
+
+	return http.StatusInternalServerError
+}
+
+// retryAfterSeconds rounds the retry hint up to whole seconds, as the Retry-After header expects.
+func retryAfterSeconds(retryAfter time.Duration) int {
+	return max(1, int(math.Ceil(retryAfter.Seconds())))
+}
+
+func handleEventWithDeduplication(
 	ctx context.Context,
+	dispatch rocketEventDispatchFunc,
 	deduplicator messaging.Deduplicator,
+	logger logger.ZerologLogger,
+	raw *rocketevents.RocketEventRaw,
 	rocketEvent rocketevents.RocketEvent,
-) error {
+) (rocketevents.RocketEventReceipt, error) {
 	if deduplicator == nil {
-		return errutil.NewError("deduplicator is not configured")
+		return rocketevents.RocketEventReceipt{}, errutil.NewError("deduplicator is not configured")
 	}
 
 	message, match := rocketEvent.(messaging.Message)
 	if !match {
-		return errutil.NewError("rocket event is not a message")
+		return rocketevents.RocketEventReceipt{}, errutil.NewError("rocket event is not a message")
 	}
 
-	markErr := deduplicator.MarkProcessed(ctx, message)
-	if markErr != nil {
-		return fmt.Errorf("failed to mark rocket event as processed: %w", markErr)
+	blockingDto, match := rocketEvent.(bus.BlockingDto)
+	if !match {
+		return rocketevents.RocketEventReceipt{}, errutil.NewError("rocket event is not a blocking event")
 	}
 
-	return nil
+	claim, err := deduplicator.Claim(ctx, message)
+	if err != nil {
+		return rocketevents.RocketEventReceipt{}, fmt.Errorf("failed to claim rocket event: %w", err)
+	}
+
+	switch claim.Status {
+	case messaging.ClaimDuplicate:
+		// Duplicates were already processed, so they are answered as a successful no-op.
+		return rocketevents.NewDuplicateRocketEventReceipt(raw.EventID(), raw.Metadata.Channel), nil
+	case messaging.ClaimInProgress:
+		return rocketevents.RocketEventReceipt{}, messaging.NewMessageInProgressError(message)
+	case messaging.ClaimAcquired:
+		return dispatchClaimedRocketEvent(ctx, dispatch, deduplicator, logger, message, claim, blockingDto)
+	default:
+		return rocketevents.RocketEventReceipt{}, errutil.NewError("unexpected rocket event claim status")
+	}
+}
+
+// dispatchClaimedRocketEvent commits the claim once the event is processed, or releases it when processing
+// failed so the event can be retried. Failing to commit is only logged as the event is already applied, a
+// delivery processing it again being left out by the rocket.
+func dispatchClaimedRocketEvent(
+	ctx context.Context,
+	dispatch rocketEventDispatchFunc,
+	deduplicator messaging.Deduplicator,
+	logger logger.ZerologLogger,
+	message messaging.Message,
+	claim messaging.Claim,
+	blockingDto bus.BlockingDto,
+) (rocketevents.RocketEventReceipt, error) {
+	receipt, err := dispatch(ctx, blockingDto)
+	if err != nil {
+		return rocketevents.RocketEventReceipt{}, fmt.Errorf(
+			"failed to dispatch blocking event: %w",
+			errors.Join(err, deduplicator.Release(ctx, message, claim)),
+		)
+	}
+
+	if commitErr := deduplicator.Commit(ctx, message, claim); commitErr != nil {
+		logger.Error().Err(commitErr).Str("event_id", message.Identifier()).Msg("failed to commit rocket event")
+	}
+
+	return receipt, nil
 }
@@ -0,0 +1,23 @@
+package rocketentrypoint
+
+import (
+	rocketevents "github.com/soulcodex/rockets-message-processor/internal/rocket/application/events"
+)
+
+type RocketEventReceiptResponseV1 struct {
+	EventID       string `json:"event_id"`
+	Outcome       string `json:"outcome"`
+	RocketID      string `json:"rocket_id"`
+	RocketVersion uint64 `json:"rocket_version,omitempty"`
+	RocketStatus  string `json:"rocket_status,omitempty"`
+}
+
+func newRocketEventReceiptResponseV1(receipt rocketevents.RocketEventReceipt) RocketEventReceiptResponseV1 {
+	return RocketEventReceiptResponseV1{
+		EventID:       receipt.EventID,
+		Outcome:       string(receipt.Outcome),
+		RocketID:      receipt.RocketID,
+		RocketVersion: receipt.RocketVersion,
+		RocketStatus:  receipt.RocketStatus,
+	}
+}
@@ -0,0 +1,34 @@
+package rocketentrypoint
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+// HandleRocketMessageGapsV1HTTP reports the message numbers of the rocket that were never processed, every rocket
+// being the channel its messages are numbered in.
+func HandleRocketMessageGapsV1HTTP(
+	gaps messaging.SequenceGapsFinder,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rocketID := mux.Vars(r)["rocket_id"]
+		if err := utils.GuardUUID(rocketID); err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"invalid rocket_id format"}, http.StatusBadRequest)
+			return
+		}
+
+		numbers, err := gaps.Gaps(r.Context(), rocketID)
+		if err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+			return
+		}
+
+		responseWriter.WriteResponse(r.Context(), w, RocketMessageGapsResponseV1{RocketID: rocketID, Gaps: numbers}, http.StatusOK)
+	}
+}
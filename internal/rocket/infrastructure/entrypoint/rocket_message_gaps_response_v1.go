@@ -0,0 +1,6 @@
+package rocketentrypoint
+
+type RocketMessageGapsResponseV1 struct {
+	RocketID string   `json:"rocket_id"`
+	Gaps     []uint64 `json:"gaps"`
+}
@@ -0,0 +1,31 @@
+package rocketentrypoint
+
+import (
+	"time"
+
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+)
+
+type RocketMessageResponseV1 struct {
+	EventID    string    `json:"event_id"`
+	RocketID   string    `json:"rocket_id"`
+	ReceivedAt time.Time `json:"received_at"`
+	Outcome    string    `json:"outcome"`
+	Error      *string   `json:"error"`
+}
+
+func newRocketMessageResponseV1(message rocketqueries.RocketMessageResponse) RocketMessageResponseV1 {
+	response := RocketMessageResponseV1{
+		EventID:    message.EventID,
+		RocketID:   message.RocketID,
+		ReceivedAt: message.ReceivedAt,
+		Outcome:    message.Outcome,
+		Error:      nil,
+	}
+
+	if message.Error != "" {
+		response.Error = &message.Error
+	}
+
+	return response
+}
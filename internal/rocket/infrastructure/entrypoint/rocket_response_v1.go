@@ -6,6 +6,25 @@ import (
 	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
 )
 
+type RocketsPageResponseV1 struct {
+	Data       RocketsResponseV1 `json:"data"`
+	NextCursor *string           `json:"next_cursor"`
+	HasMore    bool              `json:"has_more"`
+}
+
+func newRocketsPageResponseV1(page rocketqueries.RocketsPageResponse) RocketsPageResponseV1 {
+	response := RocketsPageResponseV1{
+		Data:    newRocketsResponseV1(page.Rockets),
+		HasMore: page.HasMore,
+	}
+
+	if page.NextCursor != "" {
+		response.NextCursor = &page.NextCursor
+	}
+
+	return response
+}
+
 type RocketsResponseV1 []*RocketResponseV1
 
 func newRocketsResponseV1(rockets rocketqueries.RocketsResponse) RocketsResponseV1 {
@@ -22,7 +41,9 @@ func newRocketsResponseV1(rockets rocketqueries.RocketsResponse) RocketsResponse
 type RocketResponseV1 struct {
 	ID          string    `json:"id"`
 	RocketType  string    `json:"rocket_type"`
+	UnknownType bool      `json:"unknown_type"`
 	LaunchSpeed int64     `json:"launch_speed"`
+	Status      string    `json:"status"`
 	Mission     string    `json:"mission"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
@@ -32,7 +53,9 @@ func newRocketResponseV1(r rocketqueries.RocketResponse) RocketResponseV1 {
 	return RocketResponseV1{
 		ID:          r.ID,
 		RocketType:  r.RocketType,
+		UnknownType: r.UnknownType,
 		LaunchSpeed: r.LaunchSpeed,
+		Status:      r.Status,
 		Mission:     r.Mission,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
@@ -0,0 +1,63 @@
+package rocketentrypoint
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+const (
+	fromQueryParam = "from"
+	toQueryParam   = "to"
+	stepQueryParam = "step"
+)
+
+func HandleRocketSpeedHistoryV1HTTP(
+	queryBus querybus.Bus,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rocketID := mux.Vars(r)["rocket_id"]
+		if err := utils.GuardUUID(rocketID); err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"invalid rocket_id format"}, http.StatusBadRequest)
+			return
+		}
+
+		params := httpserver.NewQueryParamsReader(r.URL.Query())
+		historyQuery := &rocketqueries.FindRocketSpeedHistoryQuery{
+			RocketID: rocketID,
+			From:     params.Time(fromQueryParam),
+			To:       params.Time(toQueryParam),
+			Step:     params.Duration(stepQueryParam),
+		}
+
+		if historyQuery.From != nil && historyQuery.To != nil && historyQuery.From.After(*historyQuery.To) {
+			params.AddError("from must not be after to")
+		}
+
+		if historyQuery.Step != nil && *historyQuery.Step <= 0 {
+			params.AddError("step must be a positive duration")
+		}
+
+		if params.HasErrors() {
+			responseWriter.WriteErrorResponse(r.Context(), w, params.Errors(), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := bus.DispatchWithResponse[*rocketqueries.FindRocketSpeedHistoryQuery, rocketqueries.RocketSpeedHistoryResponse](
+			queryBus,
+		)(r.Context(), historyQuery)
+		if err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+			return
+		}
+
+		responseWriter.WriteResponse(r.Context(), w, newRocketSpeedHistoryResponseV1(resp), http.StatusOK)
+	}
+}
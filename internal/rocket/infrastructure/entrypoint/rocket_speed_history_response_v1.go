@@ -0,0 +1,45 @@
+package rocketentrypoint
+
+import (
+	"time"
+
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+)
+
+type RocketSpeedHistoryResponseV1 struct {
+	Points  []RocketSpeedPointResponseV1  `json:"points"`
+	Buckets []RocketSpeedBucketResponseV1 `json:"buckets"`
+}
+
+type RocketSpeedPointResponseV1 struct {
+	At            time.Time `json:"at"`
+	Value         int64     `json:"value"`
+	Delta         int64     `json:"delta"`
+	MessageNumber uint64    `json:"message_number"`
+}
+
+type RocketSpeedBucketResponseV1 struct {
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+	Min   int64     `json:"min"`
+	Max   int64     `json:"max"`
+	Avg   float64   `json:"avg"`
+	Count int       `json:"count"`
+}
+
+func newRocketSpeedHistoryResponseV1(history rocketqueries.RocketSpeedHistoryResponse) RocketSpeedHistoryResponseV1 {
+	response := RocketSpeedHistoryResponseV1{
+		Points:  make([]RocketSpeedPointResponseV1, len(history.Points)),
+		Buckets: make([]RocketSpeedBucketResponseV1, len(history.Buckets)),
+	}
+
+	for i, point := range history.Points {
+		response.Points[i] = RocketSpeedPointResponseV1(point)
+	}
+
+	for i, bucket := range history.Buckets {
+		response.Buckets[i] = RocketSpeedBucketResponseV1(bucket)
+	}
+
+	return response
+}
@@ -0,0 +1,46 @@
+package rocketentrypoint
+
+import (
+	"net/http"
+
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+const groupByQueryParam = "group_by"
+
+func HandleRocketStatsV1HTTP(
+	queryBus querybus.Bus,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := httpserver.NewQueryParamsReader(r.URL.Query())
+		statsQuery := &rocketqueries.RocketStatsQuery{
+			GroupBy:       params.String(groupByQueryParam, ""),
+			RocketFilters: newRocketFilters(params),
+		}
+
+		if params.HasErrors() {
+			responseWriter.WriteErrorResponse(r.Context(), w, params.Errors(), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := bus.DispatchWithResponse[*rocketqueries.RocketStatsQuery, rocketqueries.RocketStatsReportResponse](
+			queryBus,
+		)(r.Context(), statsQuery)
+
+		criteriaErr, invalidCriteria := rocketdomain.AsInvalidRocketSearchCriteriaError(err)
+
+		switch {
+		case err == nil:
+			responseWriter.WriteResponse(r.Context(), w, newRocketStatsReportResponseV1(resp), http.StatusOK)
+		case invalidCriteria:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{criteriaErr.Reason()}, http.StatusBadRequest)
+		default:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package rocketentrypoint
+
+import (
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+)
+
+type RocketStatsReportResponseV1 struct {
+	Overall RocketStatsResponseV1        `json:"overall"`
+	GroupBy *string                      `json:"group_by"`
+	Groups  []RocketStatsGroupResponseV1 `json:"groups"`
+}
+
+func newRocketStatsReportResponseV1(report rocketqueries.RocketStatsReportResponse) RocketStatsReportResponseV1 {
+	response := RocketStatsReportResponseV1{
+		Overall: newRocketStatsResponseV1(report.Overall),
+		Groups:  make([]RocketStatsGroupResponseV1, len(report.Groups)),
+	}
+
+	if report.GroupBy != "" {
+		response.GroupBy = &report.GroupBy
+	}
+
+	for i, group := range report.Groups {
+		response.Groups[i] = RocketStatsGroupResponseV1{
+			Key:                   group.Key,
+			RocketStatsResponseV1: newRocketStatsResponseV1(group.Stats),
+		}
+	}
+
+	return response
+}
+
+type RocketStatsGroupResponseV1 struct {
+	Key string `json:"key"`
+	RocketStatsResponseV1
+}
+
+type RocketStatsResponseV1 struct {
+	Active       int                        `json:"active"`
+	Exploded     int                        `json:"exploded"`
+	ByRocketType map[string]int             `json:"by_rocket_type"`
+	ByMission    map[string]int             `json:"by_mission"`
+	LaunchSpeed  LaunchSpeedStatsResponseV1 `json:"launch_speed"`
+}
+
+func newRocketStatsResponseV1(stats rocketqueries.RocketStatsResponse) RocketStatsResponseV1 {
+	return RocketStatsResponseV1{
+		Active:       stats.Active,
+		Exploded:     stats.Exploded,
+		ByRocketType: stats.ByRocketType,
+		ByMission:    stats.ByMission,
+		LaunchSpeed:  LaunchSpeedStatsResponseV1(stats.LaunchSpeed),
+	}
+}
+
+type LaunchSpeedStatsResponseV1 struct {
+	Min int64   `json:"min"`
+	Max int64   `json:"max"`
+	Avg float64 `json:"avg"`
+	P50 int64   `json:"p50"`
+	P90 int64   `json:"p90"`
+	P95 int64   `json:"p95"`
+	P99 int64   `json:"p99"`
+}
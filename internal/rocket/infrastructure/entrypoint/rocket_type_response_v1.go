@@ -0,0 +1,23 @@
+package rocketentrypoint
+
+import (
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+)
+
+type RocketTypesResponseV1 []RocketTypeResponseV1
+
+type RocketTypeResponseV1 struct {
+	Name     string   `json:"name"`
+	Aliases  []string `json:"aliases"`
+	MinSpeed int64    `json:"min_speed"`
+	MaxSpeed int64    `json:"max_speed"`
+}
+
+func newRocketTypesResponseV1(rocketTypes rocketqueries.RocketTypesResponse) RocketTypesResponseV1 {
+	response := make(RocketTypesResponseV1, len(rocketTypes))
+	for i, rocketType := range rocketTypes {
+		response[i] = RocketTypeResponseV1(rocketType)
+	}
+
+	return response
+}
@@ -0,0 +1,68 @@
+package rocketentrypoint
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+type registerRocketTypeRequestV1 struct {
+	Aliases  []string `json:"aliases"`
+	MinSpeed int64    `json:"min_speed"`
+	MaxSpeed int64    `json:"max_speed"`
+}
+
+func HandleRegisterRocketTypeV1HTTP(
+	registry rocketdomain.RocketTypeRegistry,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request registerRocketTypeRequestV1
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{"failed to parse request body"}, http.StatusBadRequest)
+			return
+		}
+
+		spec, err := rocketdomain.NewRocketTypeSpec(mux.Vars(r)["rocket_type"], request.Aliases, request.MinSpeed, request.MaxSpeed)
+		if err == nil {
+			err = registry.Register(r.Context(), spec)
+		}
+
+		_, conflict := rocketdomain.AsRocketTypeAliasConflictError(err)
+		switch {
+		case err == nil:
+			responseWriter.WriteResponse(r.Context(), w, nil, http.StatusNoContent)
+		case errors.Is(err, rocketdomain.ErrInvalidRocketTypeSpecProvided):
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusBadRequest)
+		case conflict:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusConflict)
+		default:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+		}
+	}
+}
+
+func HandleListRocketTypesV1HTTP(
+	queryBus querybus.Bus,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := bus.DispatchWithResponse[*rocketqueries.ListRocketTypesQuery, rocketqueries.RocketTypesResponse](
+			queryBus,
+		)(r.Context(), &rocketqueries.ListRocketTypesQuery{})
+		if err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+			return
+		}
+
+		responseWriter.WriteResponse(r.Context(), w, newRocketTypesResponseV1(resp), http.StatusOK)
+	}
+}
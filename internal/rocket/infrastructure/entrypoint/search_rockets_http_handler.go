@@ -1,36 +1,64 @@
 package rocketentrypoint
 
 import (
+	"fmt"
 	"net/http"
-	"strings"
 
 	rocketqueries "github.com/soulcodex/rockets-message-processor/internal/rocket/application/queries"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
 	"github.com/soulcodex/rockets-message-processor/pkg/bus"
 	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
 	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
 )
 
 const (
-	sortQueryParam = "sort"
-	defaultSort    = "-created_at"
+	sortQueryParam           = "sort"
+	defaultSort              = "-created_at"
+	limitQueryParam          = "limit"
+	cursorQueryParam         = "cursor"
+	rocketTypeQueryParam     = "rocket_type"
+	missionQueryParam        = "mission"
+	missionPrefixQueryParam  = "mission_prefix"
+	launchSpeedMinQueryParam = "launch_speed_min"
+	launchSpeedMaxQueryParam = "launch_speed_max"
+	createdAfterQueryParam   = "created_after"
+	createdBeforeQueryParam  = "created_before"
+	updatedAfterQueryParam   = "updated_after"
+	filterQueryParam         = "filter"
 )
 
-type SortParams struct {
-	Sort string
-	Asc  bool
-}
+func newSearchRocketsQuery(r *http.Request) (*rocketqueries.SearchRocketsQuery, []string) {
+	params := httpserver.NewQueryParamsReader(r.URL.Query())
 
-func newSortParams(r *http.Request) SortParams {
-	sort, asc := httpserver.FetchStringQueryParamValue(r.URL.Query(), sortQueryParam, defaultSort), true
+	var limit int
+	if rawLimit := params.Int64(limitQueryParam); rawLimit != nil {
+		limit = int(*rawLimit)
+		if limit < 1 {
+			params.AddError(limitQueryParam + " must be a positive integer")
+		}
+	}
 
-	if strings.HasPrefix(sort, "-") {
-		asc = false
-		sort = strings.TrimPrefix(sort, "-")
+	searchQuery := &rocketqueries.SearchRocketsQuery{
+		Sort:          params.String(sortQueryParam, defaultSort),
+		Limit:         limit,
+		Cursor:        params.String(cursorQueryParam, ""),
+		RocketFilters: newRocketFilters(params),
 	}
 
-	return SortParams{
-		Sort: sort,
-		Asc:  asc,
+	return searchQuery, params.Errors()
+}
+
+func newRocketFilters(params *httpserver.QueryParamsReader) rocketqueries.RocketFilters {
+	return rocketqueries.RocketFilters{
+		RocketType:     params.String(rocketTypeQueryParam, ""),
+		Mission:        params.String(missionQueryParam, ""),
+		MissionPrefix:  params.String(missionPrefixQueryParam, ""),
+		LaunchSpeedMin: params.Int64(launchSpeedMinQueryParam),
+		LaunchSpeedMax: params.Int64(launchSpeedMaxQueryParam),
+		CreatedAfter:   params.Time(createdAfterQueryParam),
+		CreatedBefore:  params.Time(createdBeforeQueryParam),
+		UpdatedAfter:   params.Time(updatedAfterQueryParam),
+		Filter:         params.String(filterQueryParam, ""),
 	}
 }
 
@@ -39,23 +67,39 @@ func HandleSearchRocketsV1HTTP(
 	responseWriter *httpserver.JSONResponseWriter,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		sortParams := newSortParams(r)
-
-		searchQuery := &rocketqueries.SearchRocketsQuery{
-			Sort: sortParams.Sort,
-			Asc:  sortParams.Asc,
+		searchQuery, paramErrs := newSearchRocketsQuery(r)
+		if len(paramErrs) > 0 {
+			responseWriter.WriteErrorResponse(r.Context(), w, paramErrs, http.StatusBadRequest)
+			return
 		}
 
-		resp, err := bus.DispatchWithResponse[*rocketqueries.SearchRocketsQuery, rocketqueries.RocketsResponse](
+		resp, err := bus.DispatchWithResponse[*rocketqueries.SearchRocketsQuery, rocketqueries.RocketsPageResponse](
 			queryBus,
 		)(r.Context(), searchQuery)
 
-		switch err {
-		case nil:
-			response := newRocketsResponseV1(resp)
+		criteriaErr, invalidCriteria := rocketdomain.AsInvalidRocketSearchCriteriaError(err)
+
+		switch {
+		case err == nil:
+			if resp.HasMore {
+				w.Header().Set("Link", nextPageLink(r, resp.NextCursor))
+			}
+			response := newRocketsPageResponseV1(resp)
 			responseWriter.WriteResponse(r.Context(), w, response, http.StatusOK)
+		case invalidCriteria:
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{criteriaErr.Reason()}, http.StatusBadRequest)
 		default:
 			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
 		}
 	}
 }
+
+// nextPageLink builds an RFC 8288 link to the next page keeping every other query param untouched.
+func nextPageLink(r *http.Request, cursor string) string {
+	next := *r.URL
+	query := next.Query()
+	query.Set(cursorQueryParam, cursor)
+	next.RawQuery = query.Encode()
+
+	return fmt.Sprintf(`<%s>; rel="next"`, next.RequestURI())
+}
@@ -0,0 +1,41 @@
+package rocketmission
+
+import (
+	"context"
+	"fmt"
+
+	missionqueries "github.com/soulcodex/rockets-message-processor/internal/mission/application/queries"
+	missiondomain "github.com/soulcodex/rockets-message-processor/internal/mission/domain"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	querybus "github.com/soulcodex/rockets-message-processor/pkg/bus/query"
+)
+
+var _ rocketdomain.MissionGuard = (*QueryBusMissionGuard)(nil)
+
+// QueryBusMissionGuard asks the missions module through the query bus, so the rocket module
+// never reaches the mission catalog storage directly.
+type QueryBusMissionGuard struct {
+	queryBus querybus.Bus
+}
+
+func NewQueryBusMissionGuard(queryBus querybus.Bus) *QueryBusMissionGuard {
+	return &QueryBusMissionGuard{queryBus: queryBus}
+}
+
+func (g *QueryBusMissionGuard) EnsureAssignable(ctx context.Context, mission rocketdomain.Mission) error {
+	resp, err := bus.DispatchWithResponse[*missionqueries.FindMissionQuery, missionqueries.MissionResponse](
+		g.queryBus,
+	)(ctx, &missionqueries.FindMissionQuery{Code: mission.String()})
+
+	switch {
+	case missiondomain.IsMissionNotFoundError(err):
+		return rocketdomain.NewMissionNotAssignableError(mission, "doesn't exist")
+	case err != nil:
+		return fmt.Errorf("failed to find mission: %w", err)
+	case resp.Status != missiondomain.MissionStatusActive.String():
+		return rocketdomain.NewMissionNotAssignableError(mission, "is "+resp.Status)
+	default:
+		return nil
+	}
+}
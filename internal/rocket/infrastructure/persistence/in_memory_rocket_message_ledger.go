@@ -0,0 +1,78 @@
+package rocketpersistence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+type rocketMessageExpiry struct {
+	eventID   string
+	expiresAt time.Time
+}
+
+type rocketMessageEntry struct {
+	record    rocketdomain.RocketMessageRecord
+	expiresAt time.Time
+}
+
+// InMemoryRocketMessageLedger keeps the records alongside a queue of their expiries in recording order,
+// expired records are pruned every time a new one is recorded.
+type InMemoryRocketMessageLedger struct {
+	mutex        sync.RWMutex
+	retention    time.Duration
+	timeProvider utils.DateTimeProvider
+	records      map[string]rocketMessageEntry
+	expiries     []rocketMessageExpiry
+}
+
+func NewInMemoryRocketMessageLedger(retention time.Duration, timeProvider utils.DateTimeProvider) *InMemoryRocketMessageLedger {
+	return &InMemoryRocketMessageLedger{
+		mutex:        sync.RWMutex{},
+		retention:    retention,
+		timeProvider: timeProvider,
+		records:      make(map[string]rocketMessageEntry),
+		expiries:     nil,
+	}
+}
+
+func (l *InMemoryRocketMessageLedger) Record(_ context.Context, record rocketdomain.RocketMessageRecord) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.timeProvider.Now()
+	l.prune(now)
+
+	expiresAt := now.Add(l.retention)
+	l.records[record.EventID] = rocketMessageEntry{record: record, expiresAt: expiresAt}
+	l.expiries = append(l.expiries, rocketMessageExpiry{eventID: record.EventID, expiresAt: expiresAt})
+
+	return nil
+}
+
+func (l *InMemoryRocketMessageLedger) Find(_ context.Context, eventID string) (rocketdomain.RocketMessageRecord, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	entry, exists := l.records[eventID]
+	if !exists || !l.timeProvider.Now().Before(entry.expiresAt) {
+		return rocketdomain.RocketMessageRecord{}, rocketdomain.NewRocketMessageNotFoundError(eventID)
+	}
+
+	return entry.record, nil
+}
+
+// prune drops the records whose latest expiry is over, re-recorded events keep the newest expiry.
+func (l *InMemoryRocketMessageLedger) prune(now time.Time) {
+	for len(l.expiries) > 0 && !now.Before(l.expiries[0].expiresAt) {
+		expired := l.expiries[0]
+		l.expiries = l.expiries[1:]
+
+		if entry, exists := l.records[expired.eventID]; exists && !now.Before(entry.expiresAt) {
+			delete(l.records, expired.eventID)
+		}
+	}
+}
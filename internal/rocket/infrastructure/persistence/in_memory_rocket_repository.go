@@ -1,27 +1,57 @@
 package rocketpersistence
 
 import (
+	"cmp"
 	"context"
 	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
 	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+	"github.com/soulcodex/rockets-message-processor/pkg/filter"
 )
 
 var (
 	errRocketCannotBeNil = errutil.NewError("rocket cannot be nil")
+
+	rocketFilterAccessors = map[string]filter.Accessor[rocketdomain.RocketPrimitives]{
+		"id":           func(p rocketdomain.RocketPrimitives) any { return p.ID },
+		"rocket_type":  func(p rocketdomain.RocketPrimitives) any { return p.RocketType },
+		"mission":      func(p rocketdomain.RocketPrimitives) any { return p.Mission },
+		"launch_speed": func(p rocketdomain.RocketPrimitives) any { return p.LaunchSpeed },
+		"created_at":   func(p rocketdomain.RocketPrimitives) any { return p.CreatedAt },
+		"updated_at":   func(p rocketdomain.RocketPrimitives) any { return p.UpdatedAt },
+	}
 )
 
+// InMemoryRocketRepository keeps a snapshot of every saved rocket, so rockets being
+// mutated by other callers never leak into a search until they're saved again.
+// Fleet statistics are updated on every save, overall and for every grouping. Saves made
+// holding a stale fencing token are rejected, so a handler that lost its mutex can't
+// overwrite the rocket saved by the next holder.
 type InMemoryRocketRepository struct {
-	mutex   sync.RWMutex
-	rockets map[rocketdomain.RocketID]*rocketdomain.Rocket
+	mutex        sync.RWMutex
+	fencing      *distributedsync.FencingTokenGuard
+	rockets      map[rocketdomain.RocketID]rocketdomain.RocketPrimitives
+	stats        *rocketdomain.RocketStatsAccumulator
+	groupedStats map[rocketdomain.RocketStatsGroupBy]map[string]*rocketdomain.RocketStatsAccumulator
 }
 
 func NewInMemoryRocketRepository() *InMemoryRocketRepository {
+	groupedStats := make(map[rocketdomain.RocketStatsGroupBy]map[string]*rocketdomain.RocketStatsAccumulator)
+	for _, grouping := range rocketdomain.RocketStatsGroupings {
+		groupedStats[grouping] = make(map[string]*rocketdomain.RocketStatsAccumulator)
+	}
+
 	return &InMemoryRocketRepository{
-		rockets: make(map[rocketdomain.RocketID]*rocketdomain.Rocket),
-		mutex:   sync.RWMutex{},
+		rockets:      make(map[rocketdomain.RocketID]rocketdomain.RocketPrimitives),
+		mutex:        sync.RWMutex{},
+		fencing:      distributedsync.NewFencingTokenGuard(),
+		stats:        rocketdomain.NewRocketStatsAccumulator(),
+		groupedStats: groupedStats,
 	}
 }
 
@@ -30,34 +60,55 @@ func (r *InMemoryRocketRepository) Find(_ context.Context, id rocketdomain.Rocke
 	defer r.mutex.RUnlock()
 
 	rocket, exists := r.rockets[id]
-	if !exists || rocket.Primitives().DeletedAt != nil {
+	if !exists {
 		return nil, rocketdomain.NewRocketNotFoundError(id)
 	}
 
-	return rocket, nil
+	return rocketdomain.RocketFromPrimitives(rocket), nil
 }
 
-func (r *InMemoryRocketRepository) Search(_ context.Context, sortBy string, asc bool) (rocketdomain.RocketCollection, error) {
+func (r *InMemoryRocketRepository) Search(
+	_ context.Context,
+	criteria rocketdomain.RocketSearchCriteria,
+) (rocketdomain.RocketPage, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	if len(r.rockets) == 0 {
-		return rocketdomain.NewRocketCollection(), nil
+		return rocketdomain.NewRocketPage(criteria, false), nil
 	}
 
-	rockets := make([]*rocketdomain.Rocket, 0, len(r.rockets))
+	compare, matchesFilter := r.sortFunc(criteria.Sort), r.filterFunc(criteria)
+
+	matching := make([]rocketdomain.RocketPrimitives, 0, len(r.rockets))
 	for _, rocket := range r.rockets {
-		if rocket.Primitives().DeletedAt == nil {
-			rockets = append(rockets, rocket)
+		if rocket.DeletedAt != nil || !matchesFilter(rocket) {
+			continue
 		}
+
+		if criteria.After != nil && compare(rocket, criteria.After.Position()) <= 0 {
+			continue
+		}
+
+		matching = append(matching, rocket)
 	}
 
-	slices.SortFunc(rockets, r.sortFunc(sortBy, asc))
+	slices.SortFunc(matching, compare)
 
-	return rocketdomain.NewRocketCollection(rockets...), nil
+	hasMore := len(matching) > criteria.Limit
+	if hasMore {
+		matching = matching[:criteria.Limit]
+	}
+
+	rockets := make([]*rocketdomain.Rocket, len(matching))
+	for i, rocket := range matching {
+		rockets[i] = rocketdomain.RocketFromPrimitives(rocket)
+	}
+
+	return rocketdomain.NewRocketPage(criteria, hasMore, rockets...), nil
 }
 
-func (r *InMemoryRocketRepository) Save(_ context.Context, rocket *rocketdomain.Rocket) error {
+func (r *InMemoryRocketRepository) Save(ctx context.Context, rocket *rocketdomain.Rocket) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
@@ -65,61 +116,183 @@ func (r *InMemoryRocketRepository) Save(_ context.Context, rocket *rocketdomain.
 		return rocketdomain.NewRocketStoreError().Wrap(errRocketCannotBeNil)
 	}
 
-	r.rockets[rocket.ID()] = rocket
+	if fencingErr := r.fencing.Admit(ctx, rocket.ID().String()); fencingErr != nil {
+		return rocketdomain.NewRocketStoreError().Wrap(fencingErr)
+	}
+
+	snapshot := rocket.Primitives()
+	if previous, exists := r.rockets[rocket.ID()]; exists {
+		r.unaccount(previous)
+	}
+
+	r.rockets[rocket.ID()] = snapshot
+	r.account(snapshot)
 	return nil
 }
 
-func (r *InMemoryRocketRepository) sortFunc(sortBy string, asc bool) func(one, two *rocketdomain.Rocket) int {
-	switch sortBy {
-	case "created_at", "updated_at":
-		return r.sortByTimestamps(sortBy, asc)
-	case "launch_speed":
-		return r.sortByLaunchSpeed(asc)
-	default:
-		return func(_, _ *rocketdomain.Rocket) int {
-			return 1
+// FindByMissionAt goes through the mission history of every rocket, sorting them by the time
+// they were assigned the mission and then by ID.
+func (r *InMemoryRocketRepository) FindByMissionAt(
+	_ context.Context,
+	mission rocketdomain.Mission,
+	at time.Time,
+) (rocketdomain.RocketCollection, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	type flight struct {
+		rocket     *rocketdomain.Rocket
+		assignment rocketdomain.MissionAssignment
+	}
+
+	flights := make([]flight, 0)
+	for _, primitives := range r.rockets {
+		rocket := rocketdomain.RocketFromPrimitives(primitives)
+		if assignment, found := rocket.MissionHistory().At(at); found && assignment.Mission == mission {
+			flights = append(flights, flight{rocket: rocket, assignment: assignment})
 		}
 	}
+
+	slices.SortFunc(flights, func(left, right flight) int {
+		if result := left.assignment.From.Compare(right.assignment.From); result != 0 {
+			return result
+		}
+
+		return strings.Compare(left.rocket.ID().String(), right.rocket.ID().String())
+	})
+
+	rockets := make([]*rocketdomain.Rocket, len(flights))
+	for i, flight := range flights {
+		rockets[i] = flight.rocket
+	}
+
+	return rocketdomain.NewRocketCollection(rockets...), nil
 }
 
-func (r *InMemoryRocketRepository) sortByTimestamps(sortBy string, asc bool) func(left, right *rocketdomain.Rocket) int {
-	return func(left, right *rocketdomain.Rocket) int {
-		switch sortBy {
-		case "created_at":
-			if asc {
-				return left.Primitives().CreatedAt.Compare(right.Primitives().CreatedAt)
-			}
-			return right.Primitives().CreatedAt.Compare(left.Primitives().CreatedAt)
-		case "updated_at":
-			if asc {
-				return left.Primitives().UpdatedAt.Compare(right.Primitives().UpdatedAt)
-			}
+// Stats reads the statistics kept up to date on save, filtered statistics can't be
+// precomputed so they are built going through the matching rockets instead.
+func (r *InMemoryRocketRepository) Stats(
+	_ context.Context,
+	criteria rocketdomain.RocketStatsCriteria,
+) (rocketdomain.RocketStatsReport, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
 
-			return right.Primitives().UpdatedAt.Compare(left.Primitives().UpdatedAt)
-		default:
-			return 1 // Default case if sortBy is not recognized
+	if !criteria.Filters.HasFilters() {
+		return newRocketStatsReport(r.stats, r.groupedStats[criteria.GroupBy]), nil
+	}
+
+	stats, groupedStats := rocketdomain.NewRocketStatsAccumulator(), make(map[string]*rocketdomain.RocketStatsAccumulator)
+	matchesFilter := r.filterFunc(criteria.Filters)
+	for _, rocket := range r.rockets {
+		if !matchesFilter(rocket) {
+			continue
+		}
+
+		stats.Add(rocket)
+		if criteria.GroupBy != rocketdomain.RocketStatsUngrouped {
+			groupAccumulator(groupedStats, criteria.GroupBy.KeyOf(rocket)).Add(rocket)
 		}
 	}
+
+	return newRocketStatsReport(stats, groupedStats), nil
 }
 
-func (r *InMemoryRocketRepository) sortByLaunchSpeed(asc bool) func(left, right *rocketdomain.Rocket) int {
-	return func(left, right *rocketdomain.Rocket) int {
-		leftSpeed, rightSpeed := left.Primitives().LaunchSpeed, right.Primitives().LaunchSpeed
+func (r *InMemoryRocketRepository) account(rocket rocketdomain.RocketPrimitives) {
+	r.stats.Add(rocket)
+	for grouping, groups := range r.groupedStats {
+		groupAccumulator(groups, grouping.KeyOf(rocket)).Add(rocket)
+	}
+}
 
-		if leftSpeed == rightSpeed {
-			return 0
+func (r *InMemoryRocketRepository) unaccount(rocket rocketdomain.RocketPrimitives) {
+	r.stats.Remove(rocket)
+	for grouping, groups := range r.groupedStats {
+		key := grouping.KeyOf(rocket)
+		groupAccumulator(groups, key).Remove(rocket)
+		if groups[key].IsEmpty() {
+			delete(groups, key)
 		}
+	}
+}
+
+func groupAccumulator(
+	groups map[string]*rocketdomain.RocketStatsAccumulator,
+	key string,
+) *rocketdomain.RocketStatsAccumulator {
+	accumulator, exists := groups[key]
+	if !exists {
+		accumulator = rocketdomain.NewRocketStatsAccumulator()
+		groups[key] = accumulator
+	}
+
+	return accumulator
+}
+
+func newRocketStatsReport(
+	stats *rocketdomain.RocketStatsAccumulator,
+	groupedStats map[string]*rocketdomain.RocketStatsAccumulator,
+) rocketdomain.RocketStatsReport {
+	report := rocketdomain.RocketStatsReport{
+		Overall: stats.Stats(),
+		Groups:  make(map[string]rocketdomain.RocketStats, len(groupedStats)),
+	}
+
+	for key, accumulator := range groupedStats {
+		report.Groups[key] = accumulator.Stats()
+	}
+
+	return report
+}
+
+// filterFunc combines the field filters of the criteria with its compiled filter expression, if any.
+func (r *InMemoryRocketRepository) filterFunc(criteria rocketdomain.RocketSearchCriteria) filter.Predicate[rocketdomain.RocketPrimitives] {
+	if criteria.Filter == nil {
+		return criteria.Matches
+	}
 
-		if asc {
-			if leftSpeed < rightSpeed {
-				return -1
+	matchesExpression := filter.NewPredicate(criteria.Filter, rocketFilterAccessors)
+	return func(rocket rocketdomain.RocketPrimitives) bool {
+		return criteria.Matches(rocket) && matchesExpression(rocket)
+	}
+}
+
+// sortFunc returns a comparator applying every sort key in order, the criteria always ends
+// with the rocket ID so the resulting order is total and stable across pages.
+func (r *InMemoryRocketRepository) sortFunc(sort rocketdomain.RocketSort) func(left, right rocketdomain.RocketPrimitives) int {
+	return func(left, right rocketdomain.RocketPrimitives) int {
+		for _, key := range sort {
+			result := r.compareBy(key.Field, left, right)
+			if result == 0 {
+				continue
 			}
-			return 1
-		}
 
-		if leftSpeed > rightSpeed {
-			return -1
+			if key.Asc {
+				return result
+			}
+
+			return -result
 		}
-		return 1
+
+		return 0
+	}
+}
+
+func (r *InMemoryRocketRepository) compareBy(field string, left, right rocketdomain.RocketPrimitives) int {
+	switch field {
+	case "created_at":
+		return left.CreatedAt.Compare(right.CreatedAt)
+	case "updated_at":
+		return left.UpdatedAt.Compare(right.UpdatedAt)
+	case "launch_speed":
+		return cmp.Compare(left.LaunchSpeed, right.LaunchSpeed)
+	case "mission":
+		return strings.Compare(left.Mission, right.Mission)
+	case "rocket_type":
+		return strings.Compare(left.RocketType, right.RocketType)
+	case rocketdomain.RocketIDSortField:
+		return strings.Compare(left.ID, right.ID)
+	default:
+		return 0
 	}
 }
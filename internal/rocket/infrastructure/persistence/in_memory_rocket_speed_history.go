@@ -0,0 +1,139 @@
+package rocketpersistence
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+type rocketSpeedSeries struct {
+	points  []rocketdomain.RocketSpeedPoint
+	buckets []rocketdomain.RocketSpeedBucket
+}
+
+type InMemoryRocketSpeedHistory struct {
+	mutex  sync.RWMutex
+	series map[rocketdomain.RocketID]*rocketSpeedSeries
+}
+
+func NewInMemoryRocketSpeedHistory() *InMemoryRocketSpeedHistory {
+	return &InMemoryRocketSpeedHistory{
+		mutex:  sync.RWMutex{},
+		series: make(map[rocketdomain.RocketID]*rocketSpeedSeries),
+	}
+}
+
+func (h *InMemoryRocketSpeedHistory) Record(_ context.Context, id rocketdomain.RocketID, point rocketdomain.RocketSpeedPoint) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	series, exists := h.series[id]
+	if !exists {
+		series = &rocketSpeedSeries{}
+		h.series[id] = series
+	}
+
+	// Points arriving out of order are placed after the ones recorded at the same time.
+	idx, _ := slices.BinarySearchFunc(series.points, point.At, func(p rocketdomain.RocketSpeedPoint, at time.Time) int {
+		if p.At.After(at) {
+			return 1
+		}
+		return -1
+	})
+	series.points = slices.Insert(series.points, idx, point)
+
+	return nil
+}
+
+func (h *InMemoryRocketSpeedHistory) Range(
+	_ context.Context,
+	id rocketdomain.RocketID,
+	from, to time.Time,
+) (rocketdomain.RocketSpeedTimeline, error) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	timeline := rocketdomain.RocketSpeedTimeline{
+		Points:  make([]rocketdomain.RocketSpeedPoint, 0),
+		Buckets: make([]rocketdomain.RocketSpeedBucket, 0),
+	}
+
+	series, exists := h.series[id]
+	if !exists {
+		return timeline, nil
+	}
+
+	for _, point := range series.points {
+		if withinRange(point.At, from, to) {
+			timeline.Points = append(timeline.Points, point)
+		}
+	}
+
+	for _, bucket := range series.buckets {
+		if withinRange(bucket.From, from, to) {
+			timeline.Buckets = append(timeline.Buckets, bucket)
+		}
+	}
+
+	return timeline, nil
+}
+
+func (h *InMemoryRocketSpeedHistory) Compact(
+	_ context.Context,
+	policy rocketdomain.RocketSpeedRetentionPolicy,
+	now time.Time,
+) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	rawCutoff := policy.RawCutoff(now)
+	bucketsCutoff, bucketsExpire := policy.BucketsCutoff(now)
+
+	for id, series := range h.series {
+		split := slices.IndexFunc(series.points, func(p rocketdomain.RocketSpeedPoint) bool { return !p.At.Before(rawCutoff) })
+		if split == -1 {
+			split = len(series.points)
+		}
+
+		compacted := rocketdomain.CompactRocketSpeedPoints(series.points[:split], policy.BucketSize)
+		series.points = slices.Clone(series.points[split:])
+		series.buckets = mergeRocketSpeedBuckets(series.buckets, compacted)
+
+		if bucketsExpire {
+			series.buckets = slices.DeleteFunc(series.buckets, func(b rocketdomain.RocketSpeedBucket) bool {
+				return b.From.Before(bucketsCutoff)
+			})
+		}
+
+		if len(series.points) == 0 && len(series.buckets) == 0 {
+			delete(h.series, id)
+		}
+	}
+
+	return nil
+}
+
+// mergeRocketSpeedBuckets adds the compacted buckets to the existing ones merging those starting at the same time.
+func mergeRocketSpeedBuckets(existing, compacted []rocketdomain.RocketSpeedBucket) []rocketdomain.RocketSpeedBucket {
+	for _, bucket := range compacted {
+		idx, found := slices.BinarySearchFunc(existing, bucket.From, func(b rocketdomain.RocketSpeedBucket, from time.Time) int {
+			return b.From.Compare(from)
+		})
+
+		if found {
+			existing[idx] = existing[idx].Merge(bucket)
+			continue
+		}
+
+		existing = slices.Insert(existing, idx, bucket)
+	}
+
+	return existing
+}
+
+func withinRange(at, from, to time.Time) bool {
+	return !at.Before(from) && !at.After(to)
+}
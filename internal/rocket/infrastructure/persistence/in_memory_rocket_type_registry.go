@@ -0,0 +1,85 @@
+package rocketpersistence
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+// InMemoryRocketTypeRegistry indexes every rocket type by its normalized name and aliases.
+type InMemoryRocketTypeRegistry struct {
+	mutex sync.RWMutex
+	specs map[string]rocketdomain.RocketTypeSpec
+	names map[string]string
+}
+
+func NewInMemoryRocketTypeRegistry() *InMemoryRocketTypeRegistry {
+	return &InMemoryRocketTypeRegistry{
+		mutex: sync.RWMutex{},
+		specs: make(map[string]rocketdomain.RocketTypeSpec),
+		names: make(map[string]string),
+	}
+}
+
+func (r *InMemoryRocketTypeRegistry) Resolve(_ context.Context, name string) (rocketdomain.RocketTypeSpec, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	key, exists := r.names[rocketdomain.NormalizeRocketTypeName(name)]
+	if !exists {
+		return rocketdomain.RocketTypeSpec{}, rocketdomain.NewUnknownRocketTypeError(name)
+	}
+
+	return cloneRocketTypeSpec(r.specs[key]), nil
+}
+
+// Register replaces the rocket type sharing its canonical name, dropping the aliases it doesn't keep.
+func (r *InMemoryRocketTypeRegistry) Register(_ context.Context, spec rocketdomain.RocketTypeSpec) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	names := spec.Names()
+	key := names[0]
+	for _, name := range names {
+		if owner, taken := r.names[name]; taken && owner != key {
+			return rocketdomain.NewRocketTypeAliasConflictError(name, r.specs[owner].Name)
+		}
+	}
+
+	if previous, exists := r.specs[key]; exists {
+		for _, name := range previous.Names() {
+			delete(r.names, name)
+		}
+	}
+
+	for _, name := range names {
+		r.names[name] = key
+	}
+
+	r.specs[key] = cloneRocketTypeSpec(spec)
+	return nil
+}
+
+func (r *InMemoryRocketTypeRegistry) All(_ context.Context) ([]rocketdomain.RocketTypeSpec, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	specs := make([]rocketdomain.RocketTypeSpec, 0, len(r.specs))
+	for _, spec := range r.specs {
+		specs = append(specs, cloneRocketTypeSpec(spec))
+	}
+
+	slices.SortFunc(specs, func(left, right rocketdomain.RocketTypeSpec) int {
+		return strings.Compare(left.Name.String(), right.Name.String())
+	})
+
+	return specs, nil
+}
+
+func cloneRocketTypeSpec(spec rocketdomain.RocketTypeSpec) rocketdomain.RocketTypeSpec {
+	spec.Aliases = slices.Clone(spec.Aliases)
+	return spec
+}
@@ -0,0 +1,44 @@
+package rocketpersistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+type jsonRocketTypeSpec struct {
+	Name     string   `json:"name"`
+	Aliases  []string `json:"aliases"`
+	MinSpeed int64    `json:"min_speed"`
+	MaxSpeed int64    `json:"max_speed"`
+}
+
+// LoadRocketTypeCatalog registers every rocket type listed in the given JSON file, which holds a list of
+// objects with the name, aliases and speed limits of each rocket type.
+func LoadRocketTypeCatalog(ctx context.Context, path string, registry rocketdomain.RocketTypeRegistry) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read rocket type catalog: %w", err)
+	}
+
+	var catalog []jsonRocketTypeSpec
+	if unmarshalErr := json.Unmarshal(content, &catalog); unmarshalErr != nil {
+		return fmt.Errorf("failed to decode rocket type catalog: %w", unmarshalErr)
+	}
+
+	for _, entry := range catalog {
+		spec, specErr := rocketdomain.NewRocketTypeSpec(entry.Name, entry.Aliases, entry.MinSpeed, entry.MaxSpeed)
+		if specErr != nil {
+			return fmt.Errorf("invalid rocket type '%s' in catalog: %w", entry.Name, specErr)
+		}
+
+		if registerErr := registry.Register(ctx, spec); registerErr != nil {
+			return fmt.Errorf("failed to register rocket type '%s': %w", entry.Name, registerErr)
+		}
+	}
+
+	return nil
+}
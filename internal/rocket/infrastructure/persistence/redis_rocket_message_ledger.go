@@ -0,0 +1,73 @@
+package rocketpersistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+const rocketMessagePrefix = "rocket:messages:"
+
+type redisRocketMessageRecord struct {
+	EventID    string `json:"event_id"`
+	RocketID   string `json:"rocket_id"`
+	ReceivedAt int64  `json:"received_at"`
+	Outcome    string `json:"outcome"`
+	Error      string `json:"error,omitempty"`
+}
+
+// RedisRocketMessageLedger keeps every record as a JSON string expiring once the retention is over.
+type RedisRocketMessageLedger struct {
+	client    *redis.Client
+	retention time.Duration
+}
+
+func NewRedisRocketMessageLedger(client *redis.Client, retention time.Duration) *RedisRocketMessageLedger {
+	return &RedisRocketMessageLedger{client: client, retention: retention}
+}
+
+func (l *RedisRocketMessageLedger) Record(ctx context.Context, record rocketdomain.RocketMessageRecord) error {
+	value, _ := json.Marshal(redisRocketMessageRecord{
+		EventID:    record.EventID,
+		RocketID:   record.RocketID,
+		ReceivedAt: record.ReceivedAt.UnixNano(),
+		Outcome:    record.Outcome,
+		Error:      record.Error,
+	})
+
+	if err := l.client.Set(ctx, rocketMessagePrefix+record.EventID, value, l.retention).Err(); err != nil {
+		return fmt.Errorf("failed to record rocket message: %w", err)
+	}
+
+	return nil
+}
+
+func (l *RedisRocketMessageLedger) Find(ctx context.Context, eventID string) (rocketdomain.RocketMessageRecord, error) {
+	value, err := l.client.Get(ctx, rocketMessagePrefix+eventID).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return rocketdomain.RocketMessageRecord{}, rocketdomain.NewRocketMessageNotFoundError(eventID)
+	}
+
+	if err != nil {
+		return rocketdomain.RocketMessageRecord{}, fmt.Errorf("failed to find rocket message: %w", err)
+	}
+
+	var record redisRocketMessageRecord
+	if err = json.Unmarshal(value, &record); err != nil {
+		return rocketdomain.RocketMessageRecord{}, fmt.Errorf("failed to decode rocket message: %w", err)
+	}
+
+	return rocketdomain.RocketMessageRecord{
+		EventID:    record.EventID,
+		RocketID:   record.RocketID,
+		ReceivedAt: time.Unix(0, record.ReceivedAt),
+		Outcome:    record.Outcome,
+		Error:      record.Error,
+	}, nil
+}
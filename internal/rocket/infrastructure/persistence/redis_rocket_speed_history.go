@@ -0,0 +1,283 @@
+package rocketpersistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+)
+
+const (
+	rocketSpeedIndexKey     = "rocket:speed:rockets"
+	rocketSpeedPointsPrefix = "rocket:speed:points:"
+	rocketSpeedBucketPrefix = "rocket:speed:buckets:"
+)
+
+type redisRocketSpeedPoint struct {
+	At            int64  `json:"at"`
+	Value         int64  `json:"v"`
+	Delta         int64  `json:"d"`
+	MessageNumber uint64 `json:"n"`
+}
+
+type redisRocketSpeedBucket struct {
+	From  int64 `json:"from"`
+	Step  int64 `json:"step"`
+	Min   int64 `json:"min"`
+	Max   int64 `json:"max"`
+	Sum   int64 `json:"sum"`
+	Count int   `json:"count"`
+}
+
+// RedisRocketSpeedHistory keeps the points and buckets of every rocket in two sorted sets scored
+// by their unix time in milliseconds, alongside a set indexing the rockets with history.
+type RedisRocketSpeedHistory struct {
+	client *redis.Client
+}
+
+func NewRedisRocketSpeedHistory(client *redis.Client) *RedisRocketSpeedHistory {
+	return &RedisRocketSpeedHistory{client: client}
+}
+
+func (h *RedisRocketSpeedHistory) Record(ctx context.Context, id rocketdomain.RocketID, point rocketdomain.RocketSpeedPoint) error {
+	member, _ := json.Marshal(redisRocketSpeedPoint{
+		At:            point.At.UnixNano(),
+		Value:         point.Value,
+		Delta:         point.Delta,
+		MessageNumber: point.MessageNumber,
+	})
+
+	_, err := h.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, rocketSpeedPointsPrefix+id.String(), redis.Z{Score: rocketSpeedScore(point.At), Member: member})
+		pipe.SAdd(ctx, rocketSpeedIndexKey, id.String())
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record rocket speed: %w", err)
+	}
+
+	return nil
+}
+
+func (h *RedisRocketSpeedHistory) Range(
+	ctx context.Context,
+	id rocketdomain.RocketID,
+	from, to time.Time,
+) (rocketdomain.RocketSpeedTimeline, error) {
+	points, err := h.points(ctx, id, rocketSpeedScoreBound(from), rocketSpeedScoreBound(to))
+	if err != nil {
+		return rocketdomain.RocketSpeedTimeline{}, err
+	}
+
+	buckets, err := h.buckets(ctx, id, rocketSpeedScoreBound(from), rocketSpeedScoreBound(to))
+	if err != nil {
+		return rocketdomain.RocketSpeedTimeline{}, err
+	}
+
+	timeline := rocketdomain.RocketSpeedTimeline{
+		Points:  make([]rocketdomain.RocketSpeedPoint, 0, len(points)),
+		Buckets: make([]rocketdomain.RocketSpeedBucket, 0, len(buckets)),
+	}
+
+	// Scores are truncated to milliseconds, the exact bounds are checked once decoded.
+	for _, point := range points {
+		if withinRange(point.At, from, to) {
+			timeline.Points = append(timeline.Points, point)
+		}
+	}
+
+	for _, bucket := range buckets {
+		if withinRange(bucket.From, from, to) {
+			timeline.Buckets = append(timeline.Buckets, bucket)
+		}
+	}
+
+	return timeline, nil
+}
+
+func (h *RedisRocketSpeedHistory) Compact(ctx context.Context, policy rocketdomain.RocketSpeedRetentionPolicy, now time.Time) error {
+	ids, err := h.client.SMembers(ctx, rocketSpeedIndexKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list rockets with speed history: %w", err)
+	}
+
+	for _, id := range ids {
+		if compactErr := h.compactRocket(ctx, rocketdomain.RocketID(id), policy, now); compactErr != nil {
+			return compactErr
+		}
+	}
+
+	return nil
+}
+
+// compactRocket removes exactly the members it has read, so points recorded meanwhile are never lost.
+func (h *RedisRocketSpeedHistory) compactRocket(
+	ctx context.Context,
+	id rocketdomain.RocketID,
+	policy rocketdomain.RocketSpeedRetentionPolicy,
+	now time.Time,
+) error {
+	rawMembers, err := h.client.ZRangeByScore(ctx, rocketSpeedPointsPrefix+id.String(), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: "(" + rocketSpeedScoreBound(policy.RawCutoff(now)),
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("failed to read rocket speed points to compact: %w", err)
+	}
+
+	points, err := decodeRocketSpeedPoints(rawMembers)
+	if err != nil {
+		return err
+	}
+
+	compacted := rocketdomain.CompactRocketSpeedPoints(points, policy.BucketSize)
+	merged := make([]rocketdomain.RocketSpeedBucket, 0, len(compacted))
+	staleBuckets := make([]interface{}, 0)
+	for _, bucket := range compacted {
+		at := rocketSpeedScoreBound(bucket.From)
+		existing, rawExisting, readErr := h.bucketsWithMembers(ctx, id, at, at)
+		if readErr != nil {
+			return readErr
+		}
+
+		for _, previous := range existing {
+			bucket = bucket.Merge(previous)
+		}
+		merged = append(merged, bucket)
+		staleBuckets = append(staleBuckets, rawExisting...)
+	}
+
+	_, err = h.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		h.writeCompaction(ctx, pipe, id, rawMembers, staleBuckets, merged)
+		if cutoff, expire := policy.BucketsCutoff(now); expire {
+			maxScore := "(" + rocketSpeedScoreBound(cutoff)
+			pipe.ZRemRangeByScore(ctx, rocketSpeedBucketPrefix+id.String(), "-inf", maxScore)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compact rocket speed history: %w", err)
+	}
+
+	return nil
+}
+
+func (h *RedisRocketSpeedHistory) writeCompaction(
+	ctx context.Context,
+	pipe redis.Pipeliner,
+	id rocketdomain.RocketID,
+	rawMembers []string,
+	staleBuckets []interface{},
+	merged []rocketdomain.RocketSpeedBucket,
+) {
+	if len(rawMembers) > 0 {
+		members := make([]interface{}, len(rawMembers))
+		for i, member := range rawMembers {
+			members[i] = member
+		}
+		pipe.ZRem(ctx, rocketSpeedPointsPrefix+id.String(), members...)
+	}
+
+	if len(staleBuckets) > 0 {
+		pipe.ZRem(ctx, rocketSpeedBucketPrefix+id.String(), staleBuckets...)
+	}
+
+	for _, bucket := range merged {
+		member, _ := json.Marshal(redisRocketSpeedBucket{
+			From:  bucket.From.UnixNano(),
+			Step:  int64(bucket.Step),
+			Min:   bucket.Min,
+			Max:   bucket.Max,
+			Sum:   bucket.Sum,
+			Count: bucket.Count,
+		})
+		pipe.ZAdd(ctx, rocketSpeedBucketPrefix+id.String(), redis.Z{Score: rocketSpeedScore(bucket.From), Member: member})
+	}
+}
+
+func (h *RedisRocketSpeedHistory) points(
+	ctx context.Context,
+	id rocketdomain.RocketID,
+	minScore, maxScore string,
+) ([]rocketdomain.RocketSpeedPoint, error) {
+	rangeBy := &redis.ZRangeBy{Min: minScore, Max: maxScore}
+	members, err := h.client.ZRangeByScore(ctx, rocketSpeedPointsPrefix+id.String(), rangeBy).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rocket speed points: %w", err)
+	}
+
+	return decodeRocketSpeedPoints(members)
+}
+
+func (h *RedisRocketSpeedHistory) buckets(
+	ctx context.Context,
+	id rocketdomain.RocketID,
+	minScore, maxScore string,
+) ([]rocketdomain.RocketSpeedBucket, error) {
+	buckets, _, err := h.bucketsWithMembers(ctx, id, minScore, maxScore)
+	return buckets, err
+}
+
+func (h *RedisRocketSpeedHistory) bucketsWithMembers(
+	ctx context.Context,
+	id rocketdomain.RocketID,
+	minScore, maxScore string,
+) ([]rocketdomain.RocketSpeedBucket, []interface{}, error) {
+	rangeBy := &redis.ZRangeBy{Min: minScore, Max: maxScore}
+	members, err := h.client.ZRangeByScore(ctx, rocketSpeedBucketPrefix+id.String(), rangeBy).Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read rocket speed buckets: %w", err)
+	}
+
+	buckets, rawMembers := make([]rocketdomain.RocketSpeedBucket, len(members)), make([]interface{}, len(members))
+	for i, member := range members {
+		var bucket redisRocketSpeedBucket
+		if decodeErr := json.Unmarshal([]byte(member), &bucket); decodeErr != nil {
+			return nil, nil, fmt.Errorf("failed to decode rocket speed bucket: %w", decodeErr)
+		}
+
+		rawMembers[i] = member
+		buckets[i] = rocketdomain.RocketSpeedBucket{
+			From:  time.Unix(0, bucket.From).UTC(),
+			Step:  time.Duration(bucket.Step),
+			Min:   bucket.Min,
+			Max:   bucket.Max,
+			Sum:   bucket.Sum,
+			Count: bucket.Count,
+		}
+	}
+
+	return buckets, rawMembers, nil
+}
+
+func decodeRocketSpeedPoints(members []string) ([]rocketdomain.RocketSpeedPoint, error) {
+	points := make([]rocketdomain.RocketSpeedPoint, len(members))
+	for i, member := range members {
+		var point redisRocketSpeedPoint
+		if err := json.Unmarshal([]byte(member), &point); err != nil {
+			return nil, fmt.Errorf("failed to decode rocket speed point: %w", err)
+		}
+
+		points[i] = rocketdomain.RocketSpeedPoint{
+			At:            time.Unix(0, point.At).UTC(),
+			Value:         point.Value,
+			Delta:         point.Delta,
+			MessageNumber: point.MessageNumber,
+		}
+	}
+
+	return points, nil
+}
+
+func rocketSpeedScore(at time.Time) float64 {
+	return float64(at.UnixMilli())
+}
+
+func rocketSpeedScoreBound(at time.Time) string {
+	return strconv.FormatInt(at.UnixMilli(), 10)
+}
@@ -0,0 +1,52 @@
+package schedulerentrypoint
+
+import (
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/scheduler"
+)
+
+type JobsResponseV1 struct {
+	Leader bool            `json:"leader"`
+	Jobs   []JobResponseV1 `json:"jobs"`
+}
+
+func newJobsResponseV1(leader bool, jobs []scheduler.ScheduledJob) JobsResponseV1 {
+	response := JobsResponseV1{Leader: leader, Jobs: make([]JobResponseV1, len(jobs))}
+	for i, job := range jobs {
+		response.Jobs[i] = newJobResponseV1(job)
+	}
+
+	return response
+}
+
+type JobResponseV1 struct {
+	Name           string     `json:"name"`
+	Schedule       string     `json:"schedule"`
+	LastRunAt      *time.Time `json:"last_run_at"`
+	LastDurationMs *int64     `json:"last_duration_ms"`
+	LastError      *string    `json:"last_error"`
+}
+
+func newJobResponseV1(job scheduler.ScheduledJob) JobResponseV1 {
+	response := JobResponseV1{
+		Name:           job.Name,
+		Schedule:       job.Schedule,
+		LastRunAt:      nil,
+		LastDurationMs: nil,
+		LastError:      nil,
+	}
+
+	if job.LastRun == nil {
+		return response
+	}
+
+	duration := job.LastRun.LastDuration.Milliseconds()
+	response.LastRunAt = &job.LastRun.LastRunAt
+	response.LastDurationMs = &duration
+	if job.LastRun.LastError != "" {
+		response.LastError = &job.LastRun.LastError
+	}
+
+	return response
+}
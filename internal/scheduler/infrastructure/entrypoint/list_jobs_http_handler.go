@@ -0,0 +1,26 @@
+package schedulerentrypoint
+
+import (
+	"net/http"
+
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	"github.com/soulcodex/rockets-message-processor/pkg/scheduler"
+)
+
+// HandleListJobsV1HTTP answers the registered jobs along with the status of their last run, and whether the
+// instance answering leads, which is the one running them.
+func HandleListJobsV1HTTP(
+	jobScheduler *scheduler.Scheduler,
+	isLeader func() bool,
+	responseWriter *httpserver.JSONResponseWriter,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jobs, err := jobScheduler.Jobs(r.Context())
+		if err != nil {
+			responseWriter.WriteErrorResponse(r.Context(), w, []string{err.Error()}, http.StatusInternalServerError)
+			return
+		}
+
+		responseWriter.WriteResponse(r.Context(), w, newJobsResponseV1(isLeader(), jobs), http.StatusOK)
+	}
+}
@@ -0,0 +1,26 @@
+package bus
+
+type ConfigFunc func(*Config)
+
+// Config sets the middlewares every handler of the bus is wrapped with, the first one being the outermost.
+type Config struct {
+	Middlewares []Middleware
+}
+
+func NewConfig(opts ...ConfigFunc) Config {
+	config := Config{
+		Middlewares: make([]Middleware, 0),
+	}
+
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return config
+}
+
+func WithMiddleware(middlewares ...Middleware) ConfigFunc {
+	return func(config *Config) {
+		config.Middlewares = append(config.Middlewares, middlewares...)
+	}
+}
@@ -34,18 +34,20 @@ func DispatchWithResponse[Input Dto, Output any](bus Bus) DispatchWithOutputFunc
 	}
 }
 
-func DispatchBlocking(bus Bus, mutex dsync.MutexService) DispatchBlockingFunc {
+func DispatchBlocking(bus Bus, mutex dsync.MutexService, opts ...DispatchBlockingOptFunc) DispatchBlockingFunc {
+	options := NewDispatchBlockingOptions(opts...)
+
 	return func(ctx context.Context, input BlockingDto) error {
 		handler, err := bus.GetHandler(input)
 		if err != nil {
 			return ErrNoHandlerForInput(input, err)
 		}
 
-		operation := func() (interface{}, error) {
-			return handler.Handle(ctx, input)
+		operation := func(lockedCtx context.Context) (interface{}, error) {
+			return handler.Handle(lockedCtx, input)
 		}
 
-		_, blockingErr := mutex.Mutex(ctx, input.BlockingKey(), operation)
+		_, blockingErr := options.lock(ctx, mutex, input.BlockingKey(), operation)
 		if blockingErr != nil {
 			return ErrUnprocessableHandler(input, blockingErr)
 		}
@@ -54,6 +56,40 @@ func DispatchBlocking(bus Bus, mutex dsync.MutexService) DispatchBlockingFunc {
 	}
 }
 
+// DispatchBlockingWithResponse handles the input holding its blocking key, returning the handler output.
+func DispatchBlockingWithResponse[Input BlockingDto, Output any](
+	bus Bus,
+	mutex dsync.MutexService,
+	opts ...DispatchBlockingOptFunc,
+) DispatchWithOutputFunc[Input, Output] {
+	options := NewDispatchBlockingOptions(opts...)
+
+	return func(ctx context.Context, input Input) (Output, error) {
+		var output Output
+
+		handler, err := bus.GetHandler(input)
+		if err != nil {
+			return output, ErrNoHandlerForInput(input, err)
+		}
+
+		operation := func(lockedCtx context.Context) (interface{}, error) {
+			return handler.Handle(lockedCtx, input)
+		}
+
+		out, blockingErr := options.lock(ctx, mutex, input.BlockingKey(), operation)
+		if blockingErr != nil {
+			return output, ErrUnprocessableHandler(input, blockingErr)
+		}
+
+		response, ok := out.(Output)
+		if !ok {
+			return output, newInvalidOutputReceived(handler, output, out)
+		}
+
+		return response, nil
+	}
+}
+
 func Dispatch(bus Bus) DispatchFunc {
 	return func(ctx context.Context, input Dto) error {
 		handler, err := bus.GetHandler(input)
@@ -73,13 +109,13 @@ func Dispatch(bus Bus) DispatchFunc {
 func ErrNoHandlerForInput(input Dto, previous error) error {
 	return errutil.NewError(
 		"unable to get handler for input",
-		errutil.WithMetadataKeyValue("bus.operation.input_type", input.Type())).
+		errutil.WithMetadataKeyValue(busInputTypeSemConvKey, input.Type())).
 		Wrap(previous)
 }
 
 func ErrUnprocessableHandler(input Dto, previous error) error {
 	return errutil.NewError(
 		"unprocessable bus input",
-		errutil.WithMetadataKeyValue("bus.operation.input_type", input.Type())).
+		errutil.WithMetadataKeyValue(busInputTypeSemConvKey, input.Type())).
 		Wrap(previous)
 }
@@ -0,0 +1,54 @@
+package bus
+
+import (
+	"context"
+
+	dsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+)
+
+type DispatchBlockingOptFunc func(*DispatchBlockingOptions)
+
+// DispatchBlockingOptions sets whether blocking dispatches wait for the blocking key to be free or fail fast with a
+// dsync.MutexContendedError, along with the options of the lock itself.
+type DispatchBlockingOptions struct {
+	TryLock      bool
+	MutexOptions []dsync.MutexOptFunc
+}
+
+func NewDispatchBlockingOptions(opts ...DispatchBlockingOptFunc) *DispatchBlockingOptions {
+	dbo := &DispatchBlockingOptions{
+		TryLock:      false,
+		MutexOptions: nil,
+	}
+
+	for _, opt := range opts {
+		opt(dbo)
+	}
+
+	return dbo
+}
+
+func WithTryLock() DispatchBlockingOptFunc {
+	return func(dbo *DispatchBlockingOptions) {
+		dbo.TryLock = true
+	}
+}
+
+func WithMutexOptions(opts ...dsync.MutexOptFunc) DispatchBlockingOptFunc {
+	return func(dbo *DispatchBlockingOptions) {
+		dbo.MutexOptions = append(dbo.MutexOptions, opts...)
+	}
+}
+
+func (dbo *DispatchBlockingOptions) lock(
+	ctx context.Context,
+	mutex dsync.MutexService,
+	key string,
+	fn dsync.MutexCallback,
+) (interface{}, error) {
+	if dbo.TryLock {
+		return mutex.TryMutex(ctx, key, fn, dbo.MutexOptions...)
+	}
+
+	return mutex.Mutex(ctx, key, fn, dbo.MutexOptions...)
+}
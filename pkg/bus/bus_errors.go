@@ -1,14 +1,17 @@
 package bus
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
 )
 
 const (
-	busDtoSemConvKey     = "messaging.bus.dto"
-	busHandlerSemConvKey = "messaging.bus.handler"
+	busDtoSemConvKey       = "messaging.bus.dto"
+	busHandlerSemConvKey   = "messaging.bus.handler"
+	busInputTypeSemConvKey = "bus.operation.input_type"
+	busPanicSemConvKey     = "bus.operation.panic"
 
 	busOutputExpectedSemConvKey = "messaging.bus.output.expected"
 	busOutputReceivedSemConvKey = "messaging.bus.output.received"
@@ -32,7 +35,7 @@ type HandlerNotRegisteredError struct {
 	*errutil.BaseError
 }
 
-func newHandlerNotRegistered(dto Dto) *HandlerNotRegisteredError {
+func NewHandlerNotRegistered(dto Dto) *HandlerNotRegisteredError {
 	return &HandlerNotRegisteredError{
 		BaseError: errutil.NewError(
 			"bus handler not registered",
@@ -41,6 +44,11 @@ func newHandlerNotRegistered(dto Dto) *HandlerNotRegisteredError {
 	}
 }
 
+func IsHandlerNotRegisteredError(err error) bool {
+	var self *HandlerNotRegisteredError
+	return errors.As(err, &self)
+}
+
 type InvalidDtoProvidedError struct {
 	*errutil.BaseError
 }
@@ -69,3 +77,28 @@ func newInvalidOutputReceived(handler Handler[any, Dto], expected, received inte
 		),
 	}
 }
+
+// HandlerPanickedError means a handler panicked while handling an input, the panic being recovered by the
+// PanicRecoverMiddleware.
+type HandlerPanickedError struct {
+	*errutil.BaseError
+}
+
+func NewHandlerPanickedError(input Dto, recovered any) *HandlerPanickedError {
+	cause, _ := recovered.(error)
+
+	return &HandlerPanickedError{
+		BaseError: errutil.NewError(
+			"bus handler panicked",
+			errutil.WithSeverity(errutil.SeverityFatal),
+			errutil.WithCause(cause),
+			errutil.WithMetadataKeyValue(busInputTypeSemConvKey, input.Type()),
+			errutil.WithMetadataKeyValue(busPanicSemConvKey, fmt.Sprint(recovered)),
+		),
+	}
+}
+
+func IsHandlerPanickedError(err error) bool {
+	var self *HandlerPanickedError
+	return errors.As(err, &self)
+}
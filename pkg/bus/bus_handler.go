@@ -6,6 +6,11 @@ import (
 
 type HandlerFunc[Output, Input any] func(context.Context, Input) (Output, error)
 
+// Handle lets plain functions be used as handlers, as http.HandlerFunc does.
+func (hf HandlerFunc[Output, Input]) Handle(ctx context.Context, input Input) (Output, error) {
+	return hf(ctx, input)
+}
+
 type Handler[Output, Input any] interface {
 	Handle(context.Context, Input) (Output, error)
 }
@@ -0,0 +1,44 @@
+package bus
+
+import (
+	"context"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+// LoggingMiddleware logs every handled input along with how long handling it took, failures being logged as
+// warnings as they're answered to the caller anyway.
+type LoggingMiddleware struct {
+	logger       logger.ZerologLogger
+	timeProvider utils.DateTimeProvider
+}
+
+func NewLoggingMiddleware(logger logger.ZerologLogger, tp utils.DateTimeProvider) *LoggingMiddleware {
+	return &LoggingMiddleware{logger: logger, timeProvider: tp}
+}
+
+func (lm *LoggingMiddleware) Middleware(next Handler[any, Dto]) Handler[any, Dto] {
+	return HandlerFunc[any, Dto](func(ctx context.Context, input Dto) (any, error) {
+		startedAt := lm.timeProvider.Now()
+		output, err := next.Handle(ctx, input)
+		duration := lm.timeProvider.Now().Sub(startedAt)
+
+		if err != nil {
+			lm.logger.Warn().
+				Ctx(ctx).
+				Err(err).
+				Str(busInputTypeSemConvKey, input.Type()).
+				Int64("bus.operation.duration_ms", duration.Milliseconds()).
+				Msg("bus input handling failed")
+			return output, err
+		}
+
+		lm.logger.Debug().
+			Ctx(ctx).
+			Str(busInputTypeSemConvKey, input.Type()).
+			Int64("bus.operation.duration_ms", duration.Milliseconds()).
+			Msg("bus input handled")
+		return output, nil
+	})
+}
@@ -0,0 +1,34 @@
+package bus
+
+import (
+	"context"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+// DurationRecorder records how long handling every input took, to be backed by the metrics system of the service.
+type DurationRecorder interface {
+	// RecordHandlingDuration records how long handling an input of the given type took and whether it failed.
+	RecordHandlingDuration(ctx context.Context, inputType string, duration time.Duration, failed bool)
+}
+
+// MetricsMiddleware records the duration of every handled input.
+type MetricsMiddleware struct {
+	recorder     DurationRecorder
+	timeProvider utils.DateTimeProvider
+}
+
+func NewMetricsMiddleware(recorder DurationRecorder, tp utils.DateTimeProvider) *MetricsMiddleware {
+	return &MetricsMiddleware{recorder: recorder, timeProvider: tp}
+}
+
+func (mm *MetricsMiddleware) Middleware(next Handler[any, Dto]) Handler[any, Dto] {
+	return HandlerFunc[any, Dto](func(ctx context.Context, input Dto) (any, error) {
+		startedAt := mm.timeProvider.Now()
+		output, err := next.Handle(ctx, input)
+		mm.recorder.RecordHandlingDuration(ctx, input.Type(), mm.timeProvider.Now().Sub(startedAt), err != nil)
+
+		return output, err
+	})
+}
@@ -0,0 +1,14 @@
+package bus
+
+// Middleware wraps a handler with a cross-cutting concern, such as logging or recovering from panics. Middlewares
+// are composed like httpserver.Middleware, every one of them calling the next handler of the chain.
+type Middleware func(next Handler[any, Dto]) Handler[any, Dto]
+
+// ChainMiddlewares wraps the handler with the middlewares, the first one being the outermost.
+func ChainMiddlewares(handler Handler[any, Dto], middlewares ...Middleware) Handler[any, Dto] {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+
+	return handler
+}
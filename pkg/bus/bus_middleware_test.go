@@ -0,0 +1,221 @@
+package bus_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	dsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+	distributedsyncmock "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync/mock"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+func recordingMiddleware(name string, calls *[]string) bus.Middleware {
+	return func(next bus.Handler[any, bus.Dto]) bus.Handler[any, bus.Dto] {
+		return bus.HandlerFunc[any, bus.Dto](func(ctx context.Context, input bus.Dto) (any, error) {
+			*calls = append(*calls, name)
+			return next.Handle(ctx, input)
+		})
+	}
+}
+
+func createBusHandledBy(t *testing.T, handler bus.HandlerFunc[any, *FakeDto], opts ...bus.ConfigFunc) *bus.SyncBus {
+	syncBus := bus.InitSyncBus(opts...)
+	require.NoError(t, syncBus.Register(&FakeDto{}, bus.WrapAsAnyHandler(handler)))
+
+	return syncBus
+}
+
+func Test_SyncBus_DispatchesThroughMiddlewares(t *testing.T) {
+	mutex := &distributedsyncmock.MutexServiceMock{}
+	mutex.MutexFunc = func(ctx context.Context, _ string, fn dsync.MutexCallback, _ ...dsync.MutexOptFunc) (interface{}, error) {
+		return fn(ctx)
+	}
+
+	tests := []struct {
+		name     string
+		dispatch func(syncBus *bus.SyncBus) error
+	}{
+		{
+			name: "dispatch",
+			dispatch: func(syncBus *bus.SyncBus) error {
+				return bus.Dispatch(syncBus)(context.Background(), newFakeDto())
+			},
+		},
+		{
+			name: "dispatch with response",
+			dispatch: func(syncBus *bus.SyncBus) error {
+				_, err := bus.DispatchWithResponse[*FakeDto, *FakeResponse](syncBus)(context.Background(), newFakeDto())
+				return err
+			},
+		},
+		{
+			name: "dispatch blocking",
+			dispatch: func(syncBus *bus.SyncBus) error {
+				return bus.DispatchBlocking(syncBus, mutex)(context.Background(), newFakeDto())
+			},
+		},
+		{
+			name: "dispatch blocking with response",
+			dispatch: func(syncBus *bus.SyncBus) error {
+				_, err := bus.DispatchBlockingWithResponse[*FakeDto, *FakeResponse](syncBus, mutex)(context.Background(), newFakeDto())
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := make([]string, 0)
+			syncBus := createBusHandledBy(
+				t,
+				func(context.Context, *FakeDto) (any, error) {
+					calls = append(calls, "handler")
+					return newFakeResponse(), nil
+				},
+				bus.WithMiddleware(recordingMiddleware("outer", &calls), recordingMiddleware("inner", &calls)),
+			)
+
+			require.NoError(t, tt.dispatch(syncBus))
+			assert.Equal(t, []string{"outer", "inner", "handler"}, calls)
+		})
+	}
+}
+
+func Test_PanicRecoverMiddleware(t *testing.T) {
+	syncBus := createBusHandledBy(
+		t,
+		func(context.Context, *FakeDto) (any, error) {
+			panic("boom")
+		},
+		bus.WithMiddleware(bus.NewPanicRecoverMiddleware(zerolog.New(io.Discard)).Middleware),
+	)
+
+	err := bus.Dispatch(syncBus)(context.Background(), newFakeDto())
+
+	require.Error(t, err)
+	assert.True(t, bus.IsHandlerPanickedError(err))
+}
+
+func Test_TimeoutMiddleware(t *testing.T) {
+	tests := []struct {
+		name            string
+		middleware      *bus.TimeoutMiddleware
+		expectedTimeout time.Duration
+	}{
+		{
+			name:            "should apply the default timeout",
+			middleware:      bus.NewTimeoutMiddleware(time.Minute),
+			expectedTimeout: time.Minute,
+		},
+		{
+			name:            "should apply the timeout of the input type",
+			middleware:      bus.NewTimeoutMiddleware(time.Minute, bus.WithInputTimeout(&FakeDto{}, time.Hour)),
+			expectedTimeout: time.Hour,
+		},
+		{
+			name:       "should apply no timeout when zero",
+			middleware: bus.NewTimeoutMiddleware(0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startedAt := time.Now()
+			syncBus := createBusHandledBy(
+				t,
+				func(ctx context.Context, _ *FakeDto) (any, error) {
+					deadline, hasDeadline := ctx.Deadline()
+					if tt.expectedTimeout == 0 {
+						assert.False(t, hasDeadline)
+						return nil, nil
+					}
+
+					require.True(t, hasDeadline)
+					assert.WithinDuration(t, startedAt.Add(tt.expectedTimeout), deadline, time.Second)
+					return nil, nil
+				},
+				bus.WithMiddleware(tt.middleware.Middleware),
+			)
+
+			require.NoError(t, bus.Dispatch(syncBus)(context.Background(), newFakeDto()))
+		})
+	}
+}
+
+type fakeDurationRecorder struct {
+	inputType string
+	duration  time.Duration
+	failed    bool
+}
+
+func (fdr *fakeDurationRecorder) RecordHandlingDuration(_ context.Context, inputType string, duration time.Duration, failed bool) {
+	fdr.inputType, fdr.duration, fdr.failed = inputType, duration, failed
+}
+
+func Test_MetricsMiddleware(t *testing.T) {
+	recorder := &fakeDurationRecorder{}
+	syncBus := createBusHandledBy(
+		t,
+		func(context.Context, *FakeDto) (any, error) {
+			return nil, errors.New("handler failed")
+		},
+		bus.WithMiddleware(bus.NewMetricsMiddleware(recorder, utils.NewFixedTimeProvider()).Middleware),
+	)
+
+	require.Error(t, bus.Dispatch(syncBus)(context.Background(), newFakeDto()))
+
+	assert.Equal(t, "fake_dto", recorder.inputType)
+	assert.Zero(t, recorder.duration)
+	assert.True(t, recorder.failed)
+}
+
+type spanCtxKey struct{}
+
+type fakeSpan struct {
+	name       string
+	attributes []attribute.KeyValue
+	ended      bool
+	err        error
+}
+
+func (fs *fakeSpan) End(err error) {
+	fs.ended, fs.err = true, err
+}
+
+type fakeTracer struct {
+	span *fakeSpan
+}
+
+func (ft *fakeTracer) Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, bus.Span) {
+	ft.span = &fakeSpan{name: name, attributes: attributes}
+	return context.WithValue(ctx, spanCtxKey{}, ft.span), ft.span
+}
+
+func Test_TracingMiddleware(t *testing.T) {
+	tracer, errHandler := &fakeTracer{}, errors.New("handler failed")
+	syncBus := createBusHandledBy(
+		t,
+		func(ctx context.Context, _ *FakeDto) (any, error) {
+			assert.Same(t, tracer.span, ctx.Value(spanCtxKey{}), "Expected the handler to run within the span")
+			return nil, errHandler
+		},
+		bus.WithMiddleware(bus.NewTracingMiddleware(tracer).Middleware),
+	)
+
+	require.Error(t, bus.Dispatch(syncBus)(context.Background(), newFakeDto()))
+
+	require.NotNil(t, tracer.span)
+	assert.Equal(t, "bus.handle fake_dto", tracer.span.name)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("bus.operation.input_type", "fake_dto")}, tracer.span.attributes)
+	assert.True(t, tracer.span.ended)
+	assert.ErrorIs(t, tracer.span.err, errHandler)
+}
@@ -0,0 +1,75 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	busHandlingDurationMetricName = "bus.handling.duration"
+	busFailedSemConvKey           = "bus.operation.failed"
+)
+
+// OpenTelemetryDurationRecorder records handling durations on an OpenTelemetry histogram, in seconds.
+type OpenTelemetryDurationRecorder struct {
+	histogram metric.Float64Histogram
+}
+
+func NewOpenTelemetryDurationRecorder(meter metric.Meter) (*OpenTelemetryDurationRecorder, error) {
+	histogram, err := meter.Float64Histogram(
+		busHandlingDurationMetricName,
+		metric.WithDescription("How long handling a bus input took."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating the %s histogram: %w", busHandlingDurationMetricName, err)
+	}
+
+	return &OpenTelemetryDurationRecorder{histogram: histogram}, nil
+}
+
+func (r *OpenTelemetryDurationRecorder) RecordHandlingDuration(
+	ctx context.Context,
+	inputType string,
+	duration time.Duration,
+	failed bool,
+) {
+	r.histogram.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String(busInputTypeSemConvKey, inputType),
+		attribute.Bool(busFailedSemConvKey, failed),
+	))
+}
+
+// OpenTelemetryTracer starts OpenTelemetry spans, so bus spans join the trace of the request dispatching them.
+type OpenTelemetryTracer struct {
+	tracer trace.Tracer
+}
+
+func NewOpenTelemetryTracer(tracer trace.Tracer) *OpenTelemetryTracer {
+	return &OpenTelemetryTracer{tracer: tracer}
+}
+
+func (t *OpenTelemetryTracer) Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, Span) {
+	spanCtx, span := t.tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+
+	return spanCtx, &openTelemetrySpan{span: span}
+}
+
+type openTelemetrySpan struct {
+	span trace.Span
+}
+
+func (s *openTelemetrySpan) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+
+	s.span.End()
+}
@@ -0,0 +1,36 @@
+package bus
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+)
+
+// PanicRecoverMiddleware turns a handler panicking into a HandlerPanickedError, so a single input can't take the
+// whole process down.
+type PanicRecoverMiddleware struct {
+	logger logger.ZerologLogger
+}
+
+func NewPanicRecoverMiddleware(l logger.ZerologLogger) *PanicRecoverMiddleware {
+	return &PanicRecoverMiddleware{logger: l}
+}
+
+func (prm *PanicRecoverMiddleware) Middleware(next Handler[any, Dto]) Handler[any, Dto] {
+	return HandlerFunc[any, Dto](func(ctx context.Context, input Dto) (output any, err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = NewHandlerPanickedError(input, recovered)
+				prm.logger.Error().
+					Ctx(ctx).
+					Err(err).
+					Str(busInputTypeSemConvKey, input.Type()).
+					Bytes("bus.operation.stack", debug.Stack()).
+					Msg("bus handler panicked")
+			}
+		}()
+
+		return next.Handle(ctx, input)
+	})
+}
@@ -7,14 +7,18 @@ import (
 var _ Bus = (*SyncBus)(nil)
 var _ Registerer = (*SyncBus)(nil)
 
+// SyncBus handles every input with the single handler registered for its type, wrapped with the middlewares of
+// the bus.
 type SyncBus struct {
 	handlers map[string]Handler[any, Dto]
+	config   Config
 	lock     sync.Mutex
 }
 
-func InitSyncBus() *SyncBus {
+func InitSyncBus(opts ...ConfigFunc) *SyncBus {
 	return &SyncBus{
 		handlers: make(map[string]Handler[any, Dto]),
+		config:   NewConfig(opts...),
 		lock:     sync.Mutex{},
 	}
 }
@@ -36,8 +40,8 @@ func (sb *SyncBus) Register(dto Dto, handler Handler[any, Dto]) error {
 func (sb *SyncBus) GetHandler(dto Dto) (Handler[any, Dto], error) {
 	queryName := dto.Type()
 	if handler, ok := sb.handlers[queryName]; ok {
-		return WrapAsAnyHandler(handler), nil
+		return ChainMiddlewares(WrapAsAnyHandler(handler), sb.config.Middlewares...), nil
 	}
 
-	return nil, newHandlerNotRegistered(dto)
+	return nil, NewHandlerNotRegistered(dto)
 }
@@ -3,6 +3,7 @@ package bus_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -190,8 +191,8 @@ func Test_SyncBus_DispatchBlocking(t *testing.T) {
 		t.Run(scenario.name, func(t *testing.T) {
 			syncBus, dto := scenario.bus(t), scenario.input()
 			mutex := &distributedsyncmock.MutexServiceMock{}
-			mutex.MutexFunc = func(_ context.Context, _ string, fn dsync.MutexCallback) (interface{}, error) {
-				return fn()
+			mutex.MutexFunc = func(ctx context.Context, _ string, fn dsync.MutexCallback, _ ...dsync.MutexOptFunc) (interface{}, error) {
+				return fn(ctx)
 			}
 
 			err := bus.DispatchBlocking(syncBus, mutex)(
@@ -209,6 +210,31 @@ func Test_SyncBus_DispatchBlocking(t *testing.T) {
 	}
 }
 
+func Test_SyncBus_DispatchBlockingWithResponse(t *testing.T) {
+	for _, scenario := range dispatchScenarios() {
+		t.Run(scenario.name, func(t *testing.T) {
+			syncBus, dto := scenario.bus(t), scenario.input()
+			mutex := &distributedsyncmock.MutexServiceMock{}
+			mutex.MutexFunc = func(ctx context.Context, _ string, fn dsync.MutexCallback, _ ...dsync.MutexOptFunc) (interface{}, error) {
+				return fn(ctx)
+			}
+
+			response, err := bus.DispatchBlockingWithResponse[bus.BlockingDto, *FakeResponse](syncBus, mutex)(
+				context.Background(),
+				dto.(bus.BlockingDto),
+			)
+
+			if scenarioErr := scenario.err(dto, err); scenarioErr != nil {
+				require.Error(t, err)
+				require.IsType(t, scenarioErr, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, scenario.expectedResponse(), response)
+			}
+		})
+	}
+}
+
 func Test_SyncBus_Dispatch(t *testing.T) {
 	for _, scenario := range dispatchScenarios()[1:] {
 		t.Run(scenario.name, func(t *testing.T) {
@@ -228,3 +254,22 @@ func Test_SyncBus_Dispatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_SyncBus_DispatchBlocking_TryLock(t *testing.T) {
+	syncBus := createBus()
+	require.NoError(t, syncBus.Register(&FakeDto{}, bus.WrapAsAnyHandler(&FakeHandler{})))
+
+	mutex := &distributedsyncmock.MutexServiceMock{}
+	mutex.TryMutexFunc = func(_ context.Context, key string, _ dsync.MutexCallback, _ ...dsync.MutexOptFunc) (interface{}, error) {
+		return nil, dsync.NewMutexContendedError(key, time.Second)
+	}
+
+	err := bus.DispatchBlocking(syncBus, mutex, bus.WithTryLock())(context.Background(), newFakeDto())
+
+	require.Error(t, err)
+	contended, match := dsync.AsMutexContendedError(err)
+	require.True(t, match)
+	assert.Equal(t, time.Second, contended.RetryAfter)
+	assert.Len(t, mutex.TryMutexCalls(), 1)
+	assert.Empty(t, mutex.MutexCalls())
+}
@@ -0,0 +1,53 @@
+package bus
+
+import (
+	"context"
+	"time"
+)
+
+type TimeoutMiddlewareOptFunc func(*TimeoutMiddleware)
+
+// TimeoutMiddleware bounds how long handling an input may take through the deadline of its context, which handlers
+// are expected to honour. Every input type can be given its own timeout, no timeout being applied when zero.
+type TimeoutMiddleware struct {
+	timeout  time.Duration
+	timeouts map[string]time.Duration
+}
+
+func NewTimeoutMiddleware(timeout time.Duration, opts ...TimeoutMiddlewareOptFunc) *TimeoutMiddleware {
+	tm := &TimeoutMiddleware{
+		timeout:  timeout,
+		timeouts: make(map[string]time.Duration),
+	}
+
+	for _, opt := range opts {
+		opt(tm)
+	}
+
+	return tm
+}
+
+// WithInputTimeout sets the timeout of the handler of the given input type.
+func WithInputTimeout(input Dto, timeout time.Duration) TimeoutMiddlewareOptFunc {
+	return func(tm *TimeoutMiddleware) {
+		tm.timeouts[input.Type()] = timeout
+	}
+}
+
+func (tm *TimeoutMiddleware) Middleware(next Handler[any, Dto]) Handler[any, Dto] {
+	return HandlerFunc[any, Dto](func(ctx context.Context, input Dto) (any, error) {
+		timeout, overridden := tm.timeouts[input.Type()]
+		if !overridden {
+			timeout = tm.timeout
+		}
+
+		if timeout <= 0 {
+			return next.Handle(ctx, input)
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return next.Handle(timeoutCtx, input)
+	})
+}
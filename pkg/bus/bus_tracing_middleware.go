@@ -0,0 +1,45 @@
+package bus
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const busSpanNamePrefix = "bus.handle "
+
+// Tracer starts a span for every handled input, to be backed by the tracing system of the service.
+type Tracer interface {
+	// Start starts a span with the given name and attributes, returning the context carrying it.
+	Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, Span)
+}
+
+// Span is the span of a single handled input.
+type Span interface {
+	// End ends the span, recording the error handling the input failed with, if any.
+	End(err error)
+}
+
+// TracingMiddleware handles every input within a span of its own, named after the input type.
+type TracingMiddleware struct {
+	tracer Tracer
+}
+
+func NewTracingMiddleware(tracer Tracer) *TracingMiddleware {
+	return &TracingMiddleware{tracer: tracer}
+}
+
+func (tm *TracingMiddleware) Middleware(next Handler[any, Dto]) Handler[any, Dto] {
+	return HandlerFunc[any, Dto](func(ctx context.Context, input Dto) (any, error) {
+		spanCtx, span := tm.tracer.Start(
+			ctx,
+			busSpanNamePrefix+input.Type(),
+			attribute.String(busInputTypeSemConvKey, input.Type()),
+		)
+
+		output, err := next.Handle(spanCtx, input)
+		span.End(err)
+
+		return output, err
+	})
+}
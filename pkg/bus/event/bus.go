@@ -6,6 +6,6 @@ import (
 
 type Bus = bus.Bus
 
-func InitEventBus() Bus {
-	return bus.InitSyncBus()
+func InitEventBus(opts ...bus.ConfigFunc) Bus {
+	return InitFanOutBus(opts...)
 }
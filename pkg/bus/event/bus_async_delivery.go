@@ -0,0 +1,109 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+)
+
+// aggregateEvent is implemented by domain events, which are ordered per aggregate.
+type aggregateEvent interface {
+	AggregateID() string
+}
+
+type asyncEnvelope struct {
+	ctx   context.Context
+	event bus.Dto
+}
+
+// asyncDelivery hands events to a subscriber from a fixed set of workers, every event being queued to the worker its
+// ordering key maps to. Events sharing the key are queued to the same worker, so they're handled in the order they
+// were dispatched while events of other keys are handled concurrently.
+type asyncDelivery struct {
+	handler bus.Handler[any, bus.Dto]
+	onError AsyncErrorHandler
+	queues  []chan asyncEnvelope
+	workers sync.WaitGroup
+}
+
+func newAsyncDelivery(handler bus.Handler[any, bus.Dto], options SubscriptionOptions) *asyncDelivery {
+	delivery := &asyncDelivery{
+		handler: handler,
+		onError: options.ErrorHandler,
+		queues:  make([]chan asyncEnvelope, max(options.Workers, 1)),
+		workers: sync.WaitGroup{},
+	}
+
+	for i := range delivery.queues {
+		delivery.queues[i] = make(chan asyncEnvelope, max(options.QueueSize, 0))
+		delivery.workers.Add(1)
+		go delivery.work(delivery.queues[i])
+	}
+
+	return delivery
+}
+
+// enqueue queues the event out of the cancellation of the dispatch, waiting for room in the queue as long as the
+// dispatch context lasts.
+func (ad *asyncDelivery) enqueue(ctx context.Context, event bus.Dto) error {
+	queue := ad.queues[orderingShard(event, len(ad.queues))]
+
+	select {
+	case queue <- asyncEnvelope{ctx: context.WithoutCancel(ctx), event: event}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to enqueue %s event: %w", event.Type(), ctx.Err())
+	}
+}
+
+func (ad *asyncDelivery) work(queue <-chan asyncEnvelope) {
+	defer ad.workers.Done()
+
+	for envelope := range queue {
+		if err := ad.deliver(envelope); err != nil && ad.onError != nil {
+			ad.onError(envelope.ctx, envelope.event, err)
+		}
+	}
+}
+
+// deliver hands the event to the subscriber, turning a panic into an error so the worker keeps running.
+func (ad *asyncDelivery) deliver(envelope asyncEnvelope) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = bus.NewHandlerPanickedError(envelope.event, recovered)
+		}
+	}()
+
+	_, err = ad.handler.Handle(envelope.ctx, envelope.event)
+	return err
+}
+
+// close stops accepting events, the workers returning once their queue is drained.
+func (ad *asyncDelivery) close() {
+	for _, queue := range ad.queues {
+		close(queue)
+	}
+}
+
+func (ad *asyncDelivery) wait() {
+	ad.workers.Wait()
+}
+
+// orderingShard maps the event to a worker by its blocking key, by its aggregate for domain events, or by its type.
+func orderingShard(event bus.Dto, shards int) int {
+	key := event.Type()
+	switch keyed := event.(type) {
+	case bus.BlockingDto:
+		key = keyed.BlockingKey()
+	case aggregateEvent:
+		key = keyed.AggregateID()
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key))
+
+	return int(hash.Sum32() % uint32(shards))
+}
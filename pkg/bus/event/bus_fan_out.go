@@ -0,0 +1,168 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+)
+
+var _ bus.Bus = (*FanOutBus)(nil)
+var _ bus.Registerer = (*FanOutBus)(nil)
+
+var (
+	ErrEventBusClosed        = errors.New("event bus closed")
+	errUnexpectedBusProvided = errors.New("unexpected bus provided expected fan-out event bus")
+)
+
+// FanOutBus delivers every event to all the handlers subscribed to its type. Synchronous subscribers handle it one
+// after the other in subscription order within the dispatch, while asynchronous ones get it queued to their own
+// workers, ordered per blocking key. Subscribers are isolated from each other: every one of them gets the event even
+// when others failed, the dispatch failing with all their errors. Every subscriber is wrapped with the middlewares of
+// the bus on its own.
+type FanOutBus struct {
+	subscriptions map[string][]subscription
+	config        bus.Config
+	closed        bool
+	lock          sync.RWMutex
+}
+
+func InitFanOutBus(opts ...bus.ConfigFunc) *FanOutBus {
+	return &FanOutBus{
+		subscriptions: make(map[string][]subscription),
+		config:        bus.NewConfig(opts...),
+		closed:        false,
+		lock:          sync.RWMutex{},
+	}
+}
+
+// Register subscribes the handler to the events of the given type with synchronous delivery.
+func (fb *FanOutBus) Register(dto bus.Dto, handler bus.Handler[any, bus.Dto]) error {
+	return fb.Subscribe(dto, handler)
+}
+
+// Subscribe subscribes the handler to the events of the given type, synchronously unless told otherwise.
+func (fb *FanOutBus) Subscribe(dto bus.Dto, handler bus.Handler[any, bus.Dto], opts ...SubscriptionOptFunc) error {
+	fb.lock.Lock()
+	defer fb.lock.Unlock()
+
+	if fb.closed {
+		return ErrEventBusClosed
+	}
+
+	options := NewSubscriptionOptions(opts...)
+	subscriber := subscription{handler: bus.ChainMiddlewares(handler, fb.config.Middlewares...), async: nil}
+	if options.Delivery == DeliveryAsync {
+		subscriber.async = newAsyncDelivery(subscriber.handler, options)
+	}
+
+	fb.subscriptions[dto.Type()] = append(fb.subscriptions[dto.Type()], subscriber)
+
+	return nil
+}
+
+// GetHandler returns a handler delivering the event to every subscriber, its output is the one of the first
+// synchronous subscriber as it's the one owning the event.
+func (fb *FanOutBus) GetHandler(dto bus.Dto) (bus.Handler[any, bus.Dto], error) {
+	fb.lock.RLock()
+	defer fb.lock.RUnlock()
+
+	subscriptions, ok := fb.subscriptions[dto.Type()]
+	if !ok {
+		return nil, bus.NewHandlerNotRegistered(dto)
+	}
+
+	return &subscribersHandler{bus: fb, subscriptions: subscriptions}, nil
+}
+
+// Close stops accepting events and waits for the asynchronous subscribers to handle the ones already queued, or for
+// the context to be done.
+func (fb *FanOutBus) Close(ctx context.Context) error {
+	fb.lock.Lock()
+	if fb.closed {
+		fb.lock.Unlock()
+		return nil
+	}
+
+	fb.closed = true
+	deliveries := make([]*asyncDelivery, 0)
+	for _, subscriptions := range fb.subscriptions {
+		for _, subscriber := range subscriptions {
+			if subscriber.async != nil {
+				subscriber.async.close()
+				deliveries = append(deliveries, subscriber.async)
+			}
+		}
+	}
+	fb.lock.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		for _, delivery := range deliveries {
+			delivery.wait()
+		}
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event bus closed before draining its subscribers: %w", ctx.Err())
+	}
+}
+
+// enqueue queues the event to an asynchronous subscriber, the bus being read locked so it can't be closed meanwhile.
+func (fb *FanOutBus) enqueue(ctx context.Context, delivery *asyncDelivery, dto bus.Dto) error {
+	fb.lock.RLock()
+	defer fb.lock.RUnlock()
+
+	if fb.closed {
+		return ErrEventBusClosed
+	}
+
+	return delivery.enqueue(ctx, dto)
+}
+
+type subscribersHandler struct {
+	bus           *FanOutBus
+	subscriptions []subscription
+}
+
+func (sh *subscribersHandler) Handle(ctx context.Context, dto bus.Dto) (any, error) {
+	var output any
+	errs, handled := make([]error, 0), false
+	for i, subscriber := range sh.subscriptions {
+		if subscriber.async != nil {
+			continue
+		}
+
+		out, err := subscriber.handler.Handle(ctx, dto)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event subscriber #%d failed: %w", i, err))
+			continue
+		}
+
+		if !handled {
+			output, handled = out, true
+		}
+	}
+
+	for i, subscriber := range sh.subscriptions {
+		if subscriber.async == nil {
+			continue
+		}
+
+		if err := sh.bus.enqueue(ctx, subscriber.async, dto); err != nil {
+			errs = append(errs, fmt.Errorf("event subscriber #%d failed: %w", i, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,237 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	eventbus "github.com/soulcodex/rockets-message-processor/pkg/bus/event"
+)
+
+type fakeEvent struct{}
+
+func (fe *fakeEvent) Type() string {
+	return "fake_event"
+}
+
+type fakeSubscriber struct {
+	name     string
+	err      error
+	received *[]string
+}
+
+func (fs fakeSubscriber) Handle(_ context.Context, _ *fakeEvent) (string, error) {
+	*fs.received = append(*fs.received, fs.name)
+	return fs.name, fs.err
+}
+
+func TestFanOutBus_Dispatch(t *testing.T) {
+	errSubscriber := errors.New("subscriber failed")
+
+	tests := []struct {
+		name             string
+		subscribers      []string
+		failing          string
+		expectedReceived []string
+		expectedOutput   string
+		expectedErr      bool
+	}{
+		{
+			name:             "should deliver the event to every subscriber in order",
+			subscribers:      []string{"first", "second", "third"},
+			expectedReceived: []string{"first", "second", "third"},
+			expectedOutput:   "first",
+		},
+		{
+			name:             "should keep delivering to the subscribers after a failing one",
+			subscribers:      []string{"first", "second", "third"},
+			failing:          "second",
+			expectedReceived: []string{"first", "second", "third"},
+			expectedErr:      true,
+		},
+		{
+			name:             "should not answer with the output of a failing subscriber",
+			subscribers:      []string{"first", "second"},
+			failing:          "first",
+			expectedReceived: []string{"first", "second"},
+			expectedErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventBus, received := eventbus.InitFanOutBus(), make([]string, 0)
+			for _, name := range tt.subscribers {
+				subscriber := fakeSubscriber{name: name, received: &received}
+				if name == tt.failing {
+					subscriber.err = errSubscriber
+				}
+				bus.MustRegister(eventBus, &fakeEvent{}, subscriber)
+			}
+
+			output, err := bus.DispatchWithResponse[*fakeEvent, string](eventBus)(context.Background(), &fakeEvent{})
+			if tt.expectedErr {
+				require.ErrorIs(t, err, errSubscriber)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedOutput, output)
+			assert.Equal(t, tt.expectedReceived, received)
+		})
+	}
+
+	t.Run("should fail without subscribers", func(t *testing.T) {
+		err := bus.Dispatch(eventbus.InitFanOutBus())(context.Background(), &fakeEvent{})
+		var notRegistered *bus.HandlerNotRegisteredError
+		assert.ErrorAs(t, err, &notRegistered)
+	})
+}
+
+func TestFanOutBus_WrapsEverySubscriberWithMiddlewares(t *testing.T) {
+	received, wrapped := make([]string, 0), 0
+	eventBus := eventbus.InitFanOutBus(bus.WithMiddleware(func(next bus.Handler[any, bus.Dto]) bus.Handler[any, bus.Dto] {
+		return bus.HandlerFunc[any, bus.Dto](func(ctx context.Context, input bus.Dto) (any, error) {
+			wrapped++
+			return next.Handle(ctx, input)
+		})
+	}))
+	bus.MustRegister(eventBus, &fakeEvent{}, fakeSubscriber{name: "first", received: &received})
+	bus.MustRegister(eventBus, &fakeEvent{}, fakeSubscriber{name: "second", received: &received})
+
+	require.NoError(t, bus.Dispatch(eventBus)(context.Background(), &fakeEvent{}))
+
+	assert.Equal(t, []string{"first", "second"}, received)
+	assert.Equal(t, 2, wrapped)
+}
+
+type fakeKeyedEvent struct {
+	key      string
+	sequence int
+}
+
+func (fke *fakeKeyedEvent) Type() string {
+	return "fake_keyed_event"
+}
+
+func (fke *fakeKeyedEvent) BlockingKey() string {
+	return fke.key
+}
+
+// keyedRecorder records the sequence of the events handled per key.
+type keyedRecorder struct {
+	lock     sync.Mutex
+	received map[string][]int
+}
+
+func (kr *keyedRecorder) Handle(_ context.Context, event *fakeKeyedEvent) (any, error) {
+	kr.lock.Lock()
+	defer kr.lock.Unlock()
+
+	kr.received[event.key] = append(kr.received[event.key], event.sequence)
+	return nil, nil
+}
+
+func TestFanOutBus_AsyncDelivery(t *testing.T) {
+	t.Run("should deliver the events of a key in dispatch order", func(t *testing.T) {
+		eventBus, recorder := eventbus.InitFanOutBus(), &keyedRecorder{received: make(map[string][]int)}
+		eventbus.MustSubscribe(eventBus, &fakeKeyedEvent{}, recorder, eventbus.WithAsyncDelivery(), eventbus.WithAsyncWorkers(3))
+
+		keys, expected := []string{"alpha", "bravo", "charlie", "delta"}, make(map[string][]int)
+		for sequence := range 50 {
+			for _, key := range keys {
+				require.NoError(t, bus.Dispatch(eventBus)(context.Background(), &fakeKeyedEvent{key: key, sequence: sequence}))
+				expected[key] = append(expected[key], sequence)
+			}
+		}
+
+		require.NoError(t, eventBus.Close(context.Background()))
+		assert.Equal(t, expected, recorder.received)
+	})
+
+	t.Run("should tell the error handler about failures without failing the dispatch", func(t *testing.T) {
+		errSubscriber, failures := errors.New("subscriber failed"), make(chan error, 2)
+		received := make([]string, 0)
+		eventBus := eventbus.InitFanOutBus()
+		bus.MustRegister(eventBus, &fakeKeyedEvent{}, fakeSubscriberOf[*fakeKeyedEvent]("sync", &received))
+		eventbus.MustSubscribe(
+			eventBus,
+			&fakeKeyedEvent{},
+			bus.HandlerFunc[any, *fakeKeyedEvent](func(context.Context, *fakeKeyedEvent) (any, error) {
+				return nil, errSubscriber
+			}),
+			eventbus.WithAsyncDelivery(),
+			eventbus.WithAsyncErrorHandler(func(_ context.Context, _ bus.Dto, err error) { failures <- err }),
+		)
+		eventbus.MustSubscribe(
+			eventBus,
+			&fakeKeyedEvent{},
+			bus.HandlerFunc[any, *fakeKeyedEvent](func(context.Context, *fakeKeyedEvent) (any, error) {
+				panic("boom")
+			}),
+			eventbus.WithAsyncDelivery(),
+			eventbus.WithAsyncErrorHandler(func(_ context.Context, _ bus.Dto, err error) { failures <- err }),
+		)
+
+		output, err := bus.DispatchWithResponse[*fakeKeyedEvent, string](eventBus)(context.Background(), &fakeKeyedEvent{key: "alpha"})
+		require.NoError(t, err)
+		require.NoError(t, eventBus.Close(context.Background()))
+		close(failures)
+
+		assert.Equal(t, "sync", output)
+		assert.Equal(t, []string{"sync"}, received)
+
+		errs := make([]error, 0)
+		for failure := range failures {
+			errs = append(errs, failure)
+		}
+		require.Len(t, errs, 2)
+		assert.True(t, errors.Is(errs[0], errSubscriber) || errors.Is(errs[1], errSubscriber))
+		assert.True(t, bus.IsHandlerPanickedError(errs[0]) || bus.IsHandlerPanickedError(errs[1]))
+	})
+
+	t.Run("should stop accepting events once closed", func(t *testing.T) {
+		eventBus := eventbus.InitFanOutBus()
+		eventbus.MustSubscribe(eventBus, &fakeKeyedEvent{}, &keyedRecorder{received: make(map[string][]int)}, eventbus.WithAsyncDelivery())
+		require.NoError(t, eventBus.Close(context.Background()))
+
+		err := bus.Dispatch(eventBus)(context.Background(), &fakeKeyedEvent{key: "alpha"})
+		require.ErrorIs(t, err, eventbus.ErrEventBusClosed)
+		assert.ErrorIs(t, eventBus.Subscribe(&fakeKeyedEvent{}, nil), eventbus.ErrEventBusClosed)
+	})
+
+	t.Run("should give up draining once the context is done", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		eventBus := eventbus.InitFanOutBus()
+		eventbus.MustSubscribe(
+			eventBus,
+			&fakeKeyedEvent{},
+			bus.HandlerFunc[any, *fakeKeyedEvent](func(context.Context, *fakeKeyedEvent) (any, error) {
+				<-release
+				return nil, nil
+			}),
+			eventbus.WithAsyncDelivery(),
+		)
+		require.NoError(t, bus.Dispatch(eventBus)(context.Background(), &fakeKeyedEvent{key: "alpha"}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, eventBus.Close(ctx), context.DeadlineExceeded)
+	})
+}
+
+func fakeSubscriberOf[Event bus.Dto](name string, received *[]string) bus.HandlerFunc[any, Event] {
+	return func(context.Context, Event) (any, error) {
+		*received = append(*received, name)
+		return name, nil
+	}
+}
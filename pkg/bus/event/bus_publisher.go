@@ -0,0 +1,40 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+)
+
+var _ domain.EventPublisher = (*BusPublisher)(nil)
+
+// BusPublisher publishes domain events on the event bus in the order they were raised, events
+// nobody subscribed to are left out.
+type BusPublisher struct {
+	bus Bus
+}
+
+func NewBusPublisher(eventBus Bus) *BusPublisher {
+	return &BusPublisher{bus: eventBus}
+}
+
+func (p *BusPublisher) Publish(ctx context.Context, events ...domain.Event) error {
+	for _, event := range events {
+		handler, err := p.bus.GetHandler(event)
+		if bus.IsHandlerNotRegisteredError(err) {
+			continue
+		}
+
+		if err != nil {
+			return bus.ErrNoHandlerForInput(event, err)
+		}
+
+		if _, handleErr := handler.Handle(ctx, event); handleErr != nil {
+			return fmt.Errorf("failed to publish %s event: %w", event.Type(), handleErr)
+		}
+	}
+
+	return nil
+}
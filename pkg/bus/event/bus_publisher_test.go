@@ -0,0 +1,80 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	eventbus "github.com/soulcodex/rockets-message-processor/pkg/bus/event"
+)
+
+type fakeDomainEvent struct {
+	eventType string
+}
+
+func (fe *fakeDomainEvent) Type() string {
+	return fe.eventType
+}
+
+func (fe *fakeDomainEvent) AggregateID() string {
+	return "aggregate"
+}
+
+func (fe *fakeDomainEvent) OccurredOn() time.Time {
+	return time.Time{}
+}
+
+type fakeDomainEventSubscriber struct {
+	err      error
+	received *[]string
+}
+
+func (fs fakeDomainEventSubscriber) Handle(_ context.Context, evt *fakeDomainEvent) (interface{}, error) {
+	*fs.received = append(*fs.received, evt.eventType)
+	return struct{}{}, fs.err
+}
+
+func TestBusPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should publish subscribed events in order skipping the rest", func(t *testing.T) {
+		received := make([]string, 0)
+		eventBus := eventbus.InitEventBus()
+		bus.MustRegister(eventBus, &fakeDomainEvent{eventType: "created"}, fakeDomainEventSubscriber{received: &received})
+		bus.MustRegister(eventBus, &fakeDomainEvent{eventType: "changed"}, fakeDomainEventSubscriber{received: &received})
+
+		err := eventbus.NewBusPublisher(eventBus).Publish(
+			ctx,
+			&fakeDomainEvent{eventType: "created"},
+			&fakeDomainEvent{eventType: "unsubscribed"},
+			&fakeDomainEvent{eventType: "changed"},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"created", "changed"}, received)
+	})
+
+	t.Run("should stop publishing on the first failing event", func(t *testing.T) {
+		received := make([]string, 0)
+		eventBus := eventbus.InitEventBus()
+		bus.MustRegister(eventBus, &fakeDomainEvent{eventType: "created"}, fakeDomainEventSubscriber{
+			err:      errors.New("subscriber failed"),
+			received: &received,
+		})
+		bus.MustRegister(eventBus, &fakeDomainEvent{eventType: "changed"}, fakeDomainEventSubscriber{received: &received})
+
+		err := eventbus.NewBusPublisher(eventBus).Publish(
+			ctx,
+			&fakeDomainEvent{eventType: "created"},
+			&fakeDomainEvent{eventType: "changed"},
+		)
+
+		require.ErrorContains(t, err, "failed to publish created event")
+		assert.Equal(t, []string{"created"}, received)
+	})
+}
@@ -0,0 +1,91 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+)
+
+// Delivery tells whether events are handled by a subscriber within the dispatch or later on by workers of its own.
+type Delivery string
+
+const (
+	DeliverySync  Delivery = "sync"
+	DeliveryAsync Delivery = "async"
+)
+
+const (
+	defaultAsyncWorkers   = 4
+	defaultAsyncQueueSize = 128
+)
+
+// AsyncErrorHandler is told about the events an asynchronous subscriber failed to handle, as nobody waits for them.
+type AsyncErrorHandler func(ctx context.Context, event bus.Dto, err error)
+
+type SubscriptionOptFunc func(*SubscriptionOptions)
+
+// SubscriptionOptions sets how events are delivered to a subscriber, either synchronously or asynchronously by a
+// number of workers each having a queue of the given size, along with who is told about asynchronous failures.
+type SubscriptionOptions struct {
+	Delivery     Delivery
+	Workers      int
+	QueueSize    int
+	ErrorHandler AsyncErrorHandler
+}
+
+func NewSubscriptionOptions(opts ...SubscriptionOptFunc) SubscriptionOptions {
+	options := SubscriptionOptions{
+		Delivery:     DeliverySync,
+		Workers:      defaultAsyncWorkers,
+		QueueSize:    defaultAsyncQueueSize,
+		ErrorHandler: nil,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+func WithAsyncDelivery() SubscriptionOptFunc {
+	return func(so *SubscriptionOptions) {
+		so.Delivery = DeliveryAsync
+	}
+}
+
+func WithAsyncWorkers(workers int) SubscriptionOptFunc {
+	return func(so *SubscriptionOptions) {
+		so.Workers = workers
+	}
+}
+
+func WithAsyncQueueSize(size int) SubscriptionOptFunc {
+	return func(so *SubscriptionOptions) {
+		so.QueueSize = size
+	}
+}
+
+func WithAsyncErrorHandler(handler AsyncErrorHandler) SubscriptionOptFunc {
+	return func(so *SubscriptionOptions) {
+		so.ErrorHandler = handler
+	}
+}
+
+// subscription is a subscriber of an event type, delivered synchronously unless it has an async delivery.
+type subscription struct {
+	handler bus.Handler[any, bus.Dto]
+	async   *asyncDelivery
+}
+
+// MustSubscribe subscribes the handler to the events of the given type on a fan-out bus, panicking otherwise.
+func MustSubscribe[Output, Input any](eventBus Bus, event bus.Dto, handler bus.Handler[Output, Input], opts ...SubscriptionOptFunc) {
+	fanOutBus, isFanOutBus := eventBus.(*FanOutBus)
+	if !isFanOutBus {
+		panic(errUnexpectedBusProvided)
+	}
+
+	if err := fanOutBus.Subscribe(event, bus.WrapAsAnyHandler(handler), opts...); err != nil {
+		panic(err)
+	}
+}
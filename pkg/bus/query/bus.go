@@ -6,6 +6,6 @@ import (
 
 type Bus = bus.Bus
 
-func InitQueryBus() Bus {
-	return bus.InitSyncBus()
+func InitQueryBus(opts ...bus.ConfigFunc) Bus {
+	return bus.InitSyncBus(opts...)
 }
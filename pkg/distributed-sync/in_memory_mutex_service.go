@@ -0,0 +1,115 @@
+package distributedsync
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// inMemoryMutexLock is the lock of a key, counting the callers holding or waiting for it so it's dropped once the
+// last of them is done.
+type inMemoryMutexLock struct {
+	held chan struct{}
+	refs int
+}
+
+// InMemoryMutexService serializes callbacks within the process through a lock per key, kept only while someone
+// holds or waits for it. Waiting for a lock stops as soon as the context is done or the wait budget is spent. Locks
+// never expire, so fencing tokens just come from a single counter shared by every key.
+type InMemoryMutexService struct {
+	options *MutexServiceOptions
+	mutex   sync.Mutex
+	locks   map[string]*inMemoryMutexLock
+	fencing atomic.Uint64
+}
+
+func NewInMemoryMutexService(options ...MutexServiceOptFunc) *InMemoryMutexService {
+	return &InMemoryMutexService{
+		options: NewMutexServiceOptions(options...),
+		mutex:   sync.Mutex{},
+		locks:   make(map[string]*inMemoryMutexLock),
+	}
+}
+
+func (m *InMemoryMutexService) Mutex(ctx context.Context, key string, fn MutexCallback, opts ...MutexOptFunc) (interface{}, error) {
+	options := NewMutexOptions(m.options.Lock, opts...)
+	lock := m.acquire(key)
+	defer m.release(key, lock)
+
+	var budget <-chan time.Time
+	if options.WaitBudget > 0 {
+		timer := time.NewTimer(options.WaitBudget)
+		defer timer.Stop()
+		budget = timer.C
+	}
+
+	select {
+	case lock.held <- struct{}{}:
+	case <-budget:
+		return nil, NewMutexContendedError(key, options.RetryDelay)
+	case <-ctx.Done():
+		lockingErr := NewMutexLockingError(key)
+		lockingErr.Wrap(ctx.Err())
+		return nil, lockingErr
+	}
+
+	return m.run(ctx, key, lock, fn)
+}
+
+func (m *InMemoryMutexService) TryMutex(ctx context.Context, key string, fn MutexCallback, opts ...MutexOptFunc) (interface{}, error) {
+	options := NewMutexOptions(m.options.Lock, opts...)
+	lock := m.acquire(key)
+	defer m.release(key, lock)
+
+	select {
+	case lock.held <- struct{}{}:
+	default:
+		return nil, NewMutexContendedError(key, options.RetryDelay)
+	}
+
+	return m.run(ctx, key, lock, fn)
+}
+
+// Len returns how many keys have a lock, held or waited for.
+func (m *InMemoryMutexService) Len() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return len(m.locks)
+}
+
+func (m *InMemoryMutexService) run(ctx context.Context, key string, lock *inMemoryMutexLock, fn MutexCallback) (interface{}, error) {
+	defer func() {
+		<-lock.held
+	}()
+
+	return fn(WithFencingToken(ctx, FencingToken{Key: key, Value: m.fencing.Add(1)}))
+}
+
+// acquire returns the lock of the key, creating it for its first caller.
+func (m *InMemoryMutexService) acquire(key string) *inMemoryMutexLock {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	name := m.options.namespacedKey(mutexName, key)
+	lock, exists := m.locks[name]
+	if !exists {
+		lock = &inMemoryMutexLock{held: make(chan struct{}, 1), refs: 0}
+		m.locks[name] = lock
+	}
+	lock.refs++
+
+	return lock
+}
+
+// release drops the lock of the key once its last caller is done with it.
+func (m *InMemoryMutexService) release(key string, lock *inMemoryMutexLock) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	lock.refs--
+	if lock.refs == 0 {
+		delete(m.locks, m.options.namespacedKey(mutexName, key))
+	}
+}
@@ -0,0 +1,127 @@
+package distributedsync_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+)
+
+func TestInMemoryMutexService_SerializesKey(t *testing.T) {
+	mutex := distributedsync.NewInMemoryMutexService()
+
+	const callers = 50
+	counter, wg := 0, sync.WaitGroup{}
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := mutex.Mutex(t.Context(), "rocket", func(context.Context) (interface{}, error) {
+				current := counter
+				counter = current + 1
+				return nil, nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, callers, counter)
+}
+
+func TestInMemoryMutexService_StopsWaitingOnCancellation(t *testing.T) {
+	mutex := distributedsync.NewInMemoryMutexService()
+	ctx, cancel := context.WithCancel(t.Context())
+
+	result, err := mutex.Mutex(t.Context(), "rocket", func(context.Context) (interface{}, error) {
+		cancel()
+		return mutex.Mutex(ctx, "rocket", func(context.Context) (interface{}, error) {
+			return "reentered", nil
+		})
+	})
+
+	require.Error(t, err)
+	assert.IsType(t, &distributedsync.MutexLockingError{}, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, result)
+}
+
+func TestInMemoryMutexService_Contention(t *testing.T) {
+	testCases := []struct {
+		name string
+		lock func(mutex *distributedsync.InMemoryMutexService, fn distributedsync.MutexCallback) (interface{}, error)
+	}{
+		{
+			name: "try fails right away",
+			lock: func(mutex *distributedsync.InMemoryMutexService, fn distributedsync.MutexCallback) (interface{}, error) {
+				return mutex.TryMutex(t.Context(), "rocket", fn, distributedsync.WithLockRetryDelay(time.Second))
+			},
+		},
+		{
+			name: "wait fails once the budget is spent",
+			lock: func(mutex *distributedsync.InMemoryMutexService, fn distributedsync.MutexCallback) (interface{}, error) {
+				return mutex.Mutex(
+					t.Context(),
+					"rocket",
+					fn,
+					distributedsync.WithLockWaitBudget(10*time.Millisecond),
+					distributedsync.WithLockRetryDelay(time.Second),
+				)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mutex := distributedsync.NewInMemoryMutexService()
+
+			_, err := mutex.Mutex(t.Context(), "rocket", func(context.Context) (interface{}, error) {
+				return tc.lock(mutex, func(context.Context) (interface{}, error) {
+					return "reentered", nil
+				})
+			})
+
+			require.Error(t, err)
+			contended, match := distributedsync.AsMutexContendedError(err)
+			require.True(t, match)
+			assert.Equal(t, time.Second, contended.RetryAfter)
+		})
+	}
+}
+
+func TestInMemoryMutexService_TryMutexRunsWhenFree(t *testing.T) {
+	mutex := distributedsync.NewInMemoryMutexService()
+
+	result, err := mutex.TryMutex(t.Context(), "rocket", func(context.Context) (interface{}, error) {
+		return "locked", nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "locked", result)
+}
+
+func TestInMemoryMutexService_UnrelatedKeysDontContend(t *testing.T) {
+	mutex := distributedsync.NewInMemoryMutexService()
+
+	_, err := mutex.Mutex(t.Context(), "rocket:held", func(context.Context) (interface{}, error) {
+		for i := range 1000 {
+			_, tryErr := mutex.TryMutex(t.Context(), fmt.Sprintf("rocket:%d", i), func(context.Context) (interface{}, error) {
+				return nil, nil
+			})
+			if tryErr != nil {
+				return nil, tryErr
+			}
+		}
+
+		return nil, nil
+	})
+
+	require.NoError(t, err, "Expected keys other than the held one to be locked right away")
+	assert.Zero(t, mutex.Len(), "Expected locks to be dropped once released")
+}
@@ -0,0 +1,61 @@
+package distributedsync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+const inMemoryRateLimiterMinPruneSize = 1024
+
+// InMemoryRateLimiter applies the rate limits within the process. Keys whose theoretical arrival time is behind are
+// back to a full burst, so they're dropped every time the number of keys doubles.
+type InMemoryRateLimiter struct {
+	mutex        sync.Mutex
+	arrivals     map[string]time.Time
+	pruneSize    int
+	timeProvider utils.DateTimeProvider
+}
+
+func NewInMemoryRateLimiter(timeProvider utils.DateTimeProvider) *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{
+		arrivals:     make(map[string]time.Time),
+		pruneSize:    inMemoryRateLimiterMinPruneSize,
+		timeProvider: timeProvider,
+	}
+}
+
+func (rl *InMemoryRateLimiter) Allow(_ context.Context, key string, limit RateLimit) error {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	now := rl.timeProvider.Now()
+	if len(rl.arrivals) >= rl.pruneSize {
+		rl.prune(now)
+	}
+
+	arrival, tracked := rl.arrivals[key]
+	if !tracked || arrival.Before(now) {
+		arrival = now
+	}
+
+	next := arrival.Add(limit.emissionInterval())
+	if wait := next.Sub(now) - limit.tolerance(); wait > 0 {
+		return NewRateLimitExceededError(key, wait)
+	}
+
+	rl.arrivals[key] = next
+	return nil
+}
+
+func (rl *InMemoryRateLimiter) prune(now time.Time) {
+	for key, arrival := range rl.arrivals {
+		if !arrival.After(now) {
+			delete(rl.arrivals, key)
+		}
+	}
+
+	rl.pruneSize = max(inMemoryRateLimiterMinPruneSize, 2*len(rl.arrivals))
+}
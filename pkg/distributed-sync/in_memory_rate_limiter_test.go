@@ -0,0 +1,56 @@
+package distributedsync_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestInMemoryRateLimiter_Allow(t *testing.T) {
+	testCases := []struct {
+		name       string
+		limit      distributedsync.RateLimit
+		allowed    int
+		retryAfter time.Duration
+	}{
+		{name: "bursts up to the rate per second", limit: distributedsync.PerSecond(5), allowed: 5, retryAfter: 200 * time.Millisecond},
+		{
+			name:       "smaller bursts spread the rate",
+			limit:      distributedsync.RateLimit{Rate: 10, Period: time.Second, Burst: 2},
+			allowed:    2,
+			retryAfter: 100 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)}
+			limiter := distributedsync.NewInMemoryRateLimiter(clock)
+
+			for range tc.allowed {
+				require.NoError(t, limiter.Allow(t.Context(), "channel", tc.limit))
+			}
+
+			err := limiter.Allow(t.Context(), "channel", tc.limit)
+			exceeded, match := distributedsync.AsRateLimitExceededError(err)
+			require.True(t, match, "Expected requests over the burst to be rejected")
+			assert.Equal(t, tc.retryAfter, exceeded.RetryAfter)
+			assert.NoError(t, limiter.Allow(t.Context(), "other-channel", tc.limit), "Expected keys to be limited apart")
+
+			clock.now = clock.now.Add(exceeded.RetryAfter)
+			assert.NoError(t, limiter.Allow(t.Context(), "channel", tc.limit), "Expected requests to fit again after waiting")
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package distributedsync
+
+import (
+	"context"
+	"sync"
+)
+
+// InMemorySemaphoreService counts the permits held within the process, permits never expire as their holders
+// can't crash without the whole process doing so.
+type InMemorySemaphoreService struct {
+	options *MutexServiceOptions
+	mutex   sync.Mutex
+	holders map[string]int
+}
+
+func NewInMemorySemaphoreService(options ...MutexServiceOptFunc) *InMemorySemaphoreService {
+	return &InMemorySemaphoreService{options: NewMutexServiceOptions(options...), holders: make(map[string]int)}
+}
+
+func (s *InMemorySemaphoreService) Semaphore(
+	ctx context.Context,
+	key string,
+	permits int,
+	fn MutexCallback,
+	opts ...MutexOptFunc,
+) (interface{}, error) {
+	return s.run(ctx, key, permits, fn, NewMutexOptions(s.options.Lock, opts...), true)
+}
+
+func (s *InMemorySemaphoreService) TrySemaphore(
+	ctx context.Context,
+	key string,
+	permits int,
+	fn MutexCallback,
+	opts ...MutexOptFunc,
+) (interface{}, error) {
+	return s.run(ctx, key, permits, fn, NewMutexOptions(s.options.Lock, opts...), false)
+}
+
+func (s *InMemorySemaphoreService) run(
+	ctx context.Context,
+	key string,
+	permits int,
+	fn MutexCallback,
+	options MutexOptions,
+	wait bool,
+) (interface{}, error) {
+	taken, err := waitForPermit(ctx, options, wait, func() (bool, error) {
+		return s.take(key, permits), nil
+	})
+	if err != nil {
+		lockingErr := NewMutexLockingError(key)
+		lockingErr.Wrap(err)
+		return nil, lockingErr
+	}
+
+	if !taken {
+		return nil, NewSemaphoreExhaustedError(key, permits, options.RetryDelay)
+	}
+
+	defer s.release(key)
+
+	return fn(ctx)
+}
+
+func (s *InMemorySemaphoreService) take(key string, permits int) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.holders[key] >= permits {
+		return false
+	}
+
+	s.holders[key]++
+	return true
+}
+
+func (s *InMemorySemaphoreService) release(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.holders[key]--; s.holders[key] <= 0 {
+		delete(s.holders, key)
+	}
+}
@@ -0,0 +1,87 @@
+package distributedsync_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+)
+
+func TestInMemorySemaphoreService_LimitsConcurrency(t *testing.T) {
+	semaphore := distributedsync.NewInMemorySemaphoreService(
+		distributedsync.WithLockDefaults(distributedsync.WithLockRetryDelay(time.Millisecond)),
+	)
+
+	const permits, callers = 3, 20
+	running, peak, mutex, wg := 0, 0, sync.Mutex{}, sync.WaitGroup{}
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := semaphore.Semaphore(t.Context(), "webhooks", permits, func(context.Context) (interface{}, error) {
+				mutex.Lock()
+				running++
+				peak = max(peak, running)
+				mutex.Unlock()
+
+				time.Sleep(2 * time.Millisecond)
+
+				mutex.Lock()
+				running--
+				mutex.Unlock()
+				return nil, nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak, permits)
+}
+
+func TestInMemorySemaphoreService_Exhausted(t *testing.T) {
+	testCases := []struct {
+		name    string
+		acquire func(semaphore *distributedsync.InMemorySemaphoreService) (interface{}, error)
+	}{
+		{
+			name: "try fails right away",
+			acquire: func(semaphore *distributedsync.InMemorySemaphoreService) (interface{}, error) {
+				return semaphore.TrySemaphore(t.Context(), "webhooks", 1, func(context.Context) (interface{}, error) {
+					return "acquired", nil
+				}, distributedsync.WithLockRetryDelay(time.Second))
+			},
+		},
+		{
+			name: "wait fails once the budget is spent",
+			acquire: func(semaphore *distributedsync.InMemorySemaphoreService) (interface{}, error) {
+				return semaphore.Semaphore(t.Context(), "webhooks", 1, func(context.Context) (interface{}, error) {
+					return "acquired", nil
+				}, distributedsync.WithLockWaitBudget(10*time.Millisecond), distributedsync.WithLockRetryDelay(time.Second))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			semaphore := distributedsync.NewInMemorySemaphoreService()
+
+			_, err := semaphore.Semaphore(t.Context(), "webhooks", 1, func(context.Context) (interface{}, error) {
+				return tc.acquire(semaphore)
+			})
+
+			exhausted, match := distributedsync.AsSemaphoreExhaustedError(err)
+			require.True(t, match)
+			assert.Equal(t, time.Second, exhausted.RetryAfter)
+
+			result, err := tc.acquire(semaphore)
+			require.NoError(t, err, "Expected the permit to be released")
+			assert.Equal(t, "acquired", result)
+		})
+	}
+}
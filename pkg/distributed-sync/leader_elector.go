@@ -0,0 +1,105 @@
+package distributedsync
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+)
+
+const leaderElectionName = "leader-election"
+
+// LeaderCallbacks are told about the leadership of the instance.
+type LeaderCallbacks struct {
+	// OnElected runs while the instance leads, leadership being resigned once it returns. Its context is cancelled
+	// as soon as leadership is lost or the campaign ends.
+	OnElected func(ctx context.Context)
+	// OnLost is told why leadership was lost, it isn't called when leadership is resigned.
+	OnLost func(err error)
+}
+
+// LeaderElector elects a single leader among the instances campaigning for the same election. Leading is holding
+// the mutex of the election, its lease being extended by the mutex watchdog, so a leader crashing or partitioned
+// away loses leadership once its lease expires and a follower takes over on its next attempt.
+type LeaderElector struct {
+	mutex    MutexService
+	election string
+	options  *LeaderElectorOptions
+	logger   logger.ZerologLogger
+	leading  atomic.Bool
+}
+
+func NewLeaderElector(
+	mutex MutexService,
+	election string,
+	logger logger.ZerologLogger,
+	opts ...LeaderElectorOptFunc,
+) *LeaderElector {
+	return &LeaderElector{
+		mutex:    mutex,
+		election: election,
+		options:  NewLeaderElectorOptions(opts...),
+		logger:   logger,
+	}
+}
+
+// IsLeader tells whether the instance currently leads.
+func (le *LeaderElector) IsLeader() bool {
+	return le.leading.Load()
+}
+
+// Campaign runs for leadership until the context is done, trying to take over every retry interval while another
+// instance leads, and running the elected callback every time the instance is elected.
+func (le *LeaderElector) Campaign(ctx context.Context, callbacks LeaderCallbacks) {
+	ticker := time.NewTicker(le.options.RetryInterval)
+	defer ticker.Stop()
+
+	for ctx.Err() == nil {
+		le.lead(ctx, callbacks)
+
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+}
+
+// lead takes the leadership if it's free and keeps it while the elected callback runs. The mutex is locked out of
+// the campaign context so leadership is still released once the campaign ends.
+func (le *LeaderElector) lead(ctx context.Context, callbacks LeaderCallbacks) {
+	_, err := le.mutex.TryMutex(
+		context.WithoutCancel(ctx),
+		leaderElectionName+":"+le.election,
+		func(leaseCtx context.Context) (interface{}, error) {
+			termCtx, resign := context.WithCancel(leaseCtx)
+			defer resign()
+
+			stopResigning := context.AfterFunc(ctx, resign)
+			defer stopResigning()
+
+			le.leading.Store(true)
+			defer le.leading.Store(false)
+
+			le.logger.Info().Ctx(ctx).Str("leader_election", le.election).Msg("leadership acquired")
+			callbacks.OnElected(termCtx)
+
+			return nil, nil
+		},
+		WithLockExpiry(le.options.Lease),
+	)
+
+	if _, contended := AsMutexContendedError(err); err == nil || contended {
+		return
+	}
+
+	if IsMutexLeaseLostError(err) {
+		le.logger.Warn().Ctx(ctx).Err(err).Str("leader_election", le.election).Msg("leadership lost")
+		if callbacks.OnLost != nil {
+			callbacks.OnLost(err)
+		}
+		return
+	}
+
+	le.logger.Error().Ctx(ctx).Err(err).Str("leader_election", le.election).Msg("error campaigning for leadership")
+}
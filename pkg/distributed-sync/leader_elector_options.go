@@ -0,0 +1,42 @@
+package distributedsync
+
+import "time"
+
+const (
+	defaultLeaderLease         = 15 * time.Second
+	defaultLeaderRetryInterval = 5 * time.Second
+)
+
+type LeaderElectorOptFunc func(*LeaderElectorOptions)
+
+// LeaderElectorOptions sets how long the leadership lease lasts before being extended and how long followers wait
+// between attempts to take over.
+type LeaderElectorOptions struct {
+	Lease         time.Duration
+	RetryInterval time.Duration
+}
+
+func NewLeaderElectorOptions(opts ...LeaderElectorOptFunc) *LeaderElectorOptions {
+	leo := &LeaderElectorOptions{
+		Lease:         defaultLeaderLease,
+		RetryInterval: defaultLeaderRetryInterval,
+	}
+
+	for _, opt := range opts {
+		opt(leo)
+	}
+
+	return leo
+}
+
+func WithLeaderLease(lease time.Duration) LeaderElectorOptFunc {
+	return func(leo *LeaderElectorOptions) {
+		leo.Lease = lease
+	}
+}
+
+func WithLeaderRetryInterval(interval time.Duration) LeaderElectorOptFunc {
+	return func(leo *LeaderElectorOptions) {
+		leo.RetryInterval = interval
+	}
+}
@@ -0,0 +1,55 @@
+package distributedsync_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+)
+
+func TestLeaderElector_SingleLeaderWithFailover(t *testing.T) {
+	mutex := distributedsync.NewInMemoryMutexService()
+	newElector := func() *distributedsync.LeaderElector {
+		return distributedsync.NewLeaderElector(
+			mutex,
+			"scheduler",
+			zerolog.New(io.Discard),
+			distributedsync.WithLeaderRetryInterval(5*time.Millisecond),
+		)
+	}
+	campaign := func(ctx context.Context, elector *distributedsync.LeaderElector, elected chan<- *distributedsync.LeaderElector) {
+		elector.Campaign(ctx, distributedsync.LeaderCallbacks{
+			OnElected: func(ctx context.Context) {
+				elected <- elector
+				<-ctx.Done()
+			},
+			OnLost: nil,
+		})
+	}
+
+	first, second := newElector(), newElector()
+	firstCtx, stopFirst := context.WithCancel(t.Context())
+	secondCtx, stopSecond := context.WithCancel(t.Context())
+	defer stopSecond()
+
+	elected := make(chan *distributedsync.LeaderElector, 2)
+	go campaign(firstCtx, first, elected)
+	require.Same(t, first, <-elected)
+
+	go campaign(secondCtx, second, elected)
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, first.IsLeader())
+	assert.False(t, second.IsLeader(), "Expected a single leader while the first one leads")
+
+	stopFirst()
+	require.Same(t, second, <-elected, "Expected the follower to take over once the leader resigned")
+	assert.Eventually(t, func() bool {
+		return !first.IsLeader() && second.IsLeader()
+	}, time.Second, 5*time.Millisecond)
+}
@@ -0,0 +1,39 @@
+package distributedsync
+
+import (
+	"context"
+	"time"
+)
+
+// watchLease extends a lease every interval until the returned function is called, which answers whether the lease
+// was lost meanwhile. Once extend fails the lease is considered lost, the context of its holder being cancelled with
+// the error extend failed with.
+func watchLease(loseLease context.CancelCauseFunc, interval time.Duration, extend func() error) func() error {
+	done, stopped := make(chan struct{}), make(chan struct{})
+	var leaseErr error
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if leaseErr = extend(); leaseErr != nil {
+					loseLease(leaseErr)
+					return
+				}
+			}
+		}
+	}()
+
+	return func() error {
+		close(done)
+		<-stopped
+		return leaseErr
+	}
+}
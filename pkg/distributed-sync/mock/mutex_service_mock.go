@@ -5,9 +5,8 @@ package distributedsyncmock
 
 import (
 	"context"
+	"github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
 	"sync"
-
-	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
 )
 
 // Ensure, that MutexServiceMock does implement distributedsync.MutexService.
@@ -20,18 +19,24 @@ var _ distributedsync.MutexService = &MutexServiceMock{}
 //
 //		// make and configure a mocked distributedsync.MutexService
 //		mockedMutexService := &MutexServiceMock{
-//			MutexFunc: func(ctx context.Context, key string, fn distributedsync.MutexCallback) (interface{}, error) {
+//			MutexFunc: func(ctx context.Context, key string, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error) {
 //				panic("mock out the Mutex method")
 //			},
+//			TryMutexFunc: func(ctx context.Context, key string, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error) {
+//				panic("mock out the TryMutex method")
+//			},
 //		}
 //
 //		// use mockedMutexService in code that requires distributedsync.MutexService
 //		// and then make assertions.
 //
-
+//	}
 type MutexServiceMock struct {
 	// MutexFunc mocks the Mutex method.
-	MutexFunc func(ctx context.Context, key string, fn distributedsync.MutexCallback) (interface{}, error)
+	MutexFunc func(ctx context.Context, key string, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error)
+
+	// TryMutexFunc mocks the TryMutex method.
+	TryMutexFunc func(ctx context.Context, key string, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -43,29 +48,45 @@ type MutexServiceMock struct {
 			Key string
 			// Fn is the fn argument value.
 			Fn distributedsync.MutexCallback
+			// Opts is the opts argument value.
+			Opts []distributedsync.MutexOptFunc
+		}
+		// TryMutex holds details about calls to the TryMutex method.
+		TryMutex []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// Fn is the fn argument value.
+			Fn distributedsync.MutexCallback
+			// Opts is the opts argument value.
+			Opts []distributedsync.MutexOptFunc
 		}
 	}
-	lockMutex sync.RWMutex
+	lockMutex    sync.RWMutex
+	lockTryMutex sync.RWMutex
 }
 
 // Mutex calls MutexFunc.
-func (mock *MutexServiceMock) Mutex(ctx context.Context, key string, fn distributedsync.MutexCallback) (interface{}, error) {
+func (mock *MutexServiceMock) Mutex(ctx context.Context, key string, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error) {
 	if mock.MutexFunc == nil {
 		panic("MutexServiceMock.MutexFunc: method is nil but MutexService.Mutex was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		Key string
-		Fn  distributedsync.MutexCallback
+		Ctx  context.Context
+		Key  string
+		Fn   distributedsync.MutexCallback
+		Opts []distributedsync.MutexOptFunc
 	}{
-		Ctx: ctx,
-		Key: key,
-		Fn:  fn,
+		Ctx:  ctx,
+		Key:  key,
+		Fn:   fn,
+		Opts: opts,
 	}
 	mock.lockMutex.Lock()
 	mock.calls.Mutex = append(mock.calls.Mutex, callInfo)
 	mock.lockMutex.Unlock()
-	return mock.MutexFunc(ctx, key, fn)
+	return mock.MutexFunc(ctx, key, fn, opts...)
 }
 
 // MutexCalls gets all the calls that were made to Mutex.
@@ -73,17 +94,63 @@ func (mock *MutexServiceMock) Mutex(ctx context.Context, key string, fn distribu
 //
 //	len(mockedMutexService.MutexCalls())
 func (mock *MutexServiceMock) MutexCalls() []struct {
-	Ctx context.Context
-	Key string
-	Fn  distributedsync.MutexCallback
+	Ctx  context.Context
+	Key  string
+	Fn   distributedsync.MutexCallback
+	Opts []distributedsync.MutexOptFunc
 } {
 	var calls []struct {
-		Ctx context.Context
-		Key string
-		Fn  distributedsync.MutexCallback
+		Ctx  context.Context
+		Key  string
+		Fn   distributedsync.MutexCallback
+		Opts []distributedsync.MutexOptFunc
 	}
 	mock.lockMutex.RLock()
 	calls = mock.calls.Mutex
 	mock.lockMutex.RUnlock()
 	return calls
 }
+
+// TryMutex calls TryMutexFunc.
+func (mock *MutexServiceMock) TryMutex(ctx context.Context, key string, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error) {
+	if mock.TryMutexFunc == nil {
+		panic("MutexServiceMock.TryMutexFunc: method is nil but MutexService.TryMutex was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Key  string
+		Fn   distributedsync.MutexCallback
+		Opts []distributedsync.MutexOptFunc
+	}{
+		Ctx:  ctx,
+		Key:  key,
+		Fn:   fn,
+		Opts: opts,
+	}
+	mock.lockTryMutex.Lock()
+	mock.calls.TryMutex = append(mock.calls.TryMutex, callInfo)
+	mock.lockTryMutex.Unlock()
+	return mock.TryMutexFunc(ctx, key, fn, opts...)
+}
+
+// TryMutexCalls gets all the calls that were made to TryMutex.
+// Check the length with:
+//
+//	len(mockedMutexService.TryMutexCalls())
+func (mock *MutexServiceMock) TryMutexCalls() []struct {
+	Ctx  context.Context
+	Key  string
+	Fn   distributedsync.MutexCallback
+	Opts []distributedsync.MutexOptFunc
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Key  string
+		Fn   distributedsync.MutexCallback
+		Opts []distributedsync.MutexOptFunc
+	}
+	mock.lockTryMutex.RLock()
+	calls = mock.calls.TryMutex
+	mock.lockTryMutex.RUnlock()
+	return calls
+}
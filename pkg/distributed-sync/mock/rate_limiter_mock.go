@@ -0,0 +1,88 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package distributedsyncmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+	"sync"
+)
+
+// Ensure, that RateLimiterMock does implement distributedsync.RateLimiter.
+// If this is not the case, regenerate this file with moq.
+var _ distributedsync.RateLimiter = &RateLimiterMock{}
+
+// RateLimiterMock is a mock implementation of distributedsync.RateLimiter.
+//
+//	func TestSomethingThatUsesRateLimiter(t *testing.T) {
+//
+//		// make and configure a mocked distributedsync.RateLimiter
+//		mockedRateLimiter := &RateLimiterMock{
+//			AllowFunc: func(ctx context.Context, key string, limit distributedsync.RateLimit) error {
+//				panic("mock out the Allow method")
+//			},
+//		}
+//
+//		// use mockedRateLimiter in code that requires distributedsync.RateLimiter
+//		// and then make assertions.
+//
+//	}
+type RateLimiterMock struct {
+	// AllowFunc mocks the Allow method.
+	AllowFunc func(ctx context.Context, key string, limit distributedsync.RateLimit) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Allow holds details about calls to the Allow method.
+		Allow []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// Limit is the limit argument value.
+			Limit distributedsync.RateLimit
+		}
+	}
+	lockAllow sync.RWMutex
+}
+
+// Allow calls AllowFunc.
+func (mock *RateLimiterMock) Allow(ctx context.Context, key string, limit distributedsync.RateLimit) error {
+	if mock.AllowFunc == nil {
+		panic("RateLimiterMock.AllowFunc: method is nil but RateLimiter.Allow was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Key   string
+		Limit distributedsync.RateLimit
+	}{
+		Ctx:   ctx,
+		Key:   key,
+		Limit: limit,
+	}
+	mock.lockAllow.Lock()
+	mock.calls.Allow = append(mock.calls.Allow, callInfo)
+	mock.lockAllow.Unlock()
+	return mock.AllowFunc(ctx, key, limit)
+}
+
+// AllowCalls gets all the calls that were made to Allow.
+// Check the length with:
+//
+//	len(mockedRateLimiter.AllowCalls())
+func (mock *RateLimiterMock) AllowCalls() []struct {
+	Ctx   context.Context
+	Key   string
+	Limit distributedsync.RateLimit
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Key   string
+		Limit distributedsync.RateLimit
+	}
+	mock.lockAllow.RLock()
+	calls = mock.calls.Allow
+	mock.lockAllow.RUnlock()
+	return calls
+}
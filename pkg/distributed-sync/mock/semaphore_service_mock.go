@@ -0,0 +1,168 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package distributedsyncmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+	"sync"
+)
+
+// Ensure, that SemaphoreServiceMock does implement distributedsync.SemaphoreService.
+// If this is not the case, regenerate this file with moq.
+var _ distributedsync.SemaphoreService = &SemaphoreServiceMock{}
+
+// SemaphoreServiceMock is a mock implementation of distributedsync.SemaphoreService.
+//
+//	func TestSomethingThatUsesSemaphoreService(t *testing.T) {
+//
+//		// make and configure a mocked distributedsync.SemaphoreService
+//		mockedSemaphoreService := &SemaphoreServiceMock{
+//			SemaphoreFunc: func(ctx context.Context, key string, permits int, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error) {
+//				panic("mock out the Semaphore method")
+//			},
+//			TrySemaphoreFunc: func(ctx context.Context, key string, permits int, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error) {
+//				panic("mock out the TrySemaphore method")
+//			},
+//		}
+//
+//		// use mockedSemaphoreService in code that requires distributedsync.SemaphoreService
+//		// and then make assertions.
+//
+//	}
+type SemaphoreServiceMock struct {
+	// SemaphoreFunc mocks the Semaphore method.
+	SemaphoreFunc func(ctx context.Context, key string, permits int, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error)
+
+	// TrySemaphoreFunc mocks the TrySemaphore method.
+	TrySemaphoreFunc func(ctx context.Context, key string, permits int, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Semaphore holds details about calls to the Semaphore method.
+		Semaphore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// Permits is the permits argument value.
+			Permits int
+			// Fn is the fn argument value.
+			Fn distributedsync.MutexCallback
+			// Opts is the opts argument value.
+			Opts []distributedsync.MutexOptFunc
+		}
+		// TrySemaphore holds details about calls to the TrySemaphore method.
+		TrySemaphore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// Permits is the permits argument value.
+			Permits int
+			// Fn is the fn argument value.
+			Fn distributedsync.MutexCallback
+			// Opts is the opts argument value.
+			Opts []distributedsync.MutexOptFunc
+		}
+	}
+	lockSemaphore    sync.RWMutex
+	lockTrySemaphore sync.RWMutex
+}
+
+// Semaphore calls SemaphoreFunc.
+func (mock *SemaphoreServiceMock) Semaphore(ctx context.Context, key string, permits int, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error) {
+	if mock.SemaphoreFunc == nil {
+		panic("SemaphoreServiceMock.SemaphoreFunc: method is nil but SemaphoreService.Semaphore was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Key     string
+		Permits int
+		Fn      distributedsync.MutexCallback
+		Opts    []distributedsync.MutexOptFunc
+	}{
+		Ctx:     ctx,
+		Key:     key,
+		Permits: permits,
+		Fn:      fn,
+		Opts:    opts,
+	}
+	mock.lockSemaphore.Lock()
+	mock.calls.Semaphore = append(mock.calls.Semaphore, callInfo)
+	mock.lockSemaphore.Unlock()
+	return mock.SemaphoreFunc(ctx, key, permits, fn, opts...)
+}
+
+// SemaphoreCalls gets all the calls that were made to Semaphore.
+// Check the length with:
+//
+//	len(mockedSemaphoreService.SemaphoreCalls())
+func (mock *SemaphoreServiceMock) SemaphoreCalls() []struct {
+	Ctx     context.Context
+	Key     string
+	Permits int
+	Fn      distributedsync.MutexCallback
+	Opts    []distributedsync.MutexOptFunc
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Key     string
+		Permits int
+		Fn      distributedsync.MutexCallback
+		Opts    []distributedsync.MutexOptFunc
+	}
+	mock.lockSemaphore.RLock()
+	calls = mock.calls.Semaphore
+	mock.lockSemaphore.RUnlock()
+	return calls
+}
+
+// TrySemaphore calls TrySemaphoreFunc.
+func (mock *SemaphoreServiceMock) TrySemaphore(ctx context.Context, key string, permits int, fn distributedsync.MutexCallback, opts ...distributedsync.MutexOptFunc) (interface{}, error) {
+	if mock.TrySemaphoreFunc == nil {
+		panic("SemaphoreServiceMock.TrySemaphoreFunc: method is nil but SemaphoreService.TrySemaphore was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Key     string
+		Permits int
+		Fn      distributedsync.MutexCallback
+		Opts    []distributedsync.MutexOptFunc
+	}{
+		Ctx:     ctx,
+		Key:     key,
+		Permits: permits,
+		Fn:      fn,
+		Opts:    opts,
+	}
+	mock.lockTrySemaphore.Lock()
+	mock.calls.TrySemaphore = append(mock.calls.TrySemaphore, callInfo)
+	mock.lockTrySemaphore.Unlock()
+	return mock.TrySemaphoreFunc(ctx, key, permits, fn, opts...)
+}
+
+// TrySemaphoreCalls gets all the calls that were made to TrySemaphore.
+// Check the length with:
+//
+//	len(mockedSemaphoreService.TrySemaphoreCalls())
+func (mock *SemaphoreServiceMock) TrySemaphoreCalls() []struct {
+	Ctx     context.Context
+	Key     string
+	Permits int
+	Fn      distributedsync.MutexCallback
+	Opts    []distributedsync.MutexOptFunc
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Key     string
+		Permits int
+		Fn      distributedsync.MutexCallback
+		Opts    []distributedsync.MutexOptFunc
+	}
+	mock.lockTrySemaphore.RLock()
+	calls = mock.calls.TrySemaphore
+	mock.lockTrySemaphore.RUnlock()
+	return calls
+}
@@ -1,12 +1,17 @@
 package distributedsync
 
 import (
+	"errors"
+	"time"
+
 	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
 )
 
 const (
 	errLockingMutexMessage   = "an error occurred while acquiring processes"
 	errUnlockingMutexMessage = "an error occurred while releasing processes"
+
+	mutexKeySemConvKey = "db.operation.parameter.mutex_key"
 )
 
 type MutexLockingError struct {
@@ -17,7 +22,7 @@ func NewMutexLockingError(key string) *MutexLockingError {
 	return &MutexLockingError{
 		CriticalError: errutil.NewCriticalErrorWithMetadata(
 			errLockingMutexMessage,
-			errutil.NewErrorMetadata().Set("db.operation.parameter.mutex_key", key),
+			errutil.NewErrorMetadata().Set(mutexKeySemConvKey, key),
 		),
 	}
 }
@@ -30,7 +35,74 @@ func NewMutexUnlockingError(key string) *MutexUnlockingError {
 	return &MutexUnlockingError{
 		CriticalError: errutil.NewCriticalErrorWithMetadata(
 			errUnlockingMutexMessage,
-			errutil.NewErrorMetadata().Set("db.operation.parameter.mutex_key", key),
+			errutil.NewErrorMetadata().Set(mutexKeySemConvKey, key),
+		),
+	}
+}
+
+// MutexLeaseLostError means the lease of a mutex or of a semaphore permit expired or was taken over while its
+// callback was still running.
+type MutexLeaseLostError struct {
+	*errutil.BaseError
+}
+
+func NewMutexLeaseLostError(key string, cause error) *MutexLeaseLostError {
+	return &MutexLeaseLostError{
+		BaseError: errutil.NewError(
+			"mutex lease lost",
+			errutil.WithCause(cause),
+			errutil.WithMetadataKeyValue(mutexKeySemConvKey, key),
+		),
+	}
+}
+
+func IsMutexLeaseLostError(err error) bool {
+	var self *MutexLeaseLostError
+	return errors.As(err, &self)
+}
+
+// StaleFencingTokenError means a write was made by a mutex holder older than the last one seen.
+type StaleFencingTokenError struct {
+	*errutil.BaseError
+}
+
+func NewStaleFencingTokenError(token FencingToken, latest uint64) *StaleFencingTokenError {
+	return &StaleFencingTokenError{
+		BaseError: errutil.NewError(
+			"stale fencing token",
+			errutil.WithMetadataKeyValue(mutexKeySemConvKey, token.Key),
+			errutil.WithMetadataKeyValue("distributed_sync.fencing_token", token.Value),
+			errutil.WithMetadataKeyValue("distributed_sync.fencing_token.latest", latest),
+		),
+	}
+}
+
+func IsStaleFencingTokenError(err error) bool {
+	var self *StaleFencingTokenError
+	return errors.As(err, &self)
+}
+
+// MutexContendedError means the mutex is held by someone else, either on a try or once the wait budget is spent.
+type MutexContendedError struct {
+	*errutil.BaseError
+	RetryAfter time.Duration
+}
+
+func NewMutexContendedError(key string, retryAfter time.Duration) *MutexContendedError {
+	return &MutexContendedError{
+		BaseError: errutil.NewError(
+			"mutex contended",
+			errutil.WithMetadataKeyValue(mutexKeySemConvKey, key),
 		),
+		RetryAfter: retryAfter,
 	}
 }
+
+func AsMutexContendedError(err error) (*MutexContendedError, bool) {
+	var self *MutexContendedError
+	if errors.As(err, &self) {
+		return self, true
+	}
+
+	return nil, false
+}
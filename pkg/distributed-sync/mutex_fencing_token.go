@@ -0,0 +1,53 @@
+package distributedsync
+
+import (
+	"context"
+	"sync"
+)
+
+type fencingTokenContextKey struct{}
+
+// FencingToken is handed to every mutex holder, tokens of the same key only ever increase so a write carrying a
+// token lower than the last one seen comes from a holder whose lease was lost in the meantime.
+type FencingToken struct {
+	Key   string
+	Value uint64
+}
+
+func WithFencingToken(ctx context.Context, token FencingToken) context.Context {
+	return context.WithValue(ctx, fencingTokenContextKey{}, token)
+}
+
+func FencingTokenFromContext(ctx context.Context) (FencingToken, bool) {
+	token, ok := ctx.Value(fencingTokenContextKey{}).(FencingToken)
+	return token, ok
+}
+
+// FencingTokenGuard keeps the last fencing token admitted for every resource, so stores can reject writes of
+// stale mutex holders. Writes made outside a mutex carry no token and are always admitted.
+type FencingTokenGuard struct {
+	mutex  sync.Mutex
+	latest map[string]uint64
+}
+
+func NewFencingTokenGuard() *FencingTokenGuard {
+	return &FencingTokenGuard{latest: make(map[string]uint64)}
+}
+
+// Admit checks the fencing token of the context against the last one admitted for the resource.
+func (g *FencingTokenGuard) Admit(ctx context.Context, resource string) error {
+	token, fenced := FencingTokenFromContext(ctx)
+	if !fenced {
+		return nil
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if latest, seen := g.latest[resource]; seen && token.Value < latest {
+		return NewStaleFencingTokenError(token, latest)
+	}
+
+	g.latest[resource] = token.Value
+	return nil
+}
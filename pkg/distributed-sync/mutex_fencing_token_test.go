@@ -0,0 +1,59 @@
+package distributedsync_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+)
+
+func TestFencingTokenGuard_Admit(t *testing.T) {
+	fenced := func(value uint64) context.Context {
+		return distributedsync.WithFencingToken(t.Context(), distributedsync.FencingToken{Key: "rocket:1", Value: value})
+	}
+
+	testCases := []struct {
+		name     string
+		contexts []context.Context
+		stale    bool
+	}{
+		{name: "increasing tokens are admitted", contexts: []context.Context{fenced(1), fenced(2), fenced(5)}},
+		{name: "repeated tokens are admitted", contexts: []context.Context{fenced(3), fenced(3)}},
+		{name: "writes without token are admitted", contexts: []context.Context{fenced(3), t.Context()}},
+		{name: "stale tokens are rejected", contexts: []context.Context{fenced(3), fenced(2)}, stale: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			guard := distributedsync.NewFencingTokenGuard()
+
+			var err error
+			for _, ctx := range tc.contexts {
+				err = guard.Admit(ctx, "rocket-1")
+			}
+
+			assert.Equal(t, tc.stale, distributedsync.IsStaleFencingTokenError(err))
+		})
+	}
+}
+
+func TestInMemoryMutexService_HandsOutIncreasingFencingTokens(t *testing.T) {
+	mutex := distributedsync.NewInMemoryMutexService()
+
+	tokens := make([]uint64, 0, 3)
+	for range 3 {
+		_, err := mutex.Mutex(t.Context(), "rocket", func(ctx context.Context) (interface{}, error) {
+			token, fenced := distributedsync.FencingTokenFromContext(ctx)
+			require.True(t, fenced)
+			assert.Equal(t, "rocket", token.Key)
+			tokens = append(tokens, token.Value)
+			return nil, nil
+		})
+		require.NoError(t, err)
+	}
+
+	assert.IsIncreasing(t, tokens)
+}
@@ -0,0 +1,63 @@
+package distributedsync
+
+import "time"
+
+const (
+	defaultMutexWaitBudget = 10 * time.Second
+	defaultMutexExpiry     = 30 * time.Second
+	defaultMutexRetryDelay = 250 * time.Millisecond
+)
+
+type MutexOptFunc func(*MutexOptions)
+
+// MutexOptions tunes a single lock: how long to wait for the mutex to be free, how long its lease lasts before being
+// extended and how long to wait between attempts, also answered as a retry hint once the mutex is contended.
+type MutexOptions struct {
+	WaitBudget time.Duration
+	Expiry     time.Duration
+	RetryDelay time.Duration
+}
+
+func NewMutexOptions(defaults MutexOptions, opts ...MutexOptFunc) MutexOptions {
+	options := defaults
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+func defaultMutexOptions() MutexOptions {
+	return MutexOptions{
+		WaitBudget: defaultMutexWaitBudget,
+		Expiry:     defaultMutexExpiry,
+		RetryDelay: defaultMutexRetryDelay,
+	}
+}
+
+func WithLockWaitBudget(budget time.Duration) MutexOptFunc {
+	return func(mo *MutexOptions) {
+		mo.WaitBudget = budget
+	}
+}
+
+func WithLockExpiry(expiry time.Duration) MutexOptFunc {
+	return func(mo *MutexOptions) {
+		mo.Expiry = expiry
+	}
+}
+
+func WithLockRetryDelay(delay time.Duration) MutexOptFunc {
+	return func(mo *MutexOptions) {
+		mo.RetryDelay = delay
+	}
+}
+
+// attempts answers how many times the mutex can be tried within the wait budget.
+func (mo MutexOptions) attempts() int {
+	if mo.RetryDelay <= 0 {
+		return 1
+	}
+
+	return int(mo.WaitBudget/mo.RetryDelay) + 1
+}
@@ -9,59 +9,157 @@ import (
 	"github.com/soulcodex/rockets-message-processor/pkg/retry"
 
 	"github.com/go-redsync/redsync/v4"
+	redsyncredis "github.com/go-redsync/redsync/v4/redis"
 	"github.com/go-redsync/redsync/v4/redis/goredis/v9"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/soulcodex/rockets-message-processor/pkg/logger"
 )
 
-const mutexName = "distributed-sync-mutex"
+const (
+	mutexName        = "distributed-sync-mutex"
+	mutexFencingName = "distributed-sync-fencing"
+	mutexLeaseTicks  = 3
+)
 
 type MutexService interface {
-	Mutex(ctx context.Context, key string, fn MutexCallback) (interface{}, error)
+	// Mutex runs the callback holding the mutex, waiting for it up to the wait budget.
+	Mutex(ctx context.Context, key string, fn MutexCallback, opts ...MutexOptFunc) (interface{}, error)
+	// TryMutex runs the callback only if the mutex is free right away, failing with a MutexContendedError otherwise.
+	TryMutex(ctx context.Context, key string, fn MutexCallback, opts ...MutexOptFunc) (interface{}, error)
 }
 
-type MutexCallback func() (interface{}, error)
+// MutexCallback runs holding the mutex. Its context carries the fencing token of the holder, along with the nodes
+// that granted the mutex when locked through Redis, and is cancelled as soon as the mutex is lost. Its result is
+// returned even when the mutex was lost or couldn't be unlocked meanwhile, its error joined with theirs, so callers
+// can tell work applied before losing the mutex apart from work never applied.
+type MutexCallback func(ctx context.Context) (interface{}, error)
 
+// RedisMutexService locks keys through redsync, on a single Redis or following Redlock on a majority of independent
+// Redis nodes, so the mutex keeps working while a minority of them is down. A watchdog extends the lease while the
+// callback runs, cancelling the callback context when the lease can't be extended anymore, and every holder gets a
+// fencing token counted per key so stores can reject writes made by a holder that lost the lease.
 type RedisMutexService struct {
 	options *MutexServiceOptions
+	clients []*redis.Client
+	nodes   []string
+	quorum  int
 	sync    *redsync.Redsync
 	logger  logger.ZerologLogger
 }
 
-const (
-	redsyncDefaultExpiry        = 30 * time.Second
-	redsyncDefaultRetryDelay    = 250 * time.Millisecond
-	redsyncDefaultTimeoutFactor = 0.05
-)
+const redsyncDefaultTimeoutFactor = 0.05
 
 func NewRedisMutexService(redisClient *redis.Client, logger logger.ZerologLogger, options ...MutexServiceOptFunc) *RedisMutexService {
-	pool := goredis.NewPool(redisClient)
+	return NewRedlockMutexService([]*redis.Client{redisClient}, logger, options...)
+}
+
+// NewRedlockMutexService locks keys on every given Redis node, the mutex being held once a majority of them granted it.
+func NewRedlockMutexService(
+	redisClients []*redis.Client,
+	logger logger.ZerologLogger,
+	options ...MutexServiceOptFunc,
+) *RedisMutexService {
+	pools := make([]redsyncredis.Pool, len(redisClients))
+	nodes := make([]string, len(redisClients))
+	for i, client := range redisClients {
+		pools[i] = goredis.NewPool(client)
+		nodes[i] = redisNodeName(client)
+	}
 
-	return &RedisMutexService{sync: redsync.New(pool), logger: logger, options: NewMutexServiceOptions(options...)}
+	return &RedisMutexService{
+		clients: redisClients,
+		nodes:   nodes,
+		quorum:  len(redisClients)/2 + 1,
+		sync:    redsync.New(pools...),
+		logger:  logger,
+		options: NewMutexServiceOptions(options...),
+	}
+}
+
+func (rm *RedisMutexService) Mutex(ctx context.Context, key string, fn MutexCallback, opts ...MutexOptFunc) (interface{}, error) {
+	options := NewMutexOptions(rm.options.Lock, opts...)
+	return rm.run(ctx, key, fn, options, options.attempts())
 }
 
-func (rm *RedisMutexService) Mutex(ctx context.Context, key string, fn MutexCallback) (interface{}, error) {
+func (rm *RedisMutexService) TryMutex(ctx context.Context, key string, fn MutexCallback, opts ...MutexOptFunc) (interface{}, error) {
+	return rm.run(ctx, key, fn, NewMutexOptions(rm.options.Lock, opts...), 1)
+}
+
+func (rm *RedisMutexService) run(
+	ctx context.Context,
+	key string,
+	fn MutexCallback,
+	options MutexOptions,
+	attempts int,
+) (interface{}, error) {
 	mutex := rm.sync.NewMutex(
-		rm.lockingKey(key),
-		redsync.WithExpiry(redsyncDefaultExpiry),
-		redsync.WithRetryDelay(redsyncDefaultRetryDelay),
+		rm.options.namespacedKey(mutexName, key),
+		redsync.WithExpiry(options.Expiry),
+		redsync.WithTries(attempts),
+		redsync.WithRetryDelay(options.RetryDelay),
 		redsync.WithTimeoutFactor(redsyncDefaultTimeoutFactor),
 	)
 
-	if _, lockingErr := retry.Do(func() (interface{}, error) {
-		return nil, rm.acquireLock(ctx, mutex)
-	}, int(rm.options.Retries)); lockingErr != nil {
-		rm.logger.Error().
-			Ctx(ctx).
-			Err(lockingErr).
-			Str("mutex_key", mutex.Name()).
-			Msg("error locking mutex sync")
-		return nil, NewMutexLockingError(key).Wrap(lockingErr)
+	if lockingErr := rm.lock(ctx, key, mutex, options); lockingErr != nil {
+		return nil, lockingErr
+	}
+
+	token, grant, fencingErr := rm.fence(ctx, key, mutex)
+	if fencingErr != nil {
+		return nil, errors.Join(fencingErr, rm.unlock(ctx, key, mutex))
+	}
+
+	leaseCtx, loseLease := context.WithCancelCause(WithMutexGrant(WithFencingToken(ctx, token), grant))
+	defer loseLease(nil)
+
+	interval := options.Expiry / mutexLeaseTicks
+	stopWatchdog := watchLease(loseLease, interval, func() error {
+		return rm.extendLease(leaseCtx, key, mutex, interval)
+	})
+	result, err := fn(leaseCtx)
+	if leaseErr := stopWatchdog(); leaseErr != nil {
+		return result, errors.Join(err, leaseErr)
+	}
+
+	if unlockingErr := rm.unlock(ctx, key, mutex); unlockingErr != nil {
+		return result, errors.Join(err, unlockingErr)
 	}
 
-	result, err := fn()
+	return result, err
+}
 
+// lock acquires the mutex within the wait budget, telling apart a mutex held by someone else from Redis failing.
+func (rm *RedisMutexService) lock(ctx context.Context, key string, mutex *redsync.Mutex, options MutexOptions) error {
+	budgetCtx, cancel := context.WithCancel(ctx)
+	if options.WaitBudget > 0 {
+		budgetCtx, cancel = context.WithTimeout(ctx, options.WaitBudget)
+	}
+	defer cancel()
+
+	lockingErr := mutex.LockContext(budgetCtx)
+	if errors.Is(lockingErr, redsync.ErrFailed) && ctx.Err() == nil {
+		// The budget was spent between attempts, a last one tells whether the mutex is held or Redis is failing.
+		lockingErr = mutex.TryLockContext(ctx)
+	}
+
+	if lockingErr == nil {
+		return nil
+	}
+
+	if ctx.Err() == nil && isRedsyncContention(lockingErr) {
+		return NewMutexContendedError(key, options.RetryDelay)
+	}
+
+	rm.logger.Error().
+		Ctx(ctx).
+		Err(lockingErr).
+		Str("mutex_key", mutex.Name()).
+		Msg("error locking mutex sync")
+	return NewMutexLockingError(key).Wrap(lockingErr)
+}
+
+func (rm *RedisMutexService) unlock(ctx context.Context, key string, mutex *redsync.Mutex) error {
 	if _, unlockingErr := retry.Do(func() (interface{}, error) {
 		return nil, rm.releaseLock(ctx, mutex)
 	}, int(rm.options.Retries)); unlockingErr != nil {
@@ -70,18 +168,35 @@ func (rm *RedisMutexService) Mutex(ctx context.Context, key string, fn MutexCall
 			Err(unlockingErr).
 			Str("mutex_key", mutex.Name()).
 			Msg("error unlocking mutex sync")
-		return nil, NewMutexUnlockingError(key).Wrap(unlockingErr)
+		return NewMutexUnlockingError(key).Wrap(unlockingErr)
 	}
 
-	return result, err
+	return nil
 }
 
-func (rm *RedisMutexService) lockingKey(key string) string {
-	if rm.options.ServicePrefix != nil {
-		return mutexName + ":" + *rm.options.ServicePrefix + ":" + key
+// extendLease extends the mutex lease, failures being retried on the next tick as long as the lease lasts.
+func (rm *RedisMutexService) extendLease(ctx context.Context, key string, mutex *redsync.Mutex, interval time.Duration) error {
+	extended, err := mutex.ExtendContext(context.WithoutCancel(ctx))
+	if extended {
+		return nil
+	}
+
+	var taken *redsync.ErrTaken
+	if errors.As(err, &taken) || time.Until(mutex.Until()) <= interval {
+		rm.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Str("mutex_key", mutex.Name()).
+			Msg("mutex lease lost")
+		return NewMutexLeaseLostError(key, err)
 	}
 
-	return mutexName + ":" + key
+	rm.logger.Warn().
+		Ctx(ctx).
+		Err(err).
+		Str("mutex_key", mutex.Name()).
+		Msg("error extending mutex lease - retrying")
+	return nil
 }
 
 func (rm *RedisMutexService) releaseLock(ctx context.Context, mutex *redsync.Mutex) error {
@@ -101,15 +216,10 @@ func (rm *RedisMutexService) releaseLock(ctx context.Context, mutex *redsync.Mut
 	return nil
 }
 
-func (rm *RedisMutexService) acquireLock(ctx context.Context, mutex *redsync.Mutex) error {
-	if err := mutex.LockContext(ctx); err != nil {
-		rm.logger.Warn().
-			Ctx(ctx).
-			Err(err).
-			Str("mutex_key", mutex.Name()).
-			Msg("error locking mutex sync - retrying")
-		return fmt.Errorf("error while releasing mutex: %w", err)
-	}
+// isRedsyncContention tells whether locking failed because nodes were held by someone else rather than unreachable.
+func isRedsyncContention(err error) bool {
+	var taken *redsync.ErrTaken
+	var nodeTaken *redsync.ErrNodeTaken
 
-	return nil
+	return errors.As(err, &taken) || errors.As(err, &nodeTaken)
 }
@@ -6,15 +6,19 @@ const (
 
 type MutexServiceOptFunc func(*MutexServiceOptions)
 
+// MutexServiceOptions sets the prefix of every key, how many times releasing a mutex is retried and the options of
+// every lock, which can still be overridden on each call.
 type MutexServiceOptions struct {
 	ServicePrefix *string
 	Retries       uint8
+	Lock          MutexOptions
 }
 
 func NewMutexServiceOptions(opts ...MutexServiceOptFunc) *MutexServiceOptions {
 	mso := &MutexServiceOptions{
 		ServicePrefix: nil,
 		Retries:       defaultMutexRetries,
+		Lock:          defaultMutexOptions(),
 	}
 
 	for _, opt := range opts {
@@ -35,3 +39,18 @@ func WithRetries(retries uint8) MutexServiceOptFunc {
 		mso.Retries = retries
 	}
 }
+
+// WithLockDefaults sets the options of every lock made through the service.
+func WithLockDefaults(opts ...MutexOptFunc) MutexServiceOptFunc {
+	return func(mso *MutexServiceOptions) {
+		mso.Lock = NewMutexOptions(mso.Lock, opts...)
+	}
+}
+
+func (mso *MutexServiceOptions) namespacedKey(name, key string) string {
+	if mso.ServicePrefix != nil {
+		return name + ":" + *mso.ServicePrefix + ":" + key
+	}
+
+	return name + ":" + key
+}
@@ -0,0 +1,37 @@
+package distributedsync
+
+import (
+	"context"
+	"time"
+)
+
+const rateLimiterName = "distributed-sync-rate-limit"
+
+// RateLimiter lets at most a number of requests through every period for every key, following GCRA (the generic
+// cell rate algorithm): requests are spread evenly over the period, bursts of up to Burst requests being tolerated.
+type RateLimiter interface {
+	// Allow takes a request out of the rate limit of the key, failing with a RateLimitExceededError once exceeded.
+	Allow(ctx context.Context, key string, limit RateLimit) error
+}
+
+// RateLimit allows Rate requests every Period, up to Burst of them at once.
+type RateLimit struct {
+	Rate   int
+	Period time.Duration
+	Burst  int
+}
+
+// PerSecond allows rate requests every second, all of them at once if they come in a burst.
+func PerSecond(rate int) RateLimit {
+	return RateLimit{Rate: rate, Period: time.Second, Burst: rate}
+}
+
+// emissionInterval is the time between two requests when they're spread evenly.
+func (rl RateLimit) emissionInterval() time.Duration {
+	return rl.Period / time.Duration(max(rl.Rate, 1))
+}
+
+// tolerance is how far ahead of time requests can be let through, which makes room for the bursts.
+func (rl RateLimit) tolerance() time.Duration {
+	return rl.emissionInterval() * time.Duration(max(rl.Burst, 1))
+}
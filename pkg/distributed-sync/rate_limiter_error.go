@@ -0,0 +1,56 @@
+package distributedsync
+
+import (
+	"errors"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const rateLimitKeySemConvKey = "db.operation.parameter.rate_limit_key"
+
+// RateLimitExceededError means the request goes over the rate limit of the key, it fits again after RetryAfter.
+type RateLimitExceededError struct {
+	*errutil.BaseError
+	RetryAfter time.Duration
+}
+
+func NewRateLimitExceededError(key string, retryAfter time.Duration) *RateLimitExceededError {
+	return &RateLimitExceededError{
+		BaseError: errutil.NewError(
+			"rate limit exceeded",
+			errutil.WithMetadataKeyValue(rateLimitKeySemConvKey, key),
+		),
+		RetryAfter: retryAfter,
+	}
+}
+
+func AsRateLimitExceededError(err error) (*RateLimitExceededError, bool) {
+	var self *RateLimitExceededError
+	if errors.As(err, &self) {
+		return self, true
+	}
+
+	return nil, false
+}
+
+// RateLimiterUnavailableError means the rate limiter backend could not be reached, so it is unknown whether the
+// request fits the rate limit or not.
+type RateLimiterUnavailableError struct {
+	*errutil.BaseError
+}
+
+func NewRateLimiterUnavailableError(key string, cause error) *RateLimiterUnavailableError {
+	return &RateLimiterUnavailableError{
+		BaseError: errutil.NewError(
+			"rate limiter unavailable",
+			errutil.WithCause(cause),
+			errutil.WithMetadataKeyValue(rateLimitKeySemConvKey, key),
+		),
+	}
+}
+
+func IsRateLimiterUnavailableError(err error) bool {
+	var self *RateLimiterUnavailableError
+	return errors.As(err, &self)
+}
@@ -0,0 +1,123 @@
+package distributedsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-redsync/redsync/v4"
+	"github.com/redis/go-redis/v9"
+)
+
+// redisFencingProposalScript proposes the next fencing token of a node, only while the node holds the lock.
+var redisFencingProposalScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+return (tonumber(redis.call("GET", KEYS[2])) or 0) + 1
+`)
+
+// redisFencingAgreementScript raises the fencing counter of a node up to the agreed token, only while the node
+// holds the lock.
+var redisFencingAgreementScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+if (tonumber(redis.call("GET", KEYS[2])) or 0) < tonumber(ARGV[2]) then
+	redis.call("SET", KEYS[2], ARGV[2])
+end
+return 1
+`)
+
+type mutexGrantContextKey struct{}
+
+// MutexGrant tells which Redis nodes granted the mutex, out of the quorum needed to hold it.
+type MutexGrant struct {
+	Key    string
+	Nodes  []string
+	Quorum int
+}
+
+func WithMutexGrant(ctx context.Context, grant MutexGrant) context.Context {
+	return context.WithValue(ctx, mutexGrantContextKey{}, grant)
+}
+
+func MutexGrantFromContext(ctx context.Context) (MutexGrant, bool) {
+	grant, ok := ctx.Value(mutexGrantContextKey{}).(MutexGrant)
+	return grant, ok
+}
+
+type redisNodeReply struct {
+	value uint64
+	err   error
+}
+
+// fence hands out a fencing token agreed by a quorum of nodes. Every holder raises the counters of a quorum up to
+// its token and any two quorums share a node, so the next holder always proposes a greater token.
+func (rm *RedisMutexService) fence(ctx context.Context, key string, mutex *redsync.Mutex) (FencingToken, MutexGrant, error) {
+	keys := []string{mutex.Name(), rm.options.namespacedKey(mutexFencingName, key)}
+	grant := MutexGrant{Key: key, Nodes: make([]string, 0, len(rm.clients)), Quorum: rm.quorum}
+
+	var token uint64
+	failures := make([]error, 0)
+	for node, reply := range rm.onNodes(ctx, redisFencingProposalScript, keys, mutex.Value()) {
+		switch {
+		case reply.err != nil:
+			failures = append(failures, fmt.Errorf("node %s: %w", rm.nodes[node], reply.err))
+		case reply.value > 0:
+			grant.Nodes = append(grant.Nodes, rm.nodes[node])
+			token = max(token, reply.value)
+		}
+	}
+
+	if len(grant.Nodes) < rm.quorum {
+		return FencingToken{}, grant, NewMutexLeaseLostError(key, errors.Join(failures...))
+	}
+
+	agreed := 0
+	for node, reply := range rm.onNodes(ctx, redisFencingAgreementScript, keys, mutex.Value(), token) {
+		if reply.err != nil {
+			failures = append(failures, fmt.Errorf("node %s: %w", rm.nodes[node], reply.err))
+		} else if reply.value > 0 {
+			agreed++
+		}
+	}
+
+	if agreed < rm.quorum {
+		return FencingToken{}, grant, NewMutexLeaseLostError(key, errors.Join(failures...))
+	}
+
+	if len(grant.Nodes) < len(rm.clients) {
+		rm.logger.Warn().
+			Ctx(ctx).
+			Err(errors.Join(failures...)).
+			Str("mutex_key", mutex.Name()).
+			Strs("mutex_nodes", grant.Nodes).
+			Msg("mutex granted by a quorum of nodes only")
+	}
+
+	return FencingToken{Key: key, Value: token}, grant, nil
+}
+
+// onNodes runs the script on every node at once, answering the reply of each node in the order of the nodes.
+func (rm *RedisMutexService) onNodes(ctx context.Context, script *redis.Script, keys []string, args ...interface{}) []redisNodeReply {
+	replies := make([]redisNodeReply, len(rm.clients))
+
+	var wg sync.WaitGroup
+	for node, client := range rm.clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := script.Run(ctx, client, keys, args...).Uint64()
+			replies[node] = redisNodeReply{value: value, err: err}
+		}()
+	}
+	wg.Wait()
+
+	return replies
+}
+
+func redisNodeName(client *redis.Client) string {
+	return fmt.Sprintf("%s/%d", client.Options().Addr, client.Options().DB)
+}
@@ -0,0 +1,62 @@
+package distributedsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+)
+
+// redisRateLimiterScript applies GCRA to a key, keeping its theoretical arrival time in microseconds of the clock of
+// Redis. It answers how many microseconds the request has to wait for, zero meaning it was let through.
+var redisRateLimiterScript = redis.NewScript(`
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+local tat = math.max(tonumber(redis.call("GET", KEYS[1])) or now, now)
+local next = tat + tonumber(ARGV[1])
+local wait = next - now - tonumber(ARGV[2])
+if wait > 0 then
+	return wait
+end
+redis.call("SET", KEYS[1], string.format("%.0f", next), "PX", math.ceil((next - now) / 1000))
+return 0
+`)
+
+// RedisRateLimiter shares the rate limits of every key between the instances through Redis.
+type RedisRateLimiter struct {
+	options *MutexServiceOptions
+	client  *redis.Client
+	logger  logger.ZerologLogger
+}
+
+func NewRedisRateLimiter(redisClient *redis.Client, logger logger.ZerologLogger, options ...MutexServiceOptFunc) *RedisRateLimiter {
+	return &RedisRateLimiter{options: NewMutexServiceOptions(options...), client: redisClient, logger: logger}
+}
+
+func (rl *RedisRateLimiter) Allow(ctx context.Context, key string, limit RateLimit) error {
+	rateLimitKey := rl.options.namespacedKey(rateLimiterName, key)
+
+	wait, err := redisRateLimiterScript.Run(
+		ctx,
+		rl.client,
+		[]string{rateLimitKey},
+		limit.emissionInterval().Microseconds(),
+		limit.tolerance().Microseconds(),
+	).Int64()
+	if err != nil {
+		rl.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Str("rate_limit_key", rateLimitKey).
+			Msg("error applying rate limit")
+		return NewRateLimiterUnavailableError(key, err)
+	}
+
+	if wait > 0 {
+		return NewRateLimitExceededError(key, time.Duration(wait)*time.Microsecond)
+	}
+
+	return nil
+}
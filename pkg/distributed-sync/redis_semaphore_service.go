@@ -0,0 +1,207 @@
+package distributedsync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+	"github.com/soulcodex/rockets-message-processor/pkg/retry"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+// redisSemaphoreAcquireScript prunes the expired permits of the semaphore and takes one if any is left, every permit
+// being a holder scored with the deadline of its lease. Deadlines follow the clock of Redis.
+var redisSemaphoreAcquireScript = redis.NewScript(`
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now)
+if redis.call("ZCARD", KEYS[1]) >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call("ZADD", KEYS[1], now + tonumber(ARGV[2]), ARGV[3])
+if redis.call("PTTL", KEYS[1]) < tonumber(ARGV[2]) then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 1
+`)
+
+// redisSemaphoreExtendScript pushes the deadline of a permit back, only while its lease hasn't expired.
+var redisSemaphoreExtendScript = redis.NewScript(`
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local deadline = tonumber(redis.call("ZSCORE", KEYS[1], ARGV[2]))
+if not deadline or deadline <= now then
+	return 0
+end
+redis.call("ZADD", KEYS[1], now + tonumber(ARGV[1]), ARGV[2])
+if redis.call("PTTL", KEYS[1]) < tonumber(ARGV[1]) then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return 1
+`)
+
+// RedisSemaphoreService keeps the permits of every key in a sorted set, each permit leasing a slot until its deadline
+// so permits of crashed holders are freed once expired. Like the mutex, a watchdog extends the lease while the callback
+// runs and cancels the callback context once the lease is lost.
+type RedisSemaphoreService struct {
+	options      *MutexServiceOptions
+	client       *redis.Client
+	uuidProvider utils.UUIDProvider
+	logger       logger.ZerologLogger
+}
+
+func NewRedisSemaphoreService(
+	redisClient *redis.Client,
+	logger logger.ZerologLogger,
+	options ...MutexServiceOptFunc,
+) *RedisSemaphoreService {
+	return &RedisSemaphoreService{
+		options:      NewMutexServiceOptions(options...),
+		client:       redisClient,
+		uuidProvider: utils.NewRandomUUIDProvider(),
+		logger:       logger,
+	}
+}
+
+func (rs *RedisSemaphoreService) Semaphore(
+	ctx context.Context,
+	key string,
+	permits int,
+	fn MutexCallback,
+	opts ...MutexOptFunc,
+) (interface{}, error) {
+	return rs.run(ctx, key, permits, fn, NewMutexOptions(rs.options.Lock, opts...), true)
+}
+
+func (rs *RedisSemaphoreService) TrySemaphore(
+	ctx context.Context,
+	key string,
+	permits int,
+	fn MutexCallback,
+	opts ...MutexOptFunc,
+) (interface{}, error) {
+	return rs.run(ctx, key, permits, fn, NewMutexOptions(rs.options.Lock, opts...), false)
+}
+
+func (rs *RedisSemaphoreService) run(
+	ctx context.Context,
+	key string,
+	permits int,
+	fn MutexCallback,
+	options MutexOptions,
+	wait bool,
+) (interface{}, error) {
+	semaphoreKey := rs.options.namespacedKey(semaphoreName, key)
+	holder := rs.uuidProvider.New().String()
+
+	if acquiringErr := rs.acquire(ctx, key, semaphoreKey, holder, permits, options, wait); acquiringErr != nil {
+		return nil, acquiringErr
+	}
+
+	leaseCtx, loseLease := context.WithCancelCause(ctx)
+	defer loseLease(nil)
+
+	interval := options.Expiry / mutexLeaseTicks
+	leaseUntil := time.Now().Add(options.Expiry)
+	stopWatchdog := watchLease(loseLease, interval, func() error {
+		return rs.extendLease(leaseCtx, key, semaphoreKey, holder, options.Expiry, &leaseUntil)
+	})
+	result, err := fn(leaseCtx)
+	if leaseErr := stopWatchdog(); leaseErr != nil {
+		return result, errors.Join(err, leaseErr)
+	}
+
+	if releasingErr := rs.release(ctx, key, semaphoreKey, holder); releasingErr != nil {
+		return result, errors.Join(err, releasingErr)
+	}
+
+	return result, err
+}
+
+func (rs *RedisSemaphoreService) acquire(
+	ctx context.Context,
+	key, semaphoreKey, holder string,
+	permits int,
+	options MutexOptions,
+	wait bool,
+) error {
+	taken, err := waitForPermit(ctx, options, wait, func() (bool, error) {
+		return redisSemaphoreAcquireScript.Run(
+			ctx,
+			rs.client,
+			[]string{semaphoreKey},
+			permits,
+			options.Expiry.Milliseconds(),
+			holder,
+		).Bool()
+	})
+	if err != nil {
+		rs.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Str("semaphore_key", semaphoreKey).
+			Msg("error acquiring semaphore permit")
+		return NewMutexLockingError(key).Wrap(err)
+	}
+
+	if !taken {
+		return NewSemaphoreExhaustedError(key, permits, options.RetryDelay)
+	}
+
+	return nil
+}
+
+// extendLease extends the permit lease, failures being retried on the next tick as long as the lease lasts.
+func (rs *RedisSemaphoreService) extendLease(
+	ctx context.Context,
+	key, semaphoreKey, holder string,
+	expiry time.Duration,
+	leaseUntil *time.Time,
+) error {
+	extendedAt := time.Now()
+	extended, err := redisSemaphoreExtendScript.Run(
+		context.WithoutCancel(ctx),
+		rs.client,
+		[]string{semaphoreKey},
+		expiry.Milliseconds(),
+		holder,
+	).Bool()
+	if err == nil && extended {
+		*leaseUntil = extendedAt.Add(expiry)
+		return nil
+	}
+
+	if err == nil || time.Until(*leaseUntil) <= expiry/mutexLeaseTicks {
+		rs.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Str("semaphore_key", semaphoreKey).
+			Msg("semaphore permit lease lost")
+		return NewMutexLeaseLostError(key, err)
+	}
+
+	rs.logger.Warn().
+		Ctx(ctx).
+		Err(err).
+		Str("semaphore_key", semaphoreKey).
+		Msg("error extending semaphore permit lease - retrying")
+	return nil
+}
+
+func (rs *RedisSemaphoreService) release(ctx context.Context, key, semaphoreKey, holder string) error {
+	if _, err := retry.Do(func() (interface{}, error) {
+		return nil, rs.client.ZRem(ctx, semaphoreKey, holder).Err()
+	}, int(rs.options.Retries)); err != nil {
+		rs.logger.Error().
+			Ctx(ctx).
+			Err(err).
+			Str("semaphore_key", semaphoreKey).
+			Msg("error releasing semaphore permit")
+		return NewMutexUnlockingError(key).Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package distributedsync
+
+import (
+	"errors"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const semaphoreKeySemConvKey = "db.operation.parameter.semaphore_key"
+
+// SemaphoreExhaustedError means every permit of the semaphore is held, either on a try or once the wait budget
+// is spent.
+type SemaphoreExhaustedError struct {
+	*errutil.BaseError
+	RetryAfter time.Duration
+}
+
+func NewSemaphoreExhaustedError(key string, permits int, retryAfter time.Duration) *SemaphoreExhaustedError {
+	return &SemaphoreExhaustedError{
+		BaseError: errutil.NewError(
+			"semaphore exhausted",
+			errutil.WithMetadataKeyValue(semaphoreKeySemConvKey, key),
+			errutil.WithMetadataKeyValue("distributed_sync.semaphore.permits", permits),
+		),
+		RetryAfter: retryAfter,
+	}
+}
+
+func AsSemaphoreExhaustedError(err error) (*SemaphoreExhaustedError, bool) {
+	var self *SemaphoreExhaustedError
+	if errors.As(err, &self) {
+		return self, true
+	}
+
+	return nil, false
+}
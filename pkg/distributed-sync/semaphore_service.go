@@ -0,0 +1,48 @@
+package distributedsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const semaphoreName = "distributed-sync-semaphore"
+
+// SemaphoreService lets at most a number of callbacks run at once for every key, each of them holding one of the
+// permits of the key. Permits are taken with the same options as mutexes.
+type SemaphoreService interface {
+	// Semaphore runs the callback holding a permit of the key, waiting for one up to the wait budget.
+	Semaphore(ctx context.Context, key string, permits int, fn MutexCallback, opts ...MutexOptFunc) (interface{}, error)
+	// TrySemaphore runs the callback only if a permit is free right away, failing with a SemaphoreExhaustedError
+	// otherwise.
+	TrySemaphore(ctx context.Context, key string, permits int, fn MutexCallback, opts ...MutexOptFunc) (interface{}, error)
+}
+
+// waitForPermit tries to take a permit every retry delay until one is taken or the wait budget is spent, trying
+// only once when not waiting. It answers whether a permit was taken.
+func waitForPermit(ctx context.Context, options MutexOptions, wait bool, take func() (bool, error)) (bool, error) {
+	var budget <-chan time.Time
+	if options.WaitBudget > 0 {
+		timer := time.NewTimer(options.WaitBudget)
+		defer timer.Stop()
+		budget = timer.C
+	}
+
+	for {
+		taken, err := take()
+		if err != nil || taken || !wait {
+			return taken, err
+		}
+
+		retry := time.NewTimer(options.RetryDelay)
+		select {
+		case <-ctx.Done():
+			retry.Stop()
+			return false, fmt.Errorf("waiting for a permit: %w", ctx.Err())
+		case <-budget:
+			retry.Stop()
+			return false, nil
+		case <-retry.C:
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Event is a fact raised by an aggregate when its state changes.
+type Event interface {
+	Type() string
+	AggregateID() string
+	OccurredOn() time.Time
+}
+
+// EventPublisher delivers the events pulled from an aggregate once it has been saved.
+//
+//go:generate moq -pkg domainmock -out mock/event_publisher_moq.go . EventPublisher
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...Event) error
+}
+
+// EventRecorder keeps the events raised by an aggregate until they're pulled.
+type EventRecorder struct {
+	events []Event
+}
+
+func (r *EventRecorder) Record(event Event) {
+	r.events = append(r.events, event)
+}
+
+// PullEvents returns the recorded events in the order they were raised, forgetting them.
+func (r *EventRecorder) PullEvents() []Event {
+	events := slices.Clone(r.events)
+	r.events = nil
+
+	return events
+}
@@ -0,0 +1,82 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package domainmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/pkg/domain"
+	"sync"
+)
+
+// Ensure, that EventPublisherMock does implement domain.EventPublisher.
+// If this is not the case, regenerate this file with moq.
+var _ domain.EventPublisher = &EventPublisherMock{}
+
+// EventPublisherMock is a mock implementation of domain.EventPublisher.
+//
+//	func TestSomethingThatUsesEventPublisher(t *testing.T) {
+//
+//		// make and configure a mocked domain.EventPublisher
+//		mockedEventPublisher := &EventPublisherMock{
+//			PublishFunc: func(ctx context.Context, events ...domain.Event) error {
+//				panic("mock out the Publish method")
+//			},
+//		}
+//
+//		// use mockedEventPublisher in code that requires domain.EventPublisher
+//		// and then make assertions.
+//
+//	}
+type EventPublisherMock struct {
+	// PublishFunc mocks the Publish method.
+	PublishFunc func(ctx context.Context, events ...domain.Event) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Publish holds details about calls to the Publish method.
+		Publish []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Events is the events argument value.
+			Events []domain.Event
+		}
+	}
+	lockPublish sync.RWMutex
+}
+
+// Publish calls PublishFunc.
+func (mock *EventPublisherMock) Publish(ctx context.Context, events ...domain.Event) error {
+	if mock.PublishFunc == nil {
+		panic("EventPublisherMock.PublishFunc: method is nil but EventPublisher.Publish was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Events []domain.Event
+	}{
+		Ctx:    ctx,
+		Events: events,
+	}
+	mock.lockPublish.Lock()
+	mock.calls.Publish = append(mock.calls.Publish, callInfo)
+	mock.lockPublish.Unlock()
+	return mock.PublishFunc(ctx, events...)
+}
+
+// PublishCalls gets all the calls that were made to Publish.
+// Check the length with:
+//
+//	len(mockedEventPublisher.PublishCalls())
+func (mock *EventPublisherMock) PublishCalls() []struct {
+	Ctx    context.Context
+	Events []domain.Event
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Events []domain.Event
+	}
+	mock.lockPublish.RLock()
+	calls = mock.calls.Publish
+	mock.lockPublish.RUnlock()
+	return calls
+}
@@ -0,0 +1,65 @@
+package filter
+
+// Expr is a node of the untyped syntax tree produced by Parse.
+type Expr interface {
+	// Pos returns the 1-based position where the node starts within the expression.
+	Pos() int
+	expr()
+}
+
+type LiteralKind int
+
+const (
+	LiteralString LiteralKind = iota
+	LiteralNumber
+)
+
+// Literal is a raw value as written in the expression, it gets its type once checked against a Schema.
+type Literal struct {
+	Kind     LiteralKind
+	Raw      string
+	Position int
+}
+
+type AndExpr struct {
+	Left  Expr
+	Right Expr
+}
+
+type OrExpr struct {
+	Left  Expr
+	Right Expr
+}
+
+type NotExpr struct {
+	Operand  Expr
+	Position int
+}
+
+// ComparisonExpr represents `field <op> literal` where op is one of = != < <= > >= ~.
+type ComparisonExpr struct {
+	Field    string
+	Operator string
+	Value    Literal
+	Position int
+}
+
+// InExpr represents `field [not] in (literal, ...)`.
+type InExpr struct {
+	Field    string
+	Values   []Literal
+	Negated  bool
+	Position int
+}
+
+func (e *AndExpr) Pos() int        { return e.Left.Pos() }
+func (e *OrExpr) Pos() int         { return e.Left.Pos() }
+func (e *NotExpr) Pos() int        { return e.Position }
+func (e *ComparisonExpr) Pos() int { return e.Position }
+func (e *InExpr) Pos() int         { return e.Position }
+
+func (*AndExpr) expr()        {}
+func (*OrExpr) expr()         {}
+func (*NotExpr) expr()        {}
+func (*ComparisonExpr) expr() {}
+func (*InExpr) expr()         {}
@@ -0,0 +1,49 @@
+package filter
+
+// Operator is the comparison applied by a Condition, backends translate it into their own query language.
+type Operator string
+
+const (
+	OperatorEqual          Operator = "="
+	OperatorNotEqual       Operator = "!="
+	OperatorLess           Operator = "<"
+	OperatorLessOrEqual    Operator = "<="
+	OperatorGreater        Operator = ">"
+	OperatorGreaterOrEqual Operator = ">="
+	OperatorLike           Operator = "~"
+	OperatorIn             Operator = "in"
+	OperatorNotIn          Operator = "not in"
+)
+
+// Criteria is a type-checked filter tree, it only holds And, Or, Not and Condition nodes so
+// storage backends can push it down as-is instead of evaluating it in memory.
+type Criteria interface {
+	criteria()
+}
+
+type And struct {
+	Criteria []Criteria
+}
+
+type Or struct {
+	Criteria []Criteria
+}
+
+type Not struct {
+	Criteria Criteria
+}
+
+// Condition compares a field against one or more values already converted to the field type:
+// string for FieldString, float64 for FieldNumber and time.Time for FieldTime. Like patterns
+// are globs where '*' matches any run of characters and '?' a single one.
+type Condition struct {
+	Field    string
+	Type     FieldType
+	Operator Operator
+	Values   []any
+}
+
+func (And) criteria()       {}
+func (Or) criteria()        {}
+func (Not) criteria()       {}
+func (Condition) criteria() {}
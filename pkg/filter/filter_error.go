@@ -0,0 +1,57 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const (
+	filterPositionSemConvKey = "filter.expression.position"
+)
+
+// Error is returned when an expression cannot be tokenized, parsed or type-checked,
+// it carries the 1-based position of the offending character within the expression.
+type Error struct {
+	*errutil.BaseError
+
+	position int
+	reason   string
+}
+
+func newError(position int, format string, args ...interface{}) *Error {
+	reason := fmt.Sprintf(format, args...)
+
+	return &Error{
+		BaseError: errutil.NewError(
+			"invalid filter expression",
+			errutil.WithMetadataKeyValue(filterPositionSemConvKey, position),
+		),
+		position: position,
+		reason:   reason,
+	}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid filter at position %d: %s", e.position, e.reason)
+}
+
+// Position returns the 1-based position of the error within the expression.
+func (e *Error) Position() int {
+	return e.position
+}
+
+func (e *Error) Reason() string {
+	return e.reason
+}
+
+// AsError reports whether err is a filter expression error returning it.
+func AsError(err error) (*Error, bool) {
+	var self *Error
+	if errors.As(err, &self) {
+		return self, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,227 @@
+package filter
+
+import (
+	"strings"
+)
+
+// MaxDepth bounds how deeply expressions can be nested, so a hostile input cannot exhaust the stack.
+const MaxDepth = 32
+
+type parser struct {
+	tokens  []token
+	current int
+	depth   int
+}
+
+// Parse turns the given expression into a syntax tree following the grammar:
+//
+//	or         = and { "or" and }
+//	and        = unary { "and" unary }
+//	unary      = "not" unary | primary
+//	primary    = "(" or ")" | comparison
+//	comparison = IDENT OPERATOR literal | IDENT [ "not" ] "in" "(" literal { "," literal } ")"
+func Parse(input string) (Expr, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, newError(1, "expression cannot be empty")
+	}
+
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, current: 0, depth: 0}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, newError(next.pos, "unexpected %s, expected 'and', 'or' or end of expression", next.describe())
+	}
+
+	return expr, nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(tokenOr) {
+		right, rightErr := p.parseAnd()
+		if rightErr != nil {
+			return nil, rightErr
+		}
+		left = &OrExpr{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(tokenAnd) {
+		right, rightErr := p.parseUnary()
+		if rightErr != nil {
+			return nil, rightErr
+		}
+		left = &AndExpr{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	if next := p.peek(); next.kind == tokenNot {
+		p.advance()
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &NotExpr{Operand: operand, Position: next.pos}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	next := p.advance()
+
+	switch next.kind {
+	case tokenLeftParen:
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = p.expect(tokenRightParen, "')'"); err != nil {
+			return nil, err
+		}
+
+		return expr, nil
+	case tokenIdent:
+		return p.parseComparison(next)
+	default:
+		return nil, newError(next.pos, "unexpected %s, expected a field name or '('", next.describe())
+	}
+}
+
+func (p *parser) parseComparison(field token) (Expr, error) {
+	next := p.advance()
+
+	switch next.kind {
+	case tokenOperator:
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ComparisonExpr{Field: field.value, Operator: next.value, Value: value, Position: field.pos}, nil
+	case tokenNot:
+		if _, err := p.expect(tokenIn, "'in'"); err != nil {
+			return nil, err
+		}
+
+		return p.parseIn(field, true)
+	case tokenIn:
+		return p.parseIn(field, false)
+	default:
+		return nil, newError(next.pos, "unexpected %s, expected an operator after '%s'", next.describe(), field.value)
+	}
+}
+
+func (p *parser) parseIn(field token, negated bool) (Expr, error) {
+	if _, err := p.expect(tokenLeftParen, "'('"); err != nil {
+		return nil, err
+	}
+
+	values := make([]Literal, 0)
+	for {
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		if !p.accept(tokenComma) {
+			break
+		}
+	}
+
+	if _, err := p.expect(tokenRightParen, "')'"); err != nil {
+		return nil, err
+	}
+
+	return &InExpr{Field: field.value, Values: values, Negated: negated, Position: field.pos}, nil
+}
+
+func (p *parser) parseLiteral() (Literal, error) {
+	next := p.advance()
+
+	switch next.kind {
+	case tokenString:
+		return Literal{Kind: LiteralString, Raw: next.value, Position: next.pos}, nil
+	case tokenNumber:
+		return Literal{Kind: LiteralNumber, Raw: next.value, Position: next.pos}, nil
+	default:
+		return Literal{}, newError(next.pos, "unexpected %s, expected a string or a number", next.describe())
+	}
+}
+
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return newError(p.peek().pos, "expression is nested deeper than %d levels", MaxDepth)
+	}
+
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) advance() token {
+	next := p.tokens[p.current]
+	if next.kind != tokenEOF {
+		p.current++
+	}
+
+	return next
+}
+
+func (p *parser) accept(kind tokenKind) bool {
+	if p.peek().kind != kind {
+		return false
+	}
+
+	p.advance()
+	return true
+}
+
+func (p *parser) expect(kind tokenKind, description string) (token, error) {
+	next := p.advance()
+	if next.kind != kind {
+		return token{}, newError(next.pos, "unexpected %s, expected %s", next.describe(), description)
+	}
+
+	return next, nil
+}
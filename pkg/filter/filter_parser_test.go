@@ -0,0 +1,64 @@
+package filter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/filter"
+)
+
+func TestParse_Precedence(t *testing.T) {
+	expr, err := filter.Parse(`a = 1 or b = 2 and not c = 3`)
+	require.NoError(t, err)
+
+	or, isOr := expr.(*filter.OrExpr)
+	require.True(t, isOr)
+	assert.Equal(t, "a", or.Left.(*filter.ComparisonExpr).Field)
+
+	and, isAnd := or.Right.(*filter.AndExpr)
+	require.True(t, isAnd)
+	assert.IsType(t, &filter.NotExpr{}, and.Right)
+}
+
+func TestParse_InList(t *testing.T) {
+	expr, err := filter.Parse(`mission NOT IN ("ARTEMIS", 'LUNAR')`)
+	require.NoError(t, err)
+
+	in, isIn := expr.(*filter.InExpr)
+	require.True(t, isIn)
+	assert.True(t, in.Negated)
+	assert.Equal(t, "mission", in.Field)
+	require.Len(t, in.Values, 2)
+	assert.Equal(t, "LUNAR", in.Values[1].Raw)
+}
+
+func TestParse_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		position int
+	}{
+		{name: "empty expression", input: "  ", position: 1},
+		{name: "missing literal", input: "launch_speed >", position: 15},
+		{name: "unterminated string", input: `mission = "ARTEMIS`, position: 11},
+		{name: "unexpected character", input: "mission = @", position: 11},
+		{name: "dangling operator", input: "a = 1 and", position: 10},
+		{name: "unbalanced parens", input: "(a = 1 or b = 2", position: 16},
+		{name: "trailing tokens", input: "a = 1 b = 2", position: 7},
+		{name: "bare bang", input: "a ! 1", position: 3},
+		{name: "too deep", input: strings.Repeat("(", filter.MaxDepth+1) + "a = 1" + strings.Repeat(")", filter.MaxDepth+1), position: filter.MaxDepth + 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := filter.Parse(tc.input)
+
+			filterErr, isFilterErr := filter.AsError(err)
+			require.True(t, isFilterErr)
+			assert.Equal(t, tc.position, filterErr.Position(), filterErr.Error())
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package filter
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Accessor reads a field value from T, it must return a string, an integer or float number or a time.Time
+// matching the type declared for the field in the Schema.
+type Accessor[T any] func(T) any
+
+// Predicate reports whether a T satisfies a Criteria tree.
+type Predicate[T any] func(T) bool
+
+// NewPredicate compiles the criteria into an in-memory predicate, fields without an accessor never match.
+func NewPredicate[T any](criteria Criteria, accessors map[string]Accessor[T]) Predicate[T] {
+	switch node := criteria.(type) {
+	case And:
+		predicates := newPredicates(node.Criteria, accessors)
+		return func(item T) bool {
+			return !slices.ContainsFunc(predicates, func(p Predicate[T]) bool { return !p(item) })
+		}
+	case Or:
+		predicates := newPredicates(node.Criteria, accessors)
+		return func(item T) bool {
+			return slices.ContainsFunc(predicates, func(p Predicate[T]) bool { return p(item) })
+		}
+	case Not:
+		predicate := NewPredicate(node.Criteria, accessors)
+		return func(item T) bool { return !predicate(item) }
+	case Condition:
+		accessor, exists := accessors[node.Field]
+		if !exists {
+			return func(T) bool { return false }
+		}
+		return func(item T) bool { return node.evaluate(accessor(item)) }
+	default:
+		return func(T) bool { return true }
+	}
+}
+
+func newPredicates[T any](criteria []Criteria, accessors map[string]Accessor[T]) []Predicate[T] {
+	predicates := make([]Predicate[T], len(criteria))
+	for i, child := range criteria {
+		predicates[i] = NewPredicate(child, accessors)
+	}
+
+	return predicates
+}
+
+func (c Condition) evaluate(actual any) bool {
+	switch c.Operator {
+	case OperatorIn:
+		return slices.ContainsFunc(c.Values, func(expected any) bool { return equalValues(actual, expected) })
+	case OperatorNotIn:
+		return !slices.ContainsFunc(c.Values, func(expected any) bool { return equalValues(actual, expected) })
+	case OperatorLike:
+		text, isText := actual.(string)
+		pattern, isPattern := c.Values[0].(string)
+		return isText && isPattern && matchGlob(pattern, text)
+	case OperatorEqual, OperatorNotEqual, OperatorLess, OperatorLessOrEqual, OperatorGreater, OperatorGreaterOrEqual:
+		result, isComparable := compareValues(actual, c.Values[0])
+		return isComparable && c.compare(result)
+	default:
+		return false
+	}
+}
+
+func (c Condition) compare(result int) bool {
+	switch c.Operator {
+	case OperatorEqual:
+		return result == 0
+	case OperatorNotEqual:
+		return result != 0
+	case OperatorLess:
+		return result < 0
+	case OperatorLessOrEqual:
+		return result <= 0
+	case OperatorGreater:
+		return result > 0
+	case OperatorGreaterOrEqual:
+		return result >= 0
+	case OperatorIn, OperatorNotIn, OperatorLike:
+		return false
+	default:
+		return false
+	}
+}
+
+func equalValues(actual, expected any) bool {
+	result, isComparable := compareValues(actual, expected)
+	return isComparable && result == 0
+}
+
+// compareValues orders actual against expected, values of different kinds are not comparable.
+func compareValues(actual, expected any) (int, bool) {
+	switch value := expected.(type) {
+	case string:
+		if text, isText := actual.(string); isText {
+			return cmp.Compare(text, value), true
+		}
+	case float64:
+		if number, isNumber := toFloat(actual); isNumber {
+			return cmp.Compare(number, value), true
+		}
+	case time.Time:
+		if moment, isTime := actual.(time.Time); isTime {
+			return moment.Compare(value), true
+		}
+	}
+
+	return 0, false
+}
+
+func toFloat(value any) (float64, bool) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case float64:
+		return number, true
+	default:
+		return 0, false
+	}
+}
+
+// matchGlob reports whether text matches the pattern, '*' matches any run of characters and '?' exactly one.
+func matchGlob(pattern, text string) bool {
+	patternRunes, textRunes := []rune(pattern), []rune(text)
+	p, t, starAt, matchedAt := 0, 0, -1, 0
+
+	for t < len(textRunes) {
+		switch {
+		case p < len(patternRunes) && (patternRunes[p] == '?' || patternRunes[p] == textRunes[t]):
+			p++
+			t++
+		case p < len(patternRunes) && patternRunes[p] == '*':
+			starAt, matchedAt = p, t
+			p++
+		case starAt != -1:
+			p = starAt + 1
+			matchedAt++
+			t = matchedAt
+		default:
+			return false
+		}
+	}
+
+	for p < len(patternRunes) && patternRunes[p] == '*' {
+		p++
+	}
+
+	return p == len(patternRunes)
+}
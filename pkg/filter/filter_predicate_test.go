@@ -0,0 +1,108 @@
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/filter"
+)
+
+type fakeRocket struct {
+	kind      string
+	mission   string
+	speed     int64
+	createdAt time.Time
+}
+
+var (
+	fakeSchema = filter.Schema{
+		"rocket_type":  filter.FieldString,
+		"mission":      filter.FieldString,
+		"launch_speed": filter.FieldNumber,
+		"created_at":   filter.FieldTime,
+	}
+	fakeAccessors = map[string]filter.Accessor[fakeRocket]{
+		"rocket_type":  func(r fakeRocket) any { return r.kind },
+		"mission":      func(r fakeRocket) any { return r.mission },
+		"launch_speed": func(r fakeRocket) any { return r.speed },
+		"created_at":   func(r fakeRocket) any { return r.createdAt },
+	}
+)
+
+func TestNewPredicate(t *testing.T) {
+	rocket := fakeRocket{
+		kind:      "Falcon-9",
+		mission:   "ARTEMIS",
+		speed:     6000,
+		createdAt: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		expression string
+		matches    bool
+	}{
+		{expression: `launch_speed > 5000 and mission in ("ARTEMIS","LUNAR") and rocket_type ~ "Falcon*"`, matches: true},
+		{expression: `launch_speed >= 6000 and launch_speed <= 6000`, matches: true},
+		{expression: `launch_speed != 6000 or mission = "LUNAR"`, matches: false},
+		{expression: `not (mission not in ("ARTEMIS"))`, matches: true},
+		{expression: `rocket_type ~ "Falcon-?"`, matches: true},
+		{expression: `rocket_type ~ "Falcon"`, matches: false},
+		{expression: `created_at < "2025-01-10T00:00:01Z"`, matches: true},
+		{expression: `created_at > "2025-01-10T00:00:00Z"`, matches: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			criteria, err := fakeSchema.Compile(tc.expression)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.matches, filter.NewPredicate(criteria, fakeAccessors)(rocket))
+		})
+	}
+}
+
+func TestSchema_CompileFlattensCriteria(t *testing.T) {
+	criteria, err := fakeSchema.Compile(`mission = "A" and (rocket_type = "B" and launch_speed < 10) or mission = "C"`)
+	require.NoError(t, err)
+
+	or, isOr := criteria.(filter.Or)
+	require.True(t, isOr)
+	require.Len(t, or.Criteria, 2)
+
+	and, isAnd := or.Criteria[0].(filter.And)
+	require.True(t, isAnd)
+	require.Len(t, and.Criteria, 3)
+	assert.Equal(t, filter.Condition{
+		Field:    "launch_speed",
+		Type:     filter.FieldNumber,
+		Operator: filter.OperatorLess,
+		Values:   []any{float64(10)},
+	}, and.Criteria[2])
+}
+
+func TestSchema_CompileTypeErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		position int
+	}{
+		{name: "unknown field", input: `mission = "A" and speed > 1`, position: 19},
+		{name: "string against number", input: `launch_speed = "fast"`, position: 16},
+		{name: "number against string", input: `mission in ("A", 3)`, position: 18},
+		{name: "malformed time", input: `created_at > "yesterday"`, position: 14},
+		{name: "glob on number", input: `launch_speed ~ "5*"`, position: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := fakeSchema.Compile(tc.input)
+
+			filterErr, isFilterErr := filter.AsError(err)
+			require.True(t, isFilterErr)
+			assert.Equal(t, tc.position, filterErr.Position(), filterErr.Error())
+		})
+	}
+}
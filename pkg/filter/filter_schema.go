@@ -0,0 +1,177 @@
+package filter
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type FieldType int
+
+const (
+	FieldString FieldType = iota
+	FieldNumber
+	FieldTime
+)
+
+func (t FieldType) String() string {
+	switch t {
+	case FieldNumber:
+		return "number"
+	case FieldTime:
+		return "time"
+	case FieldString:
+		return "string"
+	default:
+		return "string"
+	}
+}
+
+// Schema maps the field names accepted in an expression to their types.
+type Schema map[string]FieldType
+
+// Compile parses the expression and type-checks it against the schema.
+func (s Schema) Compile(input string) (Criteria, error) {
+	expr, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Check(expr)
+}
+
+// Check validates fields, operators and literals of the syntax tree turning it into a typed Criteria tree.
+func (s Schema) Check(expr Expr) (Criteria, error) {
+	switch node := expr.(type) {
+	case *AndExpr:
+		children, err := s.checkOperands(node.Left, node.Right, func(c Criteria) ([]Criteria, bool) {
+			nested, isAnd := c.(And)
+			return nested.Criteria, isAnd
+		})
+		if err != nil {
+			return nil, err
+		}
+		return And{Criteria: children}, nil
+	case *OrExpr:
+		children, err := s.checkOperands(node.Left, node.Right, func(c Criteria) ([]Criteria, bool) {
+			nested, isOr := c.(Or)
+			return nested.Criteria, isOr
+		})
+		if err != nil {
+			return nil, err
+		}
+		return Or{Criteria: children}, nil
+	case *NotExpr:
+		operand, err := s.Check(node.Operand)
+		if err != nil {
+			return nil, err
+		}
+		return Not{Criteria: operand}, nil
+	case *ComparisonExpr:
+		return s.checkComparison(node)
+	case *InExpr:
+		return s.checkIn(node)
+	default:
+		return nil, newError(expr.Pos(), "unsupported expression")
+	}
+}
+
+// checkOperands flattens chains of the same boolean operator, so `a and b and c` becomes a single And node.
+func (s Schema) checkOperands(left, right Expr, unwrap func(Criteria) ([]Criteria, bool)) ([]Criteria, error) {
+	children := make([]Criteria, 0, 2)
+	for _, operand := range []Expr{left, right} {
+		checked, err := s.Check(operand)
+		if err != nil {
+			return nil, err
+		}
+
+		if nested, sameOperator := unwrap(checked); sameOperator {
+			children = append(children, nested...)
+			continue
+		}
+		children = append(children, checked)
+	}
+
+	return children, nil
+}
+
+func (s Schema) checkComparison(node *ComparisonExpr) (Criteria, error) {
+	fieldType, err := s.fieldType(node.Field, node.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := Operator(node.Operator)
+	if operator == OperatorLike && fieldType != FieldString {
+		return nil, newError(node.Position, "operator '~' only applies to string fields, '%s' is a %s", node.Field, fieldType)
+	}
+
+	value, err := convertLiteral(node.Field, fieldType, node.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return Condition{Field: node.Field, Type: fieldType, Operator: operator, Values: []any{value}}, nil
+}
+
+func (s Schema) checkIn(node *InExpr) (Criteria, error) {
+	fieldType, err := s.fieldType(node.Field, node.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, len(node.Values))
+	for i, literal := range node.Values {
+		if values[i], err = convertLiteral(node.Field, fieldType, literal); err != nil {
+			return nil, err
+		}
+	}
+
+	operator := OperatorIn
+	if node.Negated {
+		operator = OperatorNotIn
+	}
+
+	return Condition{Field: node.Field, Type: fieldType, Operator: operator, Values: values}, nil
+}
+
+func (s Schema) fieldType(field string, position int) (FieldType, error) {
+	fieldType, exists := s[field]
+	if !exists {
+		return 0, newError(position, "unknown field '%s', allowed fields are: %s", field, strings.Join(s.fields(), ", "))
+	}
+
+	return fieldType, nil
+}
+
+func (s Schema) fields() []string {
+	fields := make([]string, 0, len(s))
+	for field := range s {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	return fields
+}
+
+func convertLiteral(field string, fieldType FieldType, literal Literal) (any, error) {
+	switch {
+	case fieldType == FieldNumber && literal.Kind == LiteralNumber:
+		number, err := strconv.ParseFloat(literal.Raw, 64)
+		if err != nil {
+			return nil, newError(literal.Position, "malformed number '%s'", literal.Raw)
+		}
+		return number, nil
+	case fieldType == FieldTime && literal.Kind == LiteralString:
+		moment, err := time.Parse(time.RFC3339, literal.Raw)
+		if err != nil {
+			return nil, newError(literal.Position, "field '%s' expects an RFC3339 time, got '%s'", field, literal.Raw)
+		}
+		return moment, nil
+	case fieldType == FieldString && literal.Kind == LiteralString:
+		return literal.Raw, nil
+	default:
+		return nil, newError(literal.Position, "field '%s' expects a %s value, got '%s'", field, fieldType, literal.Raw)
+	}
+}
@@ -0,0 +1,170 @@
+package filter
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenIn
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+const operatorRunes = "=!<>~"
+
+var keywords = map[string]tokenKind{
+	"and": tokenAnd,
+	"or":  tokenOr,
+	"not": tokenNot,
+	"in":  tokenIn,
+}
+
+var punctuation = map[rune]tokenKind{
+	'(': tokenLeftParen,
+	')': tokenRightParen,
+	',': tokenComma,
+}
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) describe() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+
+	return "'" + t.value + "'"
+}
+
+type lexer struct {
+	runes  []rune
+	offset int
+	tokens []token
+}
+
+// tokenize splits the expression into tokens, positions are 1-based.
+func tokenize(input string) ([]token, error) {
+	lex := &lexer{runes: []rune(input), offset: 0, tokens: make([]token, 0)}
+
+	for lex.offset < len(lex.runes) {
+		if err := lex.scan(); err != nil {
+			return nil, err
+		}
+	}
+
+	return append(lex.tokens, token{kind: tokenEOF, pos: len(lex.runes) + 1}), nil
+}
+
+func (l *lexer) scan() error {
+	current := l.runes[l.offset]
+
+	if kind, isPunctuation := punctuation[current]; isPunctuation {
+		l.emit(kind, string(current), l.offset+1)
+		return nil
+	}
+
+	switch {
+	case unicode.IsSpace(current):
+		l.offset++
+		return nil
+	case current == '"' || current == '\'':
+		return l.scanString()
+	case strings.ContainsRune(operatorRunes, current):
+		return l.scanOperator()
+	case l.startsNumber():
+		l.scanNumber()
+		return nil
+	case unicode.IsLetter(current) || current == '_':
+		l.scanWord()
+		return nil
+	default:
+		return newError(l.offset+1, "unexpected character '%c'", current)
+	}
+}
+
+func (l *lexer) emit(kind tokenKind, value string, end int) {
+	l.tokens = append(l.tokens, token{kind: kind, value: value, pos: l.offset + 1})
+	l.offset = end
+}
+
+func (l *lexer) scanString() error {
+	quote, builder := l.runes[l.offset], strings.Builder{}
+
+	for i := l.offset + 1; i < len(l.runes); i++ {
+		switch {
+		case l.runes[i] == '\\' && i+1 < len(l.runes):
+			i++
+			builder.WriteRune(l.runes[i])
+		case l.runes[i] == quote:
+			l.emit(tokenString, builder.String(), i+1)
+			return nil
+		default:
+			builder.WriteRune(l.runes[i])
+		}
+	}
+
+	return newError(l.offset+1, "unterminated string literal")
+}
+
+func (l *lexer) scanOperator() error {
+	current, next := l.runes[l.offset], l.offset+1
+	if next < len(l.runes) && l.runes[next] == '=' && strings.ContainsRune("!<>", current) {
+		l.emit(tokenOperator, string(l.runes[l.offset:next+1]), next+1)
+		return nil
+	}
+
+	if current == '!' {
+		return newError(l.offset+1, "unexpected character '!', did you mean '!='?")
+	}
+
+	l.emit(tokenOperator, string(current), next)
+	return nil
+}
+
+func (l *lexer) startsNumber() bool {
+	current := l.runes[l.offset]
+	if unicode.IsDigit(current) {
+		return true
+	}
+
+	return current == '-' && l.offset+1 < len(l.runes) && unicode.IsDigit(l.runes[l.offset+1])
+}
+
+func (l *lexer) scanNumber() {
+	end := l.offset + 1
+	for end < len(l.runes) && (unicode.IsDigit(l.runes[end]) || l.runes[end] == '.') {
+		end++
+	}
+
+	l.emit(tokenNumber, string(l.runes[l.offset:end]), end)
+}
+
+func (l *lexer) scanWord() {
+	end := l.offset
+	for end < len(l.runes) && (unicode.IsLetter(l.runes[end]) || unicode.IsDigit(l.runes[end]) || l.runes[end] == '_') {
+		end++
+	}
+
+	word := string(l.runes[l.offset:end])
+	kind, isKeyword := keywords[strings.ToLower(word)]
+	if !isKeyword {
+		kind = tokenIdent
+	}
+
+	l.emit(kind, word, end)
+}
@@ -0,0 +1,203 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+)
+
+const (
+	HeaderIdempotencyKey      = "Idempotency-Key"
+	HeaderIdempotencyReplayed = "Idempotency-Replayed"
+	HeaderRetryAfter          = "Retry-After"
+)
+
+// defaultIdempotencyReservationTTL bounds how long a key stays reserved for a request that never got an answer.
+const defaultIdempotencyReservationTTL = 30 * time.Second
+
+type IdempotencyMiddlewareFunc func(*IdempotencyMiddleware)
+
+// WithIdempotencyReservationTTL sets how long a key stays reserved while its first request is being processed.
+func WithIdempotencyReservationTTL(ttl time.Duration) IdempotencyMiddlewareFunc {
+	return func(im *IdempotencyMiddleware) {
+		im.reservationTTL = ttl
+	}
+}
+
+// IdempotencyMiddleware answers repeated mutating requests carrying the same Idempotency-Key header with the
+// response given to the first one, as long as it is within the window. The key is reserved before the first request
+// is processed, so repeats arriving meanwhile are rejected as conflicts, as are repeats with the same key but a
+// different request. Only final responses are kept, letting requests answered otherwise be retried.
+type IdempotencyMiddleware struct {
+	store          IdempotencyStore
+	ttl            time.Duration
+	reservationTTL time.Duration
+	logger         logger.ZerologLogger
+	responseWriter *JSONResponseWriter
+}
+
+func NewIdempotencyMiddleware(
+	store IdempotencyStore,
+	ttl time.Duration,
+	logger logger.ZerologLogger,
+	opts ...IdempotencyMiddlewareFunc,
+) *IdempotencyMiddleware {
+	im := &IdempotencyMiddleware{
+		store:          store,
+		ttl:            ttl,
+		reservationTTL: defaultIdempotencyReservationTTL,
+		logger:         logger,
+		responseWriter: NewJSONResponseMiddleware(logger),
+	}
+
+	for _, opt := range opts {
+		opt(im)
+	}
+
+	return im
+}
+
+func (im *IdempotencyMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		key := req.Header.Get(HeaderIdempotencyKey)
+		if key == "" || !isMutatingMethod(req.Method) {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			im.responseWriter.WriteErrorResponse(req.Context(), w, []string{"failed to read request body"}, http.StatusBadRequest)
+			return
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		fingerprint := idempotencyFingerprint(req, body)
+		stored, found, err := im.store.Reserve(req.Context(), key, fingerprint, im.reservationTTL)
+		if err != nil {
+			im.logger.Warn().Ctx(req.Context()).Err(err).Msg("idempotency store unavailable, processing request anyway")
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		if found {
+			im.replay(w, req, stored, fingerprint)
+			return
+		}
+
+		im.process(next, w, req, key, fingerprint)
+	})
+}
+
+// process serves the request holding the reservation of its key, saving its response when final and releasing the
+// key otherwise, handler panics included.
+func (im *IdempotencyMiddleware) process(next http.Handler, w http.ResponseWriter, req *http.Request, key, fingerprint string) {
+	saved := false
+	defer func() {
+		if saved {
+			return
+		}
+
+		if err := im.store.Release(context.WithoutCancel(req.Context()), key); err != nil {
+			im.logger.Warn().Ctx(req.Context()).Err(err).Msg("failed to release idempotency key")
+		}
+	}()
+
+	recorder := newResponseRecorder(w)
+	next.ServeHTTP(recorder, req)
+
+	statusCode := recorder.Status()
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	if !isFinalResponse(statusCode, w.Header()) {
+		return
+	}
+
+	response := IdempotentResponse{
+		Fingerprint: fingerprint,
+		StatusCode:  statusCode,
+		ContentType: w.Header().Get("Content-Type"),
+		Body:        recorder.Body(),
+	}
+	if err := im.store.Save(req.Context(), key, response, im.ttl); err != nil {
+		im.logger.Warn().Ctx(req.Context()).Err(err).Msg("failed to save idempotent response")
+		return
+	}
+
+	saved = true
+}
+
+func (im *IdempotencyMiddleware) replay(w http.ResponseWriter, req *http.Request, stored IdempotentResponse, fingerprint string) {
+	if stored.Fingerprint != fingerprint {
+		im.responseWriter.WriteErrorResponse(
+			req.Context(),
+			w,
+			[]string{"idempotency key already used for a different request"},
+			http.StatusUnprocessableEntity,
+		)
+		return
+	}
+
+	if !stored.Completed() {
+		im.responseWriter.WriteErrorResponse(
+			req.Context(),
+			w,
+			[]string{"request with the same idempotency key is being processed"},
+			http.StatusConflict,
+		)
+		return
+	}
+
+	if stored.ContentType != "" {
+		w.Header().Set("Content-Type", stored.ContentType)
+	}
+	w.Header().Set(HeaderIdempotencyReplayed, "true")
+	w.WriteHeader(stored.StatusCode)
+
+	if _, err := w.Write(stored.Body); err != nil {
+		im.logger.Error().Ctx(req.Context()).Err(err).Msg("unexpected error replaying idempotent response")
+	}
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isFinalResponse tells whether repeating the request would get the same answer: successes and client errors, unless
+// they're locks or throttling, or tell when to retry them through a Retry-After header, as conflicts with a request
+// still in progress do, since those clear up on their own.
+func isFinalResponse(statusCode int, header http.Header) bool {
+	switch {
+	case header.Get(HeaderRetryAfter) != "":
+		return false
+	case statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices:
+		return true
+	case statusCode == http.StatusLocked, statusCode == http.StatusTooManyRequests:
+		return false
+	default:
+		return statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError
+	}
+}
+
+// idempotencyFingerprint identifies the request a key was first used for by its method, path and body.
+func idempotencyFingerprint(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%s %s\n", req.Method, req.URL.Path)
+	_, _ = hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
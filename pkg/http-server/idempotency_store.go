@@ -0,0 +1,31 @@
+package httpserver
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotentResponse is the first response given to a request carrying an idempotency key, along with the
+// fingerprint of the request it answered. Keys reserved for a request still being processed hold no status code.
+type IdempotentResponse struct {
+	Fingerprint string `json:"fingerprint"`
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// Completed reports whether the request was answered, false while it is still being processed.
+func (r IdempotentResponse) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// IdempotencyStore keeps the responses given to requests carrying an idempotency key.
+type IdempotencyStore interface {
+	// Reserve atomically takes the key for the request with the given fingerprint during the given window, unless the
+	// key already holds a response, completed or still in progress, which is returned instead.
+	Reserve(ctx context.Context, key string, fingerprint string, ttl time.Duration) (IdempotentResponse, bool, error)
+	// Save stores the final response for the key during the given window, replacing its reservation.
+	Save(ctx context.Context, key string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the reservation of the key, so a request left without a final response can be retried.
+	Release(ctx context.Context, key string) error
+}
@@ -0,0 +1,99 @@
+package httpserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+type inMemoryIdempotentResponse struct {
+	response  IdempotentResponse
+	expiresAt time.Time
+}
+
+// InMemoryIdempotencyStore keeps the responses in a map, sweeping the expired ones on every call so keys don't stay
+// resident once their window is over.
+type InMemoryIdempotencyStore struct {
+	mutex        sync.Mutex
+	timeProvider utils.DateTimeProvider
+	responses    map[string]inMemoryIdempotentResponse
+	expiries     *utils.ExpiryQueue
+}
+
+func NewInMemoryIdempotencyStore(timeProvider utils.DateTimeProvider) *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{
+		mutex:        sync.Mutex{},
+		timeProvider: timeProvider,
+		responses:    make(map[string]inMemoryIdempotentResponse),
+		expiries:     utils.NewExpiryQueue(),
+	}
+}
+
+func (s *InMemoryIdempotencyStore) Reserve(
+	_ context.Context,
+	key string,
+	fingerprint string,
+	ttl time.Duration,
+) (IdempotentResponse, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.sweep()
+	if stored, exists := s.responses[key]; exists {
+		return stored.response, true, nil
+	}
+
+	s.store(key, IdempotentResponse{Fingerprint: fingerprint}, now.Add(ttl))
+
+	return IdempotentResponse{}, false, nil
+}
+
+func (s *InMemoryIdempotencyStore) Save(_ context.Context, key string, response IdempotentResponse, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.store(key, response, s.sweep().Add(ttl))
+
+	return nil
+}
+
+func (s *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sweep()
+	if stored, exists := s.responses[key]; exists && !stored.response.Completed() {
+		delete(s.responses, key)
+	}
+
+	return nil
+}
+
+// Len returns how many keys are held, reserved or answered.
+func (s *InMemoryIdempotencyStore) Len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sweep()
+
+	return len(s.responses)
+}
+
+func (s *InMemoryIdempotencyStore) store(key string, response IdempotentResponse, expiresAt time.Time) {
+	s.responses[key] = inMemoryIdempotentResponse{response: response, expiresAt: expiresAt}
+	s.expiries.Push(key, expiresAt)
+}
+
+// sweep drops the responses expired by now, returning it.
+func (s *InMemoryIdempotencyStore) sweep() time.Time {
+	now := s.timeProvider.Now()
+	s.expiries.Expire(now, func(key string) {
+		if stored, exists := s.responses[key]; exists && !now.Before(stored.expiresAt) {
+			delete(s.responses, key)
+		}
+	})
+
+	return now
+}
@@ -0,0 +1,85 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// QueryParamsReader reads typed optional query params collecting every
+// malformed value found, so handlers can report all of them at once.
+type QueryParamsReader struct {
+	values url.Values
+	errors []string
+}
+
+func NewQueryParamsReader(values url.Values) *QueryParamsReader {
+	return &QueryParamsReader{values: values, errors: make([]string, 0)}
+}
+
+// String returns the value of the given param or the default one when absent.
+func (qpr *QueryParamsReader) String(param string, defaultVal string) string {
+	return FetchStringQueryParamValue(qpr.values, param, defaultVal)
+}
+
+// Int64 returns the integer value of the given param or nil when absent.
+func (qpr *QueryParamsReader) Int64(param string) *int64 {
+	rawVal := qpr.values.Get(param)
+	if rawVal == "" {
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(rawVal, 10, 64)
+	if err != nil {
+		qpr.AddError(fmt.Sprintf("%s must be a valid integer", param))
+		return nil
+	}
+
+	return &parsed
+}
+
+// Time returns the RFC3339 date-time value of the given param or nil when absent.
+func (qpr *QueryParamsReader) Time(param string) *time.Time {
+	rawVal := qpr.values.Get(param)
+	if rawVal == "" {
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, rawVal)
+	if err != nil {
+		qpr.AddError(fmt.Sprintf("%s must be a valid RFC3339 date-time", param))
+		return nil
+	}
+
+	return &parsed
+}
+
+// Duration returns the value of the given param parsed as a Go duration (e.g. 30s, 5m, 1h) or nil when absent.
+func (qpr *QueryParamsReader) Duration(param string) *time.Duration {
+	rawVal := qpr.values.Get(param)
+	if rawVal == "" {
+		return nil
+	}
+
+	parsed, err := time.ParseDuration(rawVal)
+	if err != nil {
+		qpr.AddError(fmt.Sprintf("%s must be a valid duration such as 30s, 5m or 1h", param))
+		return nil
+	}
+
+	return &parsed
+}
+
+// AddError records a custom validation error for the params being read.
+func (qpr *QueryParamsReader) AddError(msg string) {
+	qpr.errors = append(qpr.errors, msg)
+}
+
+func (qpr *QueryParamsReader) Errors() []string {
+	return qpr.errors
+}
+
+func (qpr *QueryParamsReader) HasErrors() bool {
+	return len(qpr.errors) > 0
+}
@@ -0,0 +1,100 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisIdempotencyPrefix      = "http:idempotency:"
+	redisIdempotencyReservation = "reserved:"
+)
+
+// redisIdempotencyReserveScript reserves the key unless it already holds a response, returned instead. Reservations
+// hold the fingerprint of their request behind a prefix, final responses their JSON encoding.
+var redisIdempotencyReserveScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current then
+	return current
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return ''
+`)
+
+// redisIdempotencyReleaseScript drops the key only while it holds a reservation, keeping any final response.
+var redisIdempotencyReleaseScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current and string.sub(current, 1, string.len(ARGV[1])) == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+end
+return 1
+`)
+
+type RedisIdempotencyStore struct {
+	client *redis.Client
+}
+
+func NewRedisIdempotencyStore(client *redis.Client) *RedisIdempotencyStore {
+	return &RedisIdempotencyStore{client: client}
+}
+
+func (s *RedisIdempotencyStore) Reserve(
+	ctx context.Context,
+	key string,
+	fingerprint string,
+	ttl time.Duration,
+) (IdempotentResponse, bool, error) {
+	value, err := redisIdempotencyReserveScript.Run(
+		ctx,
+		s.client,
+		[]string{redisIdempotencyPrefix + key},
+		redisIdempotencyReservation+fingerprint,
+		ttl.Milliseconds(),
+	).Text()
+	if err != nil {
+		return IdempotentResponse{}, false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	if value == "" {
+		return IdempotentResponse{}, false, nil
+	}
+
+	if reserved, match := strings.CutPrefix(value, redisIdempotencyReservation); match {
+		return IdempotentResponse{Fingerprint: reserved}, true, nil
+	}
+
+	var response IdempotentResponse
+	if err = json.Unmarshal([]byte(value), &response); err != nil {
+		return IdempotentResponse{}, false, fmt.Errorf("failed to decode idempotent response: %w", err)
+	}
+
+	return response, true, nil
+}
+
+func (s *RedisIdempotencyStore) Save(ctx context.Context, key string, response IdempotentResponse, ttl time.Duration) error {
+	value, _ := json.Marshal(response)
+
+	if err := s.client.Set(ctx, redisIdempotencyPrefix+key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RedisIdempotencyStore) Release(ctx context.Context, key string) error {
+	if err := redisIdempotencyReleaseScript.Run(
+		ctx,
+		s.client,
+		[]string{redisIdempotencyPrefix + key},
+		redisIdempotencyReservation,
+	).Err(); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package httpserver
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 )
@@ -55,3 +56,25 @@ func (r *StatusRecorder) Header() http.Header {
 func (r *StatusRecorder) Status() int {
 	return r.StatusCode
 }
+
+// ResponseRecorder is a StatusRecorder that also keeps a copy of the body sent to the client.
+type ResponseRecorder struct {
+	*StatusRecorder
+
+	body bytes.Buffer
+}
+
+func newResponseRecorder(w http.ResponseWriter) *ResponseRecorder {
+	return &ResponseRecorder{StatusRecorder: NewStatusRecorder(w), body: bytes.Buffer{}}
+}
+
+// Write sends data to the client, keeping a copy of it.
+func (r *ResponseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.StatusRecorder.Write(b)
+}
+
+// Body returns the body sent to the client so far.
+func (r *ResponseRecorder) Body() []byte {
+	return r.body.Bytes()
+}
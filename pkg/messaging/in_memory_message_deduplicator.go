@@ -0,0 +1,115 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+type inMemoryDeduplicationEntry struct {
+	token     string
+	expiresAt time.Time
+}
+
+// InMemoryDeduplicator follows the same claim protocol as RedisDeduplicator, an entry without token being a
+// processed message. Expired entries are swept on every call, so processed messages don't stay resident.
+type InMemoryDeduplicator struct {
+	mutex        sync.Mutex
+	timeProvider utils.DateTimeProvider
+	uuidProvider utils.UUIDProvider
+	claimTTL     time.Duration
+	messageTTL   time.Duration
+	entries      map[string]inMemoryDeduplicationEntry
+	expiries     *utils.ExpiryQueue
+}
+
+func NewInMemoryDeduplicator(timeProvider utils.DateTimeProvider, claimTTL, messageTTL time.Duration) *InMemoryDeduplicator {
+	return &InMemoryDeduplicator{
+		mutex:        sync.Mutex{},
+		timeProvider: timeProvider,
+		uuidProvider: utils.NewRandomUUIDProvider(),
+		claimTTL:     claimTTL,
+		messageTTL:   messageTTL,
+		entries:      make(map[string]inMemoryDeduplicationEntry),
+		expiries:     utils.NewExpiryQueue(),
+	}
+}
+
+func (d *InMemoryDeduplicator) Claim(_ context.Context, message Message) (Claim, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := d.sweep()
+	if entry, exists := d.entries[message.Identifier()]; exists {
+		if entry.token != "" {
+			return Claim{Status: ClaimInProgress, Token: ""}, nil
+		}
+
+		return Claim{Status: ClaimDuplicate, Token: ""}, nil
+	}
+
+	token := d.uuidProvider.New().String()
+	d.store(message, token, now.Add(d.claimTTL))
+
+	return Claim{Status: ClaimAcquired, Token: token}, nil
+}
+
+func (d *InMemoryDeduplicator) Commit(_ context.Context, message Message, claim Claim) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := d.sweep()
+	entry, exists := d.entries[message.Identifier()]
+	if exists && entry.token == "" {
+		return nil
+	}
+
+	if exists && entry.token != claim.Token {
+		return NewMessageClaimLostError(message)
+	}
+
+	d.store(message, "", now.Add(d.messageTTL))
+
+	return nil
+}
+
+func (d *InMemoryDeduplicator) Release(_ context.Context, message Message, claim Claim) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.sweep()
+	if entry, exists := d.entries[message.Identifier()]; exists && entry.token == claim.Token {
+		delete(d.entries, message.Identifier())
+	}
+
+	return nil
+}
+
+// Len returns how many messages are tracked, claimed or processed.
+func (d *InMemoryDeduplicator) Len() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.sweep()
+
+	return len(d.entries)
+}
+
+func (d *InMemoryDeduplicator) store(message Message, token string, expiresAt time.Time) {
+	d.entries[message.Identifier()] = inMemoryDeduplicationEntry{token: token, expiresAt: expiresAt}
+	d.expiries.Push(message.Identifier(), expiresAt)
+}
+
+// sweep drops the entries expired by now, returning it.
+func (d *InMemoryDeduplicator) sweep() time.Time {
+	now := d.timeProvider.Now()
+	d.expiries.Expire(now, func(key string) {
+		if entry, exists := d.entries[key]; exists && !now.Before(entry.expiresAt) {
+			delete(d.entries, key)
+		}
+	})
+
+	return now
+}
@@ -0,0 +1,92 @@
+package messaging_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestInMemoryDeduplicator_ClaimProtocol(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)}
+	deduplicator := messaging.NewInMemoryDeduplicator(clock, 30*time.Second, time.Hour)
+
+	claim, err := deduplicator.Claim(t.Context(), fakeMessage{})
+	require.NoError(t, err)
+	require.True(t, claim.Acquired())
+
+	concurrent, err := deduplicator.Claim(t.Context(), fakeMessage{})
+	require.NoError(t, err)
+	assert.Equal(t, messaging.ClaimInProgress, concurrent.Status, "Expected concurrent deliveries to be rejected")
+
+	require.NoError(t, deduplicator.Release(t.Context(), fakeMessage{}, claim))
+	retried, err := deduplicator.Claim(t.Context(), fakeMessage{})
+	require.NoError(t, err)
+	require.True(t, retried.Acquired(), "Expected released messages to be claimed again")
+
+	require.NoError(t, deduplicator.Commit(t.Context(), fakeMessage{}, retried))
+	duplicate, err := deduplicator.Claim(t.Context(), fakeMessage{})
+	require.NoError(t, err)
+	assert.Equal(t, messaging.ClaimDuplicate, duplicate.Status)
+
+	require.NoError(t, deduplicator.Release(t.Context(), fakeMessage{}, claim), "Expected stale releases to be a no-op")
+	duplicate, err = deduplicator.Claim(t.Context(), fakeMessage{})
+	require.NoError(t, err)
+	assert.Equal(t, messaging.ClaimDuplicate, duplicate.Status)
+}
+
+func TestInMemoryDeduplicator_CrashedClaimExpires(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)}
+	deduplicator := messaging.NewInMemoryDeduplicator(clock, 30*time.Second, time.Hour)
+
+	crashed, err := deduplicator.Claim(t.Context(), fakeMessage{})
+	require.NoError(t, err)
+	require.True(t, crashed.Acquired())
+
+	clock.now = clock.now.Add(30 * time.Second)
+	takeover, err := deduplicator.Claim(t.Context(), fakeMessage{})
+	require.NoError(t, err)
+	require.True(t, takeover.Acquired(), "Expected expired claims to be claimed again")
+
+	assert.True(t, messaging.IsMessageClaimLostError(deduplicator.Commit(t.Context(), fakeMessage{}, crashed)))
+	require.NoError(t, deduplicator.Commit(t.Context(), fakeMessage{}, takeover))
+	require.NoError(t, deduplicator.Commit(t.Context(), fakeMessage{}, crashed), "Expected claims committed by another delivery to be a no-op")
+
+	duplicate, err := deduplicator.Claim(t.Context(), fakeMessage{})
+	require.NoError(t, err)
+	assert.Equal(t, messaging.ClaimDuplicate, duplicate.Status)
+}
+
+func TestInMemoryDeduplicator_SweepsExpiredEntries(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)}
+	deduplicator := messaging.NewInMemoryDeduplicator(clock, 30*time.Second, time.Hour)
+
+	for number := range uint64(10) {
+		message := fakeSequencedMessage{channel: "rocket", number: number}
+		claim, err := deduplicator.Claim(t.Context(), message)
+		require.NoError(t, err)
+		require.NoError(t, deduplicator.Commit(t.Context(), message, claim))
+	}
+	_, err := deduplicator.Claim(t.Context(), fakeSequencedMessage{channel: "rocket", number: 10})
+	require.NoError(t, err)
+	require.Equal(t, 11, deduplicator.Len())
+
+	clock.now = clock.now.Add(30 * time.Second)
+	assert.Equal(t, 10, deduplicator.Len(), "Expected crashed claims to be swept once expired")
+
+	clock.now = clock.now.Add(time.Hour)
+	_, err = deduplicator.Claim(t.Context(), fakeSequencedMessage{channel: "rocket", number: 11})
+	require.NoError(t, err)
+	assert.Equal(t, 1, deduplicator.Len(), "Expected processed messages to be swept without being looked up again")
+}
@@ -0,0 +1,202 @@
+package messaging
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+type inMemorySequenceChannel struct {
+	hwm    uint64
+	window []byte
+}
+
+// InMemorySequenceDeduplicator follows the same high-water mark and window semantics as RedisSequenceDeduplicator,
+// the window being kept as the same ring bitmap. Expired claims are swept on every call.
+type InMemorySequenceDeduplicator struct {
+	mutex        sync.Mutex
+	timeProvider utils.DateTimeProvider
+	uuidProvider utils.UUIDProvider
+	windowSize   uint64
+	claimTTL     time.Duration
+	channels     map[string]*inMemorySequenceChannel
+	claims       map[string]inMemoryDeduplicationEntry
+	expiries     *utils.ExpiryQueue
+}
+
+func NewInMemorySequenceDeduplicator(
+	timeProvider utils.DateTimeProvider,
+	claimTTL time.Duration,
+	windowSize uint64,
+) (*InMemorySequenceDeduplicator, error) {
+	if err := validateSequenceWindowSize(windowSize); err != nil {
+		return nil, err
+	}
+
+	return &InMemorySequenceDeduplicator{
+		mutex:        sync.Mutex{},
+		timeProvider: timeProvider,
+		uuidProvider: utils.NewRandomUUIDProvider(),
+		windowSize:   windowSize,
+		claimTTL:     claimTTL,
+		channels:     make(map[string]*inMemorySequenceChannel),
+		claims:       make(map[string]inMemoryDeduplicationEntry),
+		expiries:     utils.NewExpiryQueue(),
+	}, nil
+}
+
+func (d *InMemorySequenceDeduplicator) Claim(_ context.Context, message Message) (Claim, error) {
+	sequenced, err := d.sequenced(message)
+	if err != nil {
+		return Claim{}, err
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.processed(d.channel(sequenced.Channel()), sequenced.SequenceNumber()) {
+		return Claim{Status: ClaimDuplicate, Token: ""}, nil
+	}
+
+	now := d.sweep()
+	if _, exists := d.claims[d.claimKey(sequenced)]; exists {
+		return Claim{Status: ClaimInProgress, Token: ""}, nil
+	}
+
+	token, expiresAt := d.uuidProvider.New().String(), now.Add(d.claimTTL)
+	d.claims[d.claimKey(sequenced)] = inMemoryDeduplicationEntry{token: token, expiresAt: expiresAt}
+	d.expiries.Push(d.claimKey(sequenced), expiresAt)
+
+	return Claim{Status: ClaimAcquired, Token: token}, nil
+}
+
+func (d *InMemorySequenceDeduplicator) Commit(_ context.Context, message Message, claim Claim) error {
+	sequenced, err := d.sequenced(message)
+	if err != nil {
+		return err
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.sweep()
+	if current, exists := d.claims[d.claimKey(sequenced)]; exists && current.token != claim.Token {
+		return NewMessageClaimLostError(message)
+	}
+
+	delete(d.claims, d.claimKey(sequenced))
+
+	channel, number := d.channel(sequenced.Channel()), sequenced.SequenceNumber()
+	if d.processed(channel, number) {
+		return nil
+	}
+
+	if number > channel.hwm {
+		if number-channel.hwm >= d.windowSize {
+			clear(channel.window)
+		} else {
+			for skipped := channel.hwm + 1; skipped < number; skipped++ {
+				d.setBit(channel, skipped, false)
+			}
+		}
+
+		channel.hwm = number
+	}
+
+	d.setBit(channel, number, true)
+
+	return nil
+}
+
+func (d *InMemorySequenceDeduplicator) Release(_ context.Context, message Message, claim Claim) error {
+	sequenced, err := d.sequenced(message)
+	if err != nil {
+		return err
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.sweep()
+	if current, exists := d.claims[d.claimKey(sequenced)]; exists && current.token == claim.Token {
+		delete(d.claims, d.claimKey(sequenced))
+	}
+
+	return nil
+}
+
+// Gaps returns the numbers of the window below the high-water mark of the channel that were never processed.
+func (d *InMemorySequenceDeduplicator) Gaps(_ context.Context, channel string) ([]uint64, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	tracked, exists := d.channels[channel]
+	if !exists {
+		return []uint64{}, nil
+	}
+
+	return SequenceWindowGaps(tracked.hwm, tracked.window, d.windowSize), nil
+}
+
+// processed tells whether the number is older than the window or its bit is set within it.
+func (d *InMemorySequenceDeduplicator) processed(channel *inMemorySequenceChannel, number uint64) bool {
+	if number+d.windowSize <= channel.hwm {
+		return true
+	}
+
+	offset := number % d.windowSize
+
+	return number <= channel.hwm && channel.window[offset/8]&(0x80>>(offset%8)) != 0
+}
+
+func (d *InMemorySequenceDeduplicator) setBit(channel *inMemorySequenceChannel, number uint64, value bool) {
+	offset := number % d.windowSize
+	if value {
+		channel.window[offset/8] |= 0x80 >> (offset % 8)
+		return
+	}
+
+	channel.window[offset/8] &^= 0x80 >> (offset % 8)
+}
+
+func (d *InMemorySequenceDeduplicator) channel(name string) *inMemorySequenceChannel {
+	channel, exists := d.channels[name]
+	if !exists {
+		channel = &inMemorySequenceChannel{hwm: 0, window: make([]byte, (d.windowSize+7)/8)}
+		d.channels[name] = channel
+	}
+
+	return channel
+}
+
+// sweep drops the claims expired by now, returning it.
+func (d *InMemorySequenceDeduplicator) sweep() time.Time {
+	now := d.timeProvider.Now()
+	d.expiries.Expire(now, func(key string) {
+		if claim, exists := d.claims[key]; exists && !now.Before(claim.expiresAt) {
+			delete(d.claims, key)
+		}
+	})
+
+	return now
+}
+
+func (d *InMemorySequenceDeduplicator) claimKey(message SequencedMessage) string {
+	return message.Channel() + ":" + strconv.FormatUint(message.SequenceNumber(), 10)
+}
+
+func (d *InMemorySequenceDeduplicator) sequenced(message Message) (SequencedMessage, error) {
+	sequenced, match := message.(SequencedMessage)
+	if !match {
+		return nil, errutil.NewError(
+			"message is not sequenced",
+			errutil.WithMetadataKeyValue(messagingMessageSemConvKey, message.Identifier()),
+		)
+	}
+
+	return sequenced, nil
+}
@@ -0,0 +1,160 @@
+package messaging_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+)
+
+type fakeSequencedMessage struct {
+	channel string
+	number  uint64
+}
+
+func (m fakeSequencedMessage) Identifier() string {
+	return m.channel + ":" + strconv.FormatUint(m.number, 10)
+}
+
+func (m fakeSequencedMessage) Channel() string {
+	return m.channel
+}
+
+func (m fakeSequencedMessage) SequenceNumber() uint64 {
+	return m.number
+}
+
+func TestInMemorySequenceDeduplicator_Window(t *testing.T) {
+	testCases := []struct {
+		name           string
+		committed      []uint64
+		claimed        uint64
+		expectedStatus messaging.ClaimStatus
+		expectedGaps   []uint64
+	}{
+		{
+			name:      "missing numbers within the window are gaps",
+			committed: []uint64{1, 2, 5, 7}, claimed: 6,
+			expectedStatus: messaging.ClaimAcquired, expectedGaps: []uint64{4, 6},
+		},
+		{
+			name:      "processed numbers within the window are duplicates",
+			committed: []uint64{1, 2, 5, 7}, claimed: 5,
+			expectedStatus: messaging.ClaimDuplicate, expectedGaps: []uint64{4, 6},
+		},
+		{
+			name:      "numbers below the window are duplicates",
+			committed: []uint64{1, 2, 5, 7}, claimed: 3,
+			expectedStatus: messaging.ClaimDuplicate, expectedGaps: []uint64{4, 6},
+		},
+		{
+			name:      "jumping a whole window ahead evicts it",
+			committed: []uint64{1, 2, 3, 20}, claimed: 18,
+			expectedStatus: messaging.ClaimAcquired, expectedGaps: []uint64{17, 18, 19},
+		},
+		{
+			name:      "jumping exactly a window ahead evicts it",
+			committed: []uint64{1, 2, 3, 7}, claimed: 3,
+			expectedStatus: messaging.ClaimDuplicate, expectedGaps: []uint64{4, 5, 6},
+		},
+		{
+			name:      "moving ahead within the window clears the slots left behind",
+			committed: []uint64{1, 2, 3, 4, 6}, claimed: 5,
+			expectedStatus: messaging.ClaimAcquired, expectedGaps: []uint64{5},
+		},
+		{
+			name:      "out of order commits fill their gaps",
+			committed: []uint64{4, 2, 3, 1}, claimed: 2,
+			expectedStatus: messaging.ClaimDuplicate, expectedGaps: []uint64{},
+		},
+		{
+			name:      "numbers left below the window are duplicates as the high-water mark moves",
+			committed: []uint64{9, 1, 10}, claimed: 8,
+			expectedStatus: messaging.ClaimAcquired, expectedGaps: []uint64{7, 8},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)}
+			deduplicator, err := messaging.NewInMemorySequenceDeduplicator(clock, 30*time.Second, 4)
+			require.NoError(t, err)
+
+			for _, number := range tc.committed {
+				message := fakeSequencedMessage{channel: "rocket", number: number}
+				claim, err := deduplicator.Claim(t.Context(), message)
+				require.NoError(t, err)
+				if claim.Acquired() {
+					require.NoError(t, deduplicator.Commit(t.Context(), message, claim))
+				}
+			}
+
+			claim, err := deduplicator.Claim(t.Context(), fakeSequencedMessage{channel: "rocket", number: tc.claimed})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, claim.Status)
+
+			gaps, err := deduplicator.Gaps(t.Context(), "rocket")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedGaps, gaps)
+		})
+	}
+}
+
+func TestInMemorySequenceDeduplicator_ClaimProtocol(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)}
+	deduplicator, err := messaging.NewInMemorySequenceDeduplicator(clock, 30*time.Second, 4)
+	require.NoError(t, err)
+	message := fakeSequencedMessage{channel: "rocket", number: 1}
+
+	crashed, err := deduplicator.Claim(t.Context(), message)
+	require.NoError(t, err)
+	require.True(t, crashed.Acquired())
+
+	concurrent, err := deduplicator.Claim(t.Context(), message)
+	require.NoError(t, err)
+	assert.Equal(t, messaging.ClaimInProgress, concurrent.Status, "Expected concurrent deliveries to be rejected")
+
+	clock.now = clock.now.Add(30 * time.Second)
+	takeover, err := deduplicator.Claim(t.Context(), message)
+	require.NoError(t, err)
+	require.True(t, takeover.Acquired(), "Expected expired claims to be claimed again")
+
+	assert.True(t, messaging.IsMessageClaimLostError(deduplicator.Commit(t.Context(), message, crashed)))
+	require.NoError(t, deduplicator.Release(t.Context(), message, crashed), "Expected stale releases to be a no-op")
+	require.NoError(t, deduplicator.Commit(t.Context(), message, takeover))
+	require.NoError(t, deduplicator.Commit(t.Context(), message, crashed), "Expected numbers committed by another delivery to be a no-op")
+
+	duplicate, err := deduplicator.Claim(t.Context(), message)
+	require.NoError(t, err)
+	assert.Equal(t, messaging.ClaimDuplicate, duplicate.Status)
+
+	late := fakeSequencedMessage{channel: "rocket", number: 2}
+	lateClaim, err := deduplicator.Claim(t.Context(), late)
+	require.NoError(t, err)
+	require.True(t, lateClaim.Acquired())
+
+	ahead := fakeSequencedMessage{channel: "rocket", number: 9}
+	aheadClaim, err := deduplicator.Claim(t.Context(), ahead)
+	require.NoError(t, err)
+	require.NoError(t, deduplicator.Commit(t.Context(), ahead, aheadClaim))
+	require.NoError(t, deduplicator.Commit(t.Context(), late, lateClaim), "Expected late commits below the window to be a no-op")
+
+	gaps, err := deduplicator.Gaps(t.Context(), "rocket")
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{6, 7, 8}, gaps, "Expected late commits below the window not to touch it")
+
+	_, err = deduplicator.Claim(t.Context(), fakeMessage{})
+	assert.Error(t, err, "Expected messages without sequence to be rejected")
+}
+
+func TestSequenceDeduplicators_RejectEmptyWindow(t *testing.T) {
+	_, err := messaging.NewInMemorySequenceDeduplicator(&fakeClock{now: time.Now()}, 30*time.Second, 0)
+	require.Error(t, err, "Expected in-memory deduplicators to reject empty windows")
+
+	_, err = messaging.NewRedisSequenceDeduplicator(nil, messaging.WithRedisSequenceDeduplicatorWindowSize(0))
+	require.Error(t, err, "Expected Redis deduplicators to reject empty windows")
+}
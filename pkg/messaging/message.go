@@ -3,3 +3,11 @@ package messaging
 type Message interface {
 	Identifier() string
 }
+
+// SequencedMessage is a message numbered within its channel by a monotonically increasing sequence.
+type SequencedMessage interface {
+	Message
+
+	Channel() string
+	SequenceNumber() uint64
+}
@@ -4,7 +4,45 @@ import (
 	"context"
 )
 
+// ClaimStatus tells whether a message was claimed for processing or is already taken.
+type ClaimStatus string
+
+const (
+	// ClaimAcquired means the message was claimed and must be committed or released once processed.
+	ClaimAcquired ClaimStatus = "acquired"
+	// ClaimDuplicate means the message was already processed.
+	ClaimDuplicate ClaimStatus = "duplicate"
+	// ClaimInProgress means another delivery of the message is being processed right now.
+	ClaimInProgress ClaimStatus = "in_progress"
+)
+
+// Claim is the result of claiming a message, the token identifies the owner of an acquired claim.
+type Claim struct {
+	Status ClaimStatus
+	Token  string
+}
+
+func (c Claim) Acquired() bool {
+	return c.Status == ClaimAcquired
+}
+
+// Deduplicator guarantees every message is processed once through a claim protocol: a message is claimed before
+// being processed, then committed when processed or released when it failed so it can be retried. Claims of crashed
+// processes expire after a while, leaving the message free to be claimed again.
+//
+//go:generate moq -pkg messagingmock -out mock/deduplicator_moq.go . Deduplicator
 type Deduplicator interface {
-	IsDuplicate(ctx context.Context, message Message) (bool, error)
-	MarkProcessed(ctx context.Context, message Message) error
+	// Claim atomically takes the message for processing unless it was already processed or is being processed.
+	Claim(ctx context.Context, message Message) (Claim, error)
+	// Commit marks the claimed message as processed, a no-op when another delivery already did. MessageClaimLostError
+	// when the claim was taken over by another delivery still being processed.
+	Commit(ctx context.Context, message Message, claim Claim) error
+	// Release gives up the claim on the message so it can be claimed again, it is a no-op for claims not owned anymore.
+	Release(ctx context.Context, message Message, claim Claim) error
+}
+
+// SequenceGapsFinder reports the numbers of a channel that were never processed, as tracked by the deduplicators
+// deduplicating messages by sequence.
+type SequenceGapsFinder interface {
+	Gaps(ctx context.Context, channel string) ([]uint64, error)
 }
@@ -0,0 +1,89 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+)
+
+// DeduplicatorFailurePolicy tells what to do with messages while the deduplicator backend is unavailable.
+type DeduplicatorFailurePolicy string
+
+const (
+	// DeduplicatorFailOpen processes the messages anyway, at the risk of processing some of them twice.
+	DeduplicatorFailOpen DeduplicatorFailurePolicy = "open"
+	// DeduplicatorFailClosed rejects the messages until the backend is back, so producers retry them later.
+	DeduplicatorFailClosed DeduplicatorFailurePolicy = "closed"
+)
+
+func NewDeduplicatorFailurePolicy(value string) (DeduplicatorFailurePolicy, error) {
+	switch policy := DeduplicatorFailurePolicy(value); policy {
+	case DeduplicatorFailOpen, DeduplicatorFailClosed:
+		return policy, nil
+	default:
+		return "", errutil.NewError(
+			"invalid deduplicator failure policy provided",
+			errutil.WithMetadataKeyValue("messaging.deduplicator.failure_policy", value),
+		)
+	}
+}
+
+// WithFailurePolicy applies the policy to the failures of the given deduplicator. Failing closed leaves it as is,
+// since its failures are already told apart as DeduplicatorUnavailableError.
+func WithFailurePolicy(deduplicator Deduplicator, policy DeduplicatorFailurePolicy, logger logger.ZerologLogger) Deduplicator {
+	if policy != DeduplicatorFailOpen {
+		return deduplicator
+	}
+
+	return &FailOpenDeduplicator{deduplicator: deduplicator, logger: logger}
+}
+
+// FailOpenDeduplicator treats the messages as new ones while the deduplicator backend is unavailable.
+type FailOpenDeduplicator struct {
+	deduplicator Deduplicator
+	logger       logger.ZerologLogger
+}
+
+func (d *FailOpenDeduplicator) Claim(ctx context.Context, message Message) (Claim, error) {
+	claim, err := d.deduplicator.Claim(ctx, message)
+	if IsDeduplicatorUnavailableError(err) {
+		d.logger.Warn().Ctx(ctx).Err(err).Msg("deduplicator unavailable, processing message anyway")
+		return Claim{Status: ClaimAcquired, Token: ""}, nil
+	}
+
+	if err != nil {
+		return Claim{}, fmt.Errorf("failed to claim message: %w", err)
+	}
+
+	return claim, nil
+}
+
+func (d *FailOpenDeduplicator) Commit(ctx context.Context, message Message, claim Claim) error {
+	err := d.deduplicator.Commit(ctx, message, claim)
+	if IsDeduplicatorUnavailableError(err) {
+		d.logger.Warn().Ctx(ctx).Err(err).Msg("deduplicator unavailable, message left uncommitted")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to commit message: %w", err)
+	}
+
+	return nil
+}
+
+func (d *FailOpenDeduplicator) Release(ctx context.Context, message Message, claim Claim) error {
+	err := d.deduplicator.Release(ctx, message, claim)
+	if IsDeduplicatorUnavailableError(err) {
+		d.logger.Warn().Ctx(ctx).Err(err).Msg("deduplicator unavailable, message claim left to expire")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to release message: %w", err)
+	}
+
+	return nil
+}
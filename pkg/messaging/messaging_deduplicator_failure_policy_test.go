@@ -0,0 +1,99 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+	messagingmock "github.com/soulcodex/rockets-message-processor/pkg/messaging/mock"
+)
+
+type fakeMessage struct{}
+
+func (m fakeMessage) Identifier() string {
+	return "fake_message_id"
+}
+
+func TestWithFailurePolicy(t *testing.T) {
+	unavailableErr := messaging.NewDeduplicatorUnavailableError(fakeMessage{}, errors.New("connection refused"))
+	unexpectedErr := errors.New("unexpected failure")
+
+	testCases := []struct {
+		name         string
+		policy       messaging.DeduplicatorFailurePolicy
+		err          error
+		expectStatus messaging.ClaimStatus
+		expectErr    func(t *testing.T, err error)
+	}{
+		{
+			name:   "fail open processes messages while the backend is unavailable",
+			policy: messaging.DeduplicatorFailOpen, err: unavailableErr, expectStatus: messaging.ClaimAcquired,
+			expectErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "fail open keeps any other failure",
+			policy: messaging.DeduplicatorFailOpen, err: unexpectedErr,
+			expectErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, unexpectedErr)
+				assert.False(t, messaging.IsDeduplicatorUnavailableError(err))
+			},
+		},
+		{
+			name:   "fail closed rejects messages while the backend is unavailable",
+			policy: messaging.DeduplicatorFailClosed, err: unavailableErr,
+			expectErr: func(t *testing.T, err error) {
+				assert.True(t, messaging.IsDeduplicatorUnavailableError(err))
+			},
+		},
+		{
+			name:   "duplicates are reported whatever the policy",
+			policy: messaging.DeduplicatorFailOpen, expectStatus: messaging.ClaimDuplicate,
+			expectErr: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inner := &messagingmock.DeduplicatorMock{
+				ClaimFunc: func(_ context.Context, _ messaging.Message) (messaging.Claim, error) {
+					if tc.err != nil {
+						return messaging.Claim{}, tc.err
+					}
+					return messaging.Claim{Status: tc.expectStatus}, nil
+				},
+				CommitFunc: func(_ context.Context, _ messaging.Message, _ messaging.Claim) error {
+					return tc.err
+				},
+				ReleaseFunc: func(_ context.Context, _ messaging.Message, _ messaging.Claim) error {
+					return tc.err
+				},
+			}
+			deduplicator := messaging.WithFailurePolicy(inner, tc.policy, logger.NewZerologLogger(t.Context(), "test"))
+
+			claim, err := deduplicator.Claim(t.Context(), fakeMessage{})
+			tc.expectErr(t, err)
+			assert.Equal(t, tc.expectStatus, claim.Status)
+
+			tc.expectErr(t, deduplicator.Commit(t.Context(), fakeMessage{}, claim))
+			tc.expectErr(t, deduplicator.Release(t.Context(), fakeMessage{}, claim))
+		})
+	}
+}
+
+func TestNewDeduplicatorFailurePolicy(t *testing.T) {
+	policy, err := messaging.NewDeduplicatorFailurePolicy("open")
+	require.NoError(t, err)
+	assert.Equal(t, messaging.DeduplicatorFailOpen, policy)
+
+	_, err = messaging.NewDeduplicatorFailurePolicy("ajar")
+	require.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package messaging
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const messagingMessageSemConvKey = "messaging.message.id"
+
+// DeduplicatorUnavailableError means the deduplicator backend could not be reached, so it is unknown whether
+// the message is a duplicate or not.
+type DeduplicatorUnavailableError struct {
+	*errutil.BaseError
+}
+
+func NewDeduplicatorUnavailableError(message Message, cause error) *DeduplicatorUnavailableError {
+	return &DeduplicatorUnavailableError{
+		BaseError: errutil.NewError(
+			"message deduplicator unavailable",
+			errutil.WithCause(cause),
+			errutil.WithMetadataKeyValue(messagingMessageSemConvKey, message.Identifier()),
+		),
+	}
+}
+
+func IsDeduplicatorUnavailableError(err error) bool {
+	var self *DeduplicatorUnavailableError
+	return errors.As(err, &self)
+}
+
+// MessageInProgressError means another delivery of the message is being processed.
+type MessageInProgressError struct {
+	*errutil.BaseError
+}
+
+func NewMessageInProgressError(message Message) *MessageInProgressError {
+	return &MessageInProgressError{
+		BaseError: errutil.NewError(
+			"message is being processed",
+			errutil.WithMetadataKeyValue(messagingMessageSemConvKey, message.Identifier()),
+		),
+	}
+}
+
+func IsMessageInProgressError(err error) bool {
+	var self *MessageInProgressError
+	return errors.As(err, &self)
+}
+
+// MessageClaimLostError means the claim expired while the message was processed and was taken over by another one.
+type MessageClaimLostError struct {
+	*errutil.BaseError
+}
+
+func NewMessageClaimLostError(message Message) *MessageClaimLostError {
+	return &MessageClaimLostError{
+		BaseError: errutil.NewError(
+			"message claim lost",
+			errutil.WithMetadataKeyValue(messagingMessageSemConvKey, message.Identifier()),
+		),
+	}
+}
+
+func IsMessageClaimLostError(err error) bool {
+	var self *MessageClaimLostError
+	return errors.As(err, &self)
+}
@@ -0,0 +1,194 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package messagingmock
+
+import (
+	"context"
+	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+	"sync"
+)
+
+// Ensure, that DeduplicatorMock does implement messaging.Deduplicator.
+// If this is not the case, regenerate this file with moq.
+var _ messaging.Deduplicator = &DeduplicatorMock{}
+
+// DeduplicatorMock is a mock implementation of messaging.Deduplicator.
+//
+//	func TestSomethingThatUsesDeduplicator(t *testing.T) {
+//
+//		// make and configure a mocked messaging.Deduplicator
+//		mockedDeduplicator := &DeduplicatorMock{
+//			ClaimFunc: func(ctx context.Context, message messaging.Message) (messaging.Claim, error) {
+//				panic("mock out the Claim method")
+//			},
+//			CommitFunc: func(ctx context.Context, message messaging.Message, claim messaging.Claim) error {
+//				panic("mock out the Commit method")
+//			},
+//			ReleaseFunc: func(ctx context.Context, message messaging.Message, claim messaging.Claim) error {
+//				panic("mock out the Release method")
+//			},
+//		}
+//
+//		// use mockedDeduplicator in code that requires messaging.Deduplicator
+//		// and then make assertions.
+//
+//	}
+type DeduplicatorMock struct {
+	// ClaimFunc mocks the Claim method.
+	ClaimFunc func(ctx context.Context, message messaging.Message) (messaging.Claim, error)
+
+	// CommitFunc mocks the Commit method.
+	CommitFunc func(ctx context.Context, message messaging.Message, claim messaging.Claim) error
+
+	// ReleaseFunc mocks the Release method.
+	ReleaseFunc func(ctx context.Context, message messaging.Message, claim messaging.Claim) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Claim holds details about calls to the Claim method.
+		Claim []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Message is the message argument value.
+			Message messaging.Message
+		}
+		// Commit holds details about calls to the Commit method.
+		Commit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Message is the message argument value.
+			Message messaging.Message
+			// Claim is the claim argument value.
+			Claim messaging.Claim
+		}
+		// Release holds details about calls to the Release method.
+		Release []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Message is the message argument value.
+			Message messaging.Message
+			// Claim is the claim argument value.
+			Claim messaging.Claim
+		}
+	}
+	lockClaim   sync.RWMutex
+	lockCommit  sync.RWMutex
+	lockRelease sync.RWMutex
+}
+
+// Claim calls ClaimFunc.
+func (mock *DeduplicatorMock) Claim(ctx context.Context, message messaging.Message) (messaging.Claim, error) {
+	if mock.ClaimFunc == nil {
+		panic("DeduplicatorMock.ClaimFunc: method is nil but Deduplicator.Claim was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Message messaging.Message
+	}{
+		Ctx:     ctx,
+		Message: message,
+	}
+	mock.lockClaim.Lock()
+	mock.calls.Claim = append(mock.calls.Claim, callInfo)
+	mock.lockClaim.Unlock()
+	return mock.ClaimFunc(ctx, message)
+}
+
+// ClaimCalls gets all the calls that were made to Claim.
+// Check the length with:
+//
+//	len(mockedDeduplicator.ClaimCalls())
+func (mock *DeduplicatorMock) ClaimCalls() []struct {
+	Ctx     context.Context
+	Message messaging.Message
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Message messaging.Message
+	}
+	mock.lockClaim.RLock()
+	calls = mock.calls.Claim
+	mock.lockClaim.RUnlock()
+	return calls
+}
+
+// Commit calls CommitFunc.
+func (mock *DeduplicatorMock) Commit(ctx context.Context, message messaging.Message, claim messaging.Claim) error {
+	if mock.CommitFunc == nil {
+		panic("DeduplicatorMock.CommitFunc: method is nil but Deduplicator.Commit was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Message messaging.Message
+		Claim   messaging.Claim
+	}{
+		Ctx:     ctx,
+		Message: message,
+		Claim:   claim,
+	}
+	mock.lockCommit.Lock()
+	mock.calls.Commit = append(mock.calls.Commit, callInfo)
+	mock.lockCommit.Unlock()
+	return mock.CommitFunc(ctx, message, claim)
+}
+
+// CommitCalls gets all the calls that were made to Commit.
+// Check the length with:
+//
+//	len(mockedDeduplicator.CommitCalls())
+func (mock *DeduplicatorMock) CommitCalls() []struct {
+	Ctx     context.Context
+	Message messaging.Message
+	Claim   messaging.Claim
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Message messaging.Message
+		Claim   messaging.Claim
+	}
+	mock.lockCommit.RLock()
+	calls = mock.calls.Commit
+	mock.lockCommit.RUnlock()
+	return calls
+}
+
+// Release calls ReleaseFunc.
+func (mock *DeduplicatorMock) Release(ctx context.Context, message messaging.Message, claim messaging.Claim) error {
+	if mock.ReleaseFunc == nil {
+		panic("DeduplicatorMock.ReleaseFunc: method is nil but Deduplicator.Release was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Message messaging.Message
+		Claim   messaging.Claim
+	}{
+		Ctx:     ctx,
+		Message: message,
+		Claim:   claim,
+	}
+	mock.lockRelease.Lock()
+	mock.calls.Release = append(mock.calls.Release, callInfo)
+	mock.lockRelease.Unlock()
+	return mock.ReleaseFunc(ctx, message, claim)
+}
+
+// ReleaseCalls gets all the calls that were made to Release.
+// Check the length with:
+//
+//	len(mockedDeduplicator.ReleaseCalls())
+func (mock *DeduplicatorMock) ReleaseCalls() []struct {
+	Ctx     context.Context
+	Message messaging.Message
+	Claim   messaging.Claim
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Message messaging.Message
+		Claim   messaging.Claim
+	}
+	mock.lockRelease.RLock()
+	calls = mock.calls.Release
+	mock.lockRelease.RUnlock()
+	return calls
+}
@@ -2,40 +2,141 @@ package messaging
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/redis/go-redis/v9"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
 )
 
 const (
-	defaultTTLSeconds = 29
+	defaultTTLSeconds      = 29
+	defaultClaimTTLSeconds = 30
+
+	redisClaimPrefix = "claim:"
 )
 
+// redisClaimScript claims the message unless its key holds a claim (in progress) or anything else (processed).
+var redisClaimScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+	return 'acquired'
+end
+if string.sub(current, 1, string.len(ARGV[3])) == ARGV[3] then
+	return 'in_progress'
+end
+return 'duplicate'
+`)
+
+// redisCommitScript marks the message as processed as long as the claim wasn't taken over by another delivery still
+// being processed. An expired claim nobody took over is committed anyway as the message was processed, and a message
+// another delivery already committed is left as it is.
+var redisCommitScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current or current == ARGV[1] then
+	redis.call('SET', KEYS[1], '1', 'PX', ARGV[2])
+	return 1
+end
+if string.sub(current, 1, string.len(ARGV[3])) == ARGV[3] then
+	return 0
+end
+return 1
+`)
+
+// redisReleaseScript drops the claim only when it is still owned.
+var redisReleaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+end
+return 1
+`)
+
+type RedisDeduplicatorOption func(*RedisDeduplicator)
+
+// WithRedisDeduplicatorClaimTTL sets how long a claim lasts before being considered crashed.
+func WithRedisDeduplicatorClaimTTL(ttl time.Duration) RedisDeduplicatorOption {
+	return func(d *RedisDeduplicator) {
+		d.claimTTL = ttl
+	}
+}
+
+// WithRedisDeduplicatorMessageTTL sets how long processed messages are remembered.
+func WithRedisDeduplicatorMessageTTL(ttl time.Duration) RedisDeduplicatorOption {
+	return func(d *RedisDeduplicator) {
+		d.messageTTL = ttl
+	}
+}
+
+// RedisDeduplicator keeps a key per message holding either the claim token while it is processed, or a marker
+// once processed. Every step of the protocol runs as a Lua script so it is atomic.
 type RedisDeduplicator struct {
-	client     *redis.Client
-	messageTTL int
+	client       *redis.Client
+	uuidProvider utils.UUIDProvider
+	claimTTL     time.Duration
+	messageTTL   time.Duration
 }
 
-func NewDefaultRedisDeduplicator(client *redis.Client) *RedisDeduplicator {
-	return &RedisDeduplicator{
-		client:     client,
-		messageTTL: defaultTTLSeconds,
+func NewDefaultRedisDeduplicator(client *redis.Client, opts ...RedisDeduplicatorOption) *RedisDeduplicator {
+	deduplicator := &RedisDeduplicator{
+		client:       client,
+		uuidProvider: utils.NewRandomUUIDProvider(),
+		claimTTL:     defaultClaimTTLSeconds * time.Second,
+		messageTTL:   defaultTTLSeconds * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(deduplicator)
 	}
+
+	return deduplicator
 }
 
-func (d *RedisDeduplicator) IsDuplicate(ctx context.Context, message Message) (bool, error) {
-	exists, err := d.client.Exists(ctx, message.Identifier()).Result()
+func (d *RedisDeduplicator) Claim(ctx context.Context, message Message) (Claim, error) {
+	token := redisClaimPrefix + d.uuidProvider.New().String()
+
+	status, err := redisClaimScript.Run(
+		ctx,
+		d.client,
+		[]string{message.Identifier()},
+		token,
+		d.claimTTL.Milliseconds(),
+		redisClaimPrefix,
+	).Text()
 	if err != nil {
-		return false, fmt.Errorf("failed to check message duplicity: %w", err)
+		return Claim{}, NewDeduplicatorUnavailableError(message, err)
+	}
+
+	if ClaimStatus(status) != ClaimAcquired {
+		return Claim{Status: ClaimStatus(status), Token: ""}, nil
 	}
-	return exists == 1, nil
+
+	return Claim{Status: ClaimAcquired, Token: token}, nil
+}
+
+func (d *RedisDeduplicator) Commit(ctx context.Context, message Message, claim Claim) error {
+	committed, err := redisCommitScript.Run(
+		ctx,
+		d.client,
+		[]string{message.Identifier()},
+		claim.Token,
+		d.messageTTL.Milliseconds(),
+		redisClaimPrefix,
+	).Int()
+	if err != nil {
+		return NewDeduplicatorUnavailableError(message, err)
+	}
+
+	if committed == 0 {
+		return NewMessageClaimLostError(message)
+	}
+
+	return nil
 }
 
-func (d *RedisDeduplicator) MarkProcessed(ctx context.Context, message Message) error {
-	status := d.client.SetNX(ctx, message.Identifier(), "1", time.Duration(d.messageTTL)*time.Second)
-	if _, err := status.Result(); err != nil {
-		return fmt.Errorf("failed to mark message as processed: %w", err)
+func (d *RedisDeduplicator) Release(ctx context.Context, message Message, claim Claim) error {
+	if err := redisReleaseScript.Run(ctx, d.client, []string{message.Identifier()}, claim.Token).Err(); err != nil {
+		return NewDeduplicatorUnavailableError(message, err)
 	}
 
 	return nil
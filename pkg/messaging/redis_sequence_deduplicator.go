@@ -0,0 +1,255 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+const (
+	defaultSequenceWindowSize = 1024
+
+	redisSequencePrefix = "messaging:sequence:"
+)
+
+// redisSequenceClaimScript tells processed numbers apart through the high-water mark and the window below it, then
+// claims the number unless another delivery holds it.
+var redisSequenceClaimScript = redis.NewScript(`
+local n, w = tonumber(ARGV[1]), tonumber(ARGV[2])
+local hwm = tonumber(redis.call('GET', KEYS[1]) or '0')
+if n <= hwm - w then
+	return 'duplicate'
+end
+if n <= hwm and redis.call('GETBIT', KEYS[2], n % w) == 1 then
+	return 'duplicate'
+end
+if redis.call('SET', KEYS[3], ARGV[3], 'NX', 'PX', ARGV[4]) then
+	return 'acquired'
+end
+return 'in_progress'
+`)
+
+// redisSequenceCommitScript marks the number as processed, moving the high-water mark forward and clearing the
+// window slots it leaves behind when the number is the highest one so far. Numbers another delivery already
+// committed are left as they are.
+var redisSequenceCommitScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[3])
+if current and current ~= ARGV[3] then
+	return 0
+end
+local n, w = tonumber(ARGV[1]), tonumber(ARGV[2])
+local hwm = tonumber(redis.call('GET', KEYS[1]) or '0')
+redis.call('DEL', KEYS[3])
+if n <= hwm - w then
+	return 1
+end
+if n <= hwm and redis.call('GETBIT', KEYS[2], n % w) == 1 then
+	return 1
+end
+if n > hwm then
+	if n - hwm >= w then
+		redis.call('DEL', KEYS[2])
+	else
+		for i = hwm + 1, n - 1 do
+			redis.call('SETBIT', KEYS[2], i % w, 0)
+		end
+	end
+	redis.call('SET', KEYS[1], n)
+end
+redis.call('SETBIT', KEYS[2], n % w, 1)
+return 1
+`)
+
+type RedisSequenceDeduplicatorOption func(*RedisSequenceDeduplicator)
+
+// WithRedisSequenceDeduplicatorWindowSize sets how many numbers below the high-water mark are tracked one by one,
+// older numbers are considered processed.
+func WithRedisSequenceDeduplicatorWindowSize(size uint64) RedisSequenceDeduplicatorOption {
+	return func(d *RedisSequenceDeduplicator) {
+		d.windowSize = size
+	}
+}
+
+// WithRedisSequenceDeduplicatorClaimTTL sets how long a claim lasts before being considered crashed.
+func WithRedisSequenceDeduplicatorClaimTTL(ttl time.Duration) RedisSequenceDeduplicatorOption {
+	return func(d *RedisSequenceDeduplicator) {
+		d.claimTTL = ttl
+	}
+}
+
+// RedisSequenceDeduplicator deduplicates sequenced messages through the high-water mark of every channel, the
+// highest number processed, plus a bitmap window of the numbers right below it. Deduplication is permanent and
+// takes a bounded amount of memory per channel, claims being the only keys expiring.
+type RedisSequenceDeduplicator struct {
+	client       *redis.Client
+	uuidProvider utils.UUIDProvider
+	windowSize   uint64
+	claimTTL     time.Duration
+}
+
+func NewRedisSequenceDeduplicator(
+	client *redis.Client,
+	opts ...RedisSequenceDeduplicatorOption,
+) (*RedisSequenceDeduplicator, error) {
+	deduplicator := &RedisSequenceDeduplicator{
+		client:       client,
+		uuidProvider: utils.NewRandomUUIDProvider(),
+		windowSize:   defaultSequenceWindowSize,
+		claimTTL:     defaultClaimTTLSeconds * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(deduplicator)
+	}
+
+	if err := validateSequenceWindowSize(deduplicator.windowSize); err != nil {
+		return nil, err
+	}
+
+	return deduplicator, nil
+}
+
+func (d *RedisSequenceDeduplicator) Claim(ctx context.Context, message Message) (Claim, error) {
+	sequenced, err := d.sequenced(message)
+	if err != nil {
+		return Claim{}, err
+	}
+
+	token := d.uuidProvider.New().String()
+	status, err := redisSequenceClaimScript.Run(
+		ctx,
+		d.client,
+		d.keys(sequenced),
+		sequenced.SequenceNumber(),
+		d.windowSize,
+		token,
+		d.claimTTL.Milliseconds(),
+	).Text()
+	if err != nil {
+		return Claim{}, NewDeduplicatorUnavailableError(message, err)
+	}
+
+	if ClaimStatus(status) != ClaimAcquired {
+		return Claim{Status: ClaimStatus(status), Token: ""}, nil
+	}
+
+	return Claim{Status: ClaimAcquired, Token: token}, nil
+}
+
+func (d *RedisSequenceDeduplicator) Commit(ctx context.Context, message Message, claim Claim) error {
+	sequenced, err := d.sequenced(message)
+	if err != nil {
+		return err
+	}
+
+	committed, err := redisSequenceCommitScript.Run(
+		ctx,
+		d.client,
+		d.keys(sequenced),
+		sequenced.SequenceNumber(),
+		d.windowSize,
+		claim.Token,
+	).Int()
+	if err != nil {
+		return NewDeduplicatorUnavailableError(message, err)
+	}
+
+	if committed == 0 {
+		return NewMessageClaimLostError(message)
+	}
+
+	return nil
+}
+
+func (d *RedisSequenceDeduplicator) Release(ctx context.Context, message Message, claim Claim) error {
+	sequenced, err := d.sequenced(message)
+	if err != nil {
+		return err
+	}
+
+	if err = redisReleaseScript.Run(ctx, d.client, d.keys(sequenced)[2:], claim.Token).Err(); err != nil {
+		return NewDeduplicatorUnavailableError(message, err)
+	}
+
+	return nil
+}
+
+// Gaps returns the numbers of the window below the high-water mark of the channel that were never processed.
+func (d *RedisSequenceDeduplicator) Gaps(ctx context.Context, channel string) ([]uint64, error) {
+	hwm, err := d.client.Get(ctx, redisSequencePrefix+channel).Uint64()
+	if errors.Is(err, redis.Nil) {
+		return []uint64{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read channel high-water mark: %w", err)
+	}
+
+	window, err := d.client.Get(ctx, redisSequencePrefix+channel+":window").Bytes()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("failed to read channel window: %w", err)
+	}
+
+	return SequenceWindowGaps(hwm, window, d.windowSize), nil
+}
+
+func (d *RedisSequenceDeduplicator) keys(message SequencedMessage) []string {
+	channelKey := redisSequencePrefix + message.Channel()
+
+	return []string{
+		channelKey,
+		channelKey + ":window",
+		channelKey + ":claim:" + strconv.FormatUint(message.SequenceNumber(), 10),
+	}
+}
+
+func (d *RedisSequenceDeduplicator) sequenced(message Message) (SequencedMessage, error) {
+	sequenced, match := message.(SequencedMessage)
+	if !match {
+		return nil, errutil.NewError(
+			"message is not sequenced",
+			errutil.WithMetadataKeyValue(messagingMessageSemConvKey, message.Identifier()),
+		)
+	}
+
+	return sequenced, nil
+}
+
+// validateSequenceWindowSize rejects empty windows, as every number takes the bit at its position modulo the size.
+func validateSequenceWindowSize(size uint64) error {
+	if size == 0 {
+		return errutil.NewError(
+			"invalid sequence window size provided, it must be positive",
+			errutil.WithMetadataKeyValue("messaging.deduplicator.sequence_window", size),
+		)
+	}
+
+	return nil
+}
+
+// SequenceWindowGaps returns the numbers of the window below the high-water mark whose bit is unset, the window
+// being a ring bitmap where every number takes the bit at its position modulo the window size, most significant
+// bit first.
+func SequenceWindowGaps(hwm uint64, window []byte, size uint64) []uint64 {
+	from := uint64(1)
+	if hwm > size {
+		from = hwm - size + 1
+	}
+
+	gaps := make([]uint64, 0)
+	for number := from; number < hwm; number++ {
+		offset := number % size
+		if offset/8 >= uint64(len(window)) || window[offset/8]&(0x80>>(offset%8)) == 0 {
+			gaps = append(gaps, number)
+		}
+	}
+
+	return gaps
+}
@@ -0,0 +1,33 @@
+package messaging_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+)
+
+func TestSequenceWindowGaps(t *testing.T) {
+	testCases := []struct {
+		name     string
+		hwm      uint64
+		window   []byte
+		size     uint64
+		expected []uint64
+	}{
+		{name: "empty channel has no gaps", hwm: 0, window: nil, size: 16, expected: []uint64{}},
+		{name: "contiguous numbers have no gaps", hwm: 3, window: []byte{0b01110000}, size: 16, expected: []uint64{}},
+		{name: "missing numbers are gaps", hwm: 5, window: []byte{0b01000100}, size: 16, expected: []uint64{2, 3, 4}},
+		{
+			name: "numbers wrap around the window", hwm: 10, window: []byte{0b00100000}, size: 8,
+			expected: []uint64{3, 4, 5, 6, 7, 8, 9},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, messaging.SequenceWindowGaps(tc.hwm, tc.window, tc.size))
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cronFieldsCount = 5
+	cronSearchYears = 5
+	cronSunday      = 7
+)
+
+type cronFieldBounds struct {
+	name     string
+	min, max int
+}
+
+// cronFields are the fields of a cron expression in order, Sunday being either 0 or 7 as day of week.
+var cronFields = [cronFieldsCount]cronFieldBounds{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: cronSunday},
+}
+
+// CronSchedule runs a job on the minutes matching a cron expression, evaluated in the location of the time it's
+// given. Every field takes `*`, values, ranges (`1-5`) and steps (`*/15`, `0-30/10`) separated by commas. As in
+// cron, a day matches either the day of month or the day of week when both are restricted.
+type CronSchedule struct {
+	spec       string
+	minutes    uint64
+	hours      uint64
+	days       uint64
+	months     uint64
+	weekdays   uint64
+	anyDay     bool
+	anyWeekday bool
+}
+
+func parseCronSchedule(spec, expression string) (*CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != cronFieldsCount {
+		return nil, NewInvalidScheduleError(spec, fmt.Sprintf("expected %d cron fields, got %d", cronFieldsCount, len(fields)))
+	}
+
+	var sets [cronFieldsCount]uint64
+	for i, field := range fields {
+		set, err := parseCronField(spec, field, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+
+		sets[i] = set
+	}
+
+	weekdays := sets[4]
+	if weekdays&(1<<cronSunday) != 0 {
+		weekdays = weekdays&^(1<<cronSunday) | 1
+	}
+
+	return &CronSchedule{
+		spec:       spec,
+		minutes:    sets[0],
+		hours:      sets[1],
+		days:       sets[2],
+		months:     sets[3],
+		weekdays:   weekdays,
+		anyDay:     strings.HasPrefix(fields[2], "*"),
+		anyWeekday: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField returns the set of values matched by a field, as a bit per value.
+func parseCronField(spec, field string, bounds cronFieldBounds) (uint64, error) {
+	var set uint64
+	for _, term := range strings.Split(field, ",") {
+		from, to, step, err := parseCronTerm(spec, term, bounds)
+		if err != nil {
+			return 0, err
+		}
+
+		for value := from; value <= to; value += step {
+			set |= 1 << value
+		}
+	}
+
+	return set, nil
+}
+
+func parseCronTerm(spec, term string, bounds cronFieldBounds) (int, int, int, error) {
+	values, stepSpec, hasStep := strings.Cut(term, "/")
+
+	step := 1
+	if hasStep {
+		parsed, err := strconv.Atoi(stepSpec)
+		if err != nil || parsed <= 0 {
+			return 0, 0, 0, NewInvalidScheduleError(spec, fmt.Sprintf("invalid %s step %q", bounds.name, stepSpec))
+		}
+
+		step = parsed
+	}
+
+	if values == "*" {
+		return bounds.min, bounds.max, step, nil
+	}
+
+	lower, upper, isRange := strings.Cut(values, "-")
+	from, err := parseCronValue(spec, lower, bounds)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	to := from
+	switch {
+	case isRange:
+		if to, err = parseCronValue(spec, upper, bounds); err != nil {
+			return 0, 0, 0, err
+		}
+	case hasStep:
+		to = bounds.max
+	}
+
+	if from > to {
+		return 0, 0, 0, NewInvalidScheduleError(spec, fmt.Sprintf("invalid %s range %q", bounds.name, values))
+	}
+
+	return from, to, step, nil
+}
+
+func parseCronValue(spec, value string, bounds cronFieldBounds) (int, error) {
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < bounds.min || parsed > bounds.max {
+		return 0, NewInvalidScheduleError(
+			spec,
+			fmt.Sprintf("%s %q out of range %d-%d", bounds.name, value, bounds.min, bounds.max),
+		)
+	}
+
+	return parsed, nil
+}
+
+// Next walks the calendar from the minute after the given time, skipping whole months, days and hours that don't
+// match, and gives up after some years for expressions no date matches (e.g. February 30th).
+func (s *CronSchedule) Next(after time.Time) time.Time {
+	next := after.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(cronSearchYears, 0, 0)
+
+	for next.Before(limit) {
+		switch {
+		case !cronMatches(s.months, int(next.Month())):
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+		case !s.matchesDay(next):
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+		case !cronMatches(s.hours, next.Hour()):
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, next.Location())
+		case !cronMatches(s.minutes, next.Minute()):
+			next = next.Add(time.Minute)
+		default:
+			return next
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *CronSchedule) String() string {
+	return s.spec
+}
+
+func (s *CronSchedule) matchesDay(at time.Time) bool {
+	day, weekday := cronMatches(s.days, at.Day()), cronMatches(s.weekdays, int(at.Weekday()))
+	if s.anyDay || s.anyWeekday {
+		return day && weekday
+	}
+
+	return day || weekday
+}
+
+func cronMatches(set uint64, value int) bool {
+	return set&(1<<value) != 0
+}
@@ -0,0 +1,36 @@
+package scheduler
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+type InMemoryJobStatusStore struct {
+	mutex    sync.Mutex
+	statuses map[string]JobStatus
+}
+
+func NewInMemoryJobStatusStore() *InMemoryJobStatusStore {
+	return &InMemoryJobStatusStore{
+		mutex:    sync.Mutex{},
+		statuses: make(map[string]JobStatus),
+	}
+}
+
+func (s *InMemoryJobStatusStore) Save(_ context.Context, status JobStatus) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.statuses[status.Name] = status
+
+	return nil
+}
+
+func (s *InMemoryJobStatusStore) All(_ context.Context) ([]JobStatus, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return slices.Collect(maps.Values(s.statuses)), nil
+}
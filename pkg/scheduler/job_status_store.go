@@ -0,0 +1,23 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// JobStatus is the outcome of the last run of a job, its error being empty when it succeeded.
+type JobStatus struct {
+	Name         string        `json:"name"`
+	LastRunAt    time.Time     `json:"last_run_at"`
+	LastDuration time.Duration `json:"last_duration"`
+	LastError    string        `json:"last_error"`
+}
+
+// JobStatusStore keeps the status of the last run of every job, so any instance can tell how the jobs run by the
+// leader went.
+type JobStatusStore interface {
+	// Save stores the status of the last run of the job, replacing the previous one.
+	Save(ctx context.Context, status JobStatus) error
+	// All returns the status of every job that ran at least once.
+	All(ctx context.Context) ([]JobStatus, error)
+}
@@ -0,0 +1,49 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisJobStatusKey = "scheduler:jobs"
+
+// RedisJobStatusStore keeps the status of every job as a field of a single hash.
+type RedisJobStatusStore struct {
+	client *redis.Client
+}
+
+func NewRedisJobStatusStore(client *redis.Client) *RedisJobStatusStore {
+	return &RedisJobStatusStore{client: client}
+}
+
+func (s *RedisJobStatusStore) Save(ctx context.Context, status JobStatus) error {
+	value, _ := json.Marshal(status)
+
+	if err := s.client.HSet(ctx, redisJobStatusKey, status.Name, value).Err(); err != nil {
+		return fmt.Errorf("failed to save job status: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RedisJobStatusStore) All(ctx context.Context) ([]JobStatus, error) {
+	values, err := s.client.HGetAll(ctx, redisJobStatusKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find job statuses: %w", err)
+	}
+
+	statuses := make([]JobStatus, 0, len(values))
+	for _, value := range values {
+		var status JobStatus
+		if err = json.Unmarshal([]byte(value), &status); err != nil {
+			return nil, fmt.Errorf("failed to decode job status: %w", err)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"strings"
+	"time"
+)
+
+const intervalSpecPrefix = "@every "
+
+// cronDescriptors are the shorthands accepted in place of a cron expression.
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule tells when a job runs.
+type Schedule interface {
+	// Next returns the first time the job runs after the given one, the zero time when it never runs again.
+	Next(after time.Time) time.Time
+	// String returns the spec the schedule was parsed from.
+	String() string
+}
+
+// ParseSchedule parses either an interval (`@every 10m`), a cron descriptor (`@hourly`, `@daily`...) or a cron
+// expression of five fields (minute, hour, day of month, month and day of week).
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if every, isInterval := strings.CutPrefix(spec, intervalSpecPrefix); isInterval {
+		return parseIntervalSchedule(spec, strings.TrimSpace(every))
+	}
+
+	if expression, isDescriptor := cronDescriptors[spec]; isDescriptor {
+		return parseCronSchedule(spec, expression)
+	}
+
+	return parseCronSchedule(spec, spec)
+}
+
+// IntervalSchedule runs a job every fixed interval, counted from the end of its previous run.
+type IntervalSchedule struct {
+	spec  string
+	every time.Duration
+}
+
+func parseIntervalSchedule(spec, every string) (*IntervalSchedule, error) {
+	interval, err := time.ParseDuration(every)
+	if err != nil {
+		return nil, NewInvalidScheduleError(spec, "malformed interval")
+	}
+
+	if interval <= 0 {
+		return nil, NewInvalidScheduleError(spec, "interval must be positive")
+	}
+
+	return &IntervalSchedule{spec: spec, every: interval}, nil
+}
+
+func (s *IntervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(s.every)
+}
+
+func (s *IntervalSchedule) String() string {
+	return s.spec
+}
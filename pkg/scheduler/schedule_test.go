@@ -0,0 +1,68 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/scheduler"
+)
+
+func TestParseSchedule_Next(t *testing.T) {
+	// 2025-01-01 is a Wednesday.
+	after := time.Date(2025, 1, 1, 10, 7, 30, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		spec     string
+		expected time.Time
+	}{
+		{name: "interval", spec: "@every 90s", expected: after.Add(90 * time.Second)},
+		{name: "every minute", spec: "* * * * *", expected: time.Date(2025, 1, 1, 10, 8, 0, 0, time.UTC)},
+		{name: "minute steps", spec: "*/15 * * * *", expected: time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC)},
+		{name: "hour ranges", spec: "30 2-4 * * *", expected: time.Date(2025, 1, 2, 2, 30, 0, 0, time.UTC)},
+		{name: "lists", spec: "5,45 10 * * *", expected: time.Date(2025, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{name: "day of week", spec: "0 9 * * 1", expected: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)},
+		{name: "sunday as seven", spec: "0 0 * * 7", expected: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month or day of week", spec: "0 0 15 * 5", expected: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "month", spec: "0 0 1 3 *", expected: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", spec: "0 0 29 2 *", expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "descriptor", spec: "@daily", expected: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "never", spec: "0 0 30 2 *", expected: time.Time{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := scheduler.ParseSchedule(tc.spec)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, schedule.Next(after))
+			assert.Equal(t, tc.spec, schedule.String())
+		})
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec string
+	}{
+		{name: "malformed interval", spec: "@every soon"},
+		{name: "negative interval", spec: "@every -1m"},
+		{name: "missing fields", spec: "* * * *"},
+		{name: "value out of range", spec: "60 * * * *"},
+		{name: "reversed range", spec: "* 5-2 * * *"},
+		{name: "zero step", spec: "*/0 * * * *"},
+		{name: "unknown descriptor", spec: "@fortnightly"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := scheduler.ParseSchedule(tc.spec)
+
+			assert.True(t, scheduler.IsInvalidScheduleError(err))
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/logger"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+// JobFunc runs a job, its context being cancelled once the scheduler stops.
+type JobFunc func(ctx context.Context) error
+
+type job struct {
+	name     string
+	schedule Schedule
+	run      JobFunc
+}
+
+// ScheduledJob is a registered job along with the status of its last run, nil while it never ran.
+type ScheduledJob struct {
+	Name     string
+	Schedule string
+	LastRun  *JobStatus
+}
+
+// Scheduler runs the registered jobs on their schedule while it runs, recording the status of every run in a
+// store. It's meant to run only on the leader so every job runs once across instances.
+type Scheduler struct {
+	mutex        sync.Mutex
+	jobs         []job
+	store        JobStatusStore
+	timeProvider utils.DateTimeProvider
+	logger       logger.ZerologLogger
+}
+
+func NewScheduler(store JobStatusStore, timeProvider utils.DateTimeProvider, logger logger.ZerologLogger) *Scheduler {
+	return &Scheduler{
+		mutex:        sync.Mutex{},
+		jobs:         make([]job, 0),
+		store:        store,
+		timeProvider: timeProvider,
+		logger:       logger,
+	}
+}
+
+// Register adds a job run on the given schedule spec, see ParseSchedule. Jobs registered while the scheduler runs
+// are only picked up the next time it's run.
+func (s *Scheduler) Register(name, spec string, run JobFunc) error {
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if slices.ContainsFunc(s.jobs, func(j job) bool { return j.name == name }) {
+		return NewJobAlreadyRegisteredError(name)
+	}
+
+	s.jobs = append(s.jobs, job{name: name, schedule: schedule, run: run})
+
+	return nil
+}
+
+// Run runs the registered jobs on their schedule until the context is done, then waits for the running ones to
+// return. A job never overlaps itself, a run lasting longer than its schedule delaying the next one.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mutex.Lock()
+	jobs := slices.Clone(s.jobs)
+	s.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	for _, j := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.schedule(ctx, j)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// Jobs returns every registered job sorted by name, along with the status of its last run.
+func (s *Scheduler) Jobs(ctx context.Context) ([]ScheduledJob, error) {
+	statuses, err := s.store.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	jobs := make([]ScheduledJob, len(s.jobs))
+	for i, j := range s.jobs {
+		jobs[i] = ScheduledJob{Name: j.name, Schedule: j.schedule.String(), LastRun: nil}
+
+		if at := slices.IndexFunc(statuses, func(status JobStatus) bool { return status.Name == j.name }); at >= 0 {
+			jobs[i].LastRun = &statuses[at]
+		}
+	}
+
+	slices.SortFunc(jobs, func(a, b ScheduledJob) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return jobs, nil
+}
+
+// schedule runs the job every time its schedule is due until the context is done. The next run is never computed
+// from a time before the previous one, so a timer firing early can't run the job twice.
+func (s *Scheduler) schedule(ctx context.Context, j job) {
+	var previous time.Time
+	for {
+		from := s.timeProvider.Now()
+		if from.Before(previous) {
+			from = previous
+		}
+
+		next := j.schedule.Next(from)
+		if next.IsZero() {
+			return
+		}
+
+		timer := time.NewTimer(next.Sub(s.timeProvider.Now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.run(ctx, j)
+		previous = next
+	}
+}
+
+// run runs the job once and records how it went, a job failing to record its status doesn't stop it from running.
+func (s *Scheduler) run(ctx context.Context, j job) {
+	startedAt := s.timeProvider.Now()
+	err := s.call(ctx, j)
+	status := JobStatus{
+		Name:         j.name,
+		LastRunAt:    startedAt,
+		LastDuration: s.timeProvider.Now().Sub(startedAt),
+		LastError:    "",
+	}
+
+	if err != nil {
+		status.LastError = err.Error()
+		s.logger.Error().Ctx(ctx).Err(err).Str(jobNameSemConvKey, j.name).Msg("scheduled job failed")
+	}
+
+	if saveErr := s.store.Save(context.WithoutCancel(ctx), status); saveErr != nil {
+		s.logger.Warn().Ctx(ctx).Err(saveErr).Str(jobNameSemConvKey, j.name).Msg("error saving scheduled job status")
+	}
+}
+
+// call runs the job, turning a panic into an error so a single job can't take the scheduler down.
+func (s *Scheduler) call(ctx context.Context, j job) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+
+	return j.run(ctx)
+}
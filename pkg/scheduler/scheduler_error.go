@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"errors"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/errutil"
+)
+
+const (
+	scheduleSpecSemConvKey = "scheduler.schedule.spec"
+	jobNameSemConvKey      = "scheduler.job.name"
+)
+
+// InvalidScheduleError means a schedule spec is neither an interval nor a valid cron expression.
+type InvalidScheduleError struct {
+	*errutil.BaseError
+}
+
+func NewInvalidScheduleError(spec, reason string) *InvalidScheduleError {
+	return &InvalidScheduleError{
+		BaseError: errutil.NewError(
+			"invalid schedule: "+reason,
+			errutil.WithMetadataKeyValue(scheduleSpecSemConvKey, spec),
+		),
+	}
+}
+
+func IsInvalidScheduleError(err error) bool {
+	var self *InvalidScheduleError
+	return errors.As(err, &self)
+}
+
+// JobAlreadyRegisteredError means a job with the same name was already registered on the scheduler.
+type JobAlreadyRegisteredError struct {
+	*errutil.BaseError
+}
+
+func NewJobAlreadyRegisteredError(name string) *JobAlreadyRegisteredError {
+	return &JobAlreadyRegisteredError{
+		BaseError: errutil.NewError(
+			"job already registered",
+			errutil.WithMetadataKeyValue(jobNameSemConvKey, name),
+		),
+	}
+}
+
+func IsJobAlreadyRegisteredError(err error) bool {
+	var self *JobAlreadyRegisteredError
+	return errors.As(err, &self)
+}
@@ -0,0 +1,80 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/soulcodex/rockets-message-processor/pkg/scheduler"
+	"github.com/soulcodex/rockets-message-processor/pkg/utils"
+)
+
+func TestScheduler_RunsJobsAndRecordsTheirStatus(t *testing.T) {
+	jobScheduler := scheduler.NewScheduler(
+		scheduler.NewInMemoryJobStatusStore(),
+		utils.NewSystemTimeProvider(),
+		zerolog.New(io.Discard),
+	)
+
+	var compactions atomic.Int32
+	require.NoError(t, jobScheduler.Register("compaction", "@every 10ms", func(context.Context) error {
+		compactions.Add(1)
+		return nil
+	}))
+	require.NoError(t, jobScheduler.Register("purge", "@every 10ms", func(context.Context) error {
+		return errors.New("purge failed")
+	}))
+	require.NoError(t, jobScheduler.Register("panicking", "@every 10ms", func(context.Context) error {
+		panic("boom")
+	}))
+	require.NoError(t, jobScheduler.Register("yearly", "@yearly", func(context.Context) error {
+		return nil
+	}))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+	jobScheduler.Run(ctx)
+
+	assert.Greater(t, compactions.Load(), int32(1), "Expected jobs to run every interval")
+
+	jobs, err := jobScheduler.Jobs(t.Context())
+	require.NoError(t, err)
+	require.Len(t, jobs, 4)
+
+	assert.Equal(t, "compaction", jobs[0].Name)
+	assert.Equal(t, "@every 10ms", jobs[0].Schedule)
+	require.NotNil(t, jobs[0].LastRun)
+	assert.Empty(t, jobs[0].LastRun.LastError)
+
+	assert.Equal(t, "panicking", jobs[1].Name)
+	require.NotNil(t, jobs[1].LastRun)
+	assert.Equal(t, "job panicked: boom", jobs[1].LastRun.LastError)
+
+	assert.Equal(t, "purge", jobs[2].Name)
+	require.NotNil(t, jobs[2].LastRun)
+	assert.Equal(t, "purge failed", jobs[2].LastRun.LastError)
+
+	assert.Equal(t, "yearly", jobs[3].Name)
+	assert.Nil(t, jobs[3].LastRun, "Expected jobs that never ran to have no status")
+}
+
+func TestScheduler_Register(t *testing.T) {
+	jobScheduler := scheduler.NewScheduler(
+		scheduler.NewInMemoryJobStatusStore(),
+		utils.NewSystemTimeProvider(),
+		zerolog.New(io.Discard),
+	)
+	noop := func(context.Context) error { return nil }
+
+	require.NoError(t, jobScheduler.Register("compaction", "@hourly", noop))
+
+	assert.True(t, scheduler.IsJobAlreadyRegisteredError(jobScheduler.Register("compaction", "@daily", noop)))
+	assert.True(t, scheduler.IsInvalidScheduleError(jobScheduler.Register("purge", "every hour", noop)))
+}
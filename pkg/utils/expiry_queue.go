@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"container/heap"
+	"time"
+)
+
+type expiryItem struct {
+	key       string
+	expiresAt time.Time
+}
+
+type expiryHeap []expiryItem
+
+func (h expiryHeap) Len() int           { return len(h) }
+func (h expiryHeap) Less(i, j int) bool { return h[i].expiresAt.Before(h[j].expiresAt) }
+func (h expiryHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *expiryHeap) Push(x any) {
+	item, _ := x.(expiryItem)
+	*h = append(*h, item)
+}
+
+func (h *expiryHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return item
+}
+
+// ExpiryQueue orders keys by the time they expire at, so the expired entries of a map can be swept without scanning
+// it. A key pushed again keeps its former expiry queued as well, callers telling whether it's still expired on sweep.
+// It isn't safe for concurrent use, being meant to be guarded by the lock of the map it sweeps.
+type ExpiryQueue struct {
+	items expiryHeap
+}
+
+// NewExpiryQueue constructs an empty ExpiryQueue.
+func NewExpiryQueue() *ExpiryQueue {
+	return &ExpiryQueue{items: make(expiryHeap, 0)}
+}
+
+// Push queues the key to expire at the given time.
+func (q *ExpiryQueue) Push(key string, expiresAt time.Time) {
+	heap.Push(&q.items, expiryItem{key: key, expiresAt: expiresAt})
+}
+
+// Expire dequeues the keys queued to expire by now, handing every one of them to the given func.
+func (q *ExpiryQueue) Expire(now time.Time, expire func(key string)) {
+	for len(q.items) > 0 && !now.Before(q.items[0].expiresAt) {
+		item, _ := heap.Pop(&q.items).(expiryItem)
+		expire(item.key)
+	}
+}
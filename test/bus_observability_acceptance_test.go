@@ -0,0 +1,139 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type BusObservabilityAcceptanceTestSuite struct {
+	suite.Suite
+
+	common         *di.CommonServices
+	spans          *tracetest.InMemoryExporter
+	metrics        *sdkmetric.ManualReader
+	tracerProvider *sdktrace.TracerProvider
+	meterProvider  *sdkmetric.MeterProvider
+}
+
+func TestBusObservability(t *testing.T) {
+	suite.Run(t, new(BusObservabilityAcceptanceTestSuite))
+}
+
+func (suite *BusObservabilityAcceptanceTestSuite) SetupSuite() {
+	suite.spans = tracetest.NewInMemoryExporter()
+	suite.metrics = sdkmetric.NewManualReader()
+	suite.tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(suite.spans))
+	suite.meterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(suite.metrics))
+	otel.SetTracerProvider(suite.tracerProvider)
+	otel.SetMeterProvider(suite.meterProvider)
+
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	_ = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *BusObservabilityAcceptanceTestSuite) TearDownSuite() {
+	suite.Require().NoError(suite.tracerProvider.Shutdown(suite.T().Context()))
+	suite.Require().NoError(suite.meterProvider.Shutdown(suite.T().Context()))
+}
+
+func (suite *BusObservabilityAcceptanceTestSuite) SetupTest() {
+	suite.spans.Reset()
+}
+
+func (suite *BusObservabilityAcceptanceTestSuite) TestBusObservability_RecordsHandledQueries() {
+	tests := []struct {
+		name       string
+		path       string
+		inputType  string
+		statusCode int
+		failed     bool
+	}{
+		{
+			name:       "handled query",
+			path:       "/rockets/stats",
+			inputType:  "rocket_stats_query",
+			statusCode: http.StatusOK,
+			failed:     false,
+		},
+		{
+			name:       "failed query",
+			path:       "/rockets/" + suite.common.UUIDProvider.New().String(),
+			inputType:  "find_rocket_by_id_query",
+			statusCode: http.StatusNotFound,
+			failed:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, tt.path, nil)
+			suite.Require().Equal(tt.statusCode, response.Code)
+
+			suite.requireSpan(tt.inputType, tt.failed)
+			suite.requireDurationRecorded(tt.inputType, tt.failed)
+		})
+	}
+}
+
+func (suite *BusObservabilityAcceptanceTestSuite) requireSpan(inputType string, failed bool) {
+	for _, span := range suite.spans.GetSpans() {
+		if span.Name != "bus.handle "+inputType {
+			continue
+		}
+
+		suite.Contains(span.Attributes, attribute.String("bus.operation.input_type", inputType))
+		if failed {
+			suite.Equal(codes.Error, span.Status.Code, "Expected the span of a failed query to be an error")
+		} else {
+			suite.Equal(codes.Unset, span.Status.Code, "Expected the span of a handled query not to be an error")
+		}
+
+		return
+	}
+
+	suite.Failf("span not found", "Expected a span for %s", inputType)
+}
+
+func (suite *BusObservabilityAcceptanceTestSuite) requireDurationRecorded(inputType string, failed bool) {
+	var collected metricdata.ResourceMetrics
+	suite.Require().NoError(suite.metrics.Collect(suite.T().Context(), &collected))
+
+	want := attribute.NewSet(
+		attribute.String("bus.operation.input_type", inputType),
+		attribute.Bool("bus.operation.failed", failed),
+	)
+	for _, scope := range collected.ScopeMetrics {
+		for _, collectedMetric := range scope.Metrics {
+			histogram, isHistogram := collectedMetric.Data.(metricdata.Histogram[float64])
+			if collectedMetric.Name != "bus.handling.duration" || !isHistogram {
+				continue
+			}
+
+			for _, point := range histogram.DataPoints {
+				if point.Attributes.Equals(&want) {
+					suite.Positive(point.Count, "Expected the duration of %s to be recorded", inputType)
+					return
+				}
+			}
+		}
+	}
+
+	suite.Failf("duration not recorded", "Expected a duration recorded for %s", inputType)
+}
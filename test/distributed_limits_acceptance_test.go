@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+)
+
+const distributedLimitsTestExpiry = 300 * time.Millisecond
+
+type DistributedLimitsAcceptanceTestSuite struct {
+	suite.Suite
+
+	common    *di.CommonServices
+	semaphore *distributedsync.RedisSemaphoreService
+	limiter   *distributedsync.RedisRateLimiter
+}
+
+func TestDistributedLimits(t *testing.T) {
+	suite.Run(t, new(DistributedLimitsAcceptanceTestSuite))
+}
+
+func (suite *DistributedLimitsAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	if suite.common.RedisClient == nil {
+		suite.T().Skip("redis semaphores and rate limiters need redis, the service runs standalone")
+	}
+
+	suite.semaphore = distributedsync.NewRedisSemaphoreService(
+		suite.common.RedisClient,
+		suite.common.Logger,
+		distributedsync.WithLockDefaults(distributedsync.WithLockExpiry(distributedLimitsTestExpiry)),
+	)
+	suite.limiter = distributedsync.NewRedisRateLimiter(suite.common.RedisClient, suite.common.Logger)
+}
+
+func (suite *DistributedLimitsAcceptanceTestSuite) SetupTest() {
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *DistributedLimitsAcceptanceTestSuite) TestSemaphore_LimitsPermits() {
+	acquire := func(ctx context.Context) (interface{}, error) {
+		return suite.semaphore.TrySemaphore(ctx, "webhooks", 2, func(context.Context) (interface{}, error) {
+			return "acquired", nil
+		})
+	}
+
+	_, err := suite.semaphore.Semaphore(suite.T().Context(), "webhooks", 2, func(ctx context.Context) (interface{}, error) {
+		return suite.semaphore.Semaphore(ctx, "webhooks", 2, func(ctx context.Context) (interface{}, error) {
+			return acquire(ctx)
+		})
+	})
+	_, exhausted := distributedsync.AsSemaphoreExhaustedError(err)
+	suite.True(exhausted, "Expected no third permit to be handed out")
+
+	result, err := acquire(suite.T().Context())
+	suite.Require().NoError(err, "Expected the permits to be released")
+	suite.Equal("acquired", result)
+}
+
+func (suite *DistributedLimitsAcceptanceTestSuite) TestSemaphore_FreesPermitsOfCrashedHolders() {
+	suite.common.RedisClient.ZAdd(suite.T().Context(), "distributed-sync-semaphore:webhooks", redis.Z{Score: 1, Member: "crashed"})
+
+	result, err := suite.semaphore.TrySemaphore(suite.T().Context(), "webhooks", 1, func(context.Context) (interface{}, error) {
+		return "acquired", nil
+	})
+
+	suite.Require().NoError(err, "Expected expired permits to be freed")
+	suite.Equal("acquired", result)
+}
+
+func (suite *DistributedLimitsAcceptanceTestSuite) TestSemaphore_LeaseIsExtendedUntilLost() {
+	errLateWrite := errors.New("write rejected for a stale fencing token")
+	result, err := suite.semaphore.Semaphore(suite.T().Context(), "webhooks", 1, func(ctx context.Context) (interface{}, error) {
+		time.Sleep(2 * distributedLimitsTestExpiry)
+		suite.Require().NoError(ctx.Err(), "Expected the lease to be extended while the callback runs")
+
+		suite.common.RedisClient.Del(ctx, "distributed-sync-semaphore:webhooks")
+		select {
+		case <-ctx.Done():
+			suite.True(distributedsync.IsMutexLeaseLostError(context.Cause(ctx)))
+		case <-time.After(3 * distributedLimitsTestExpiry):
+			suite.Fail("Expected the callback context to be cancelled once the lease was lost")
+		}
+
+		return "written", errLateWrite
+	})
+
+	suite.True(distributedsync.IsMutexLeaseLostError(err))
+	suite.ErrorIs(err, errLateWrite, "Expected the callback error to be kept along with the lost lease")
+	suite.Equal("written", result, "Expected the callback result to be kept along with the lost lease")
+}
+
+func (suite *DistributedLimitsAcceptanceTestSuite) TestRateLimiter_Allow() {
+	limit := distributedsync.PerSecond(3)
+	for range 3 {
+		suite.Require().NoError(suite.limiter.Allow(suite.T().Context(), "channel", limit))
+	}
+
+	err := suite.limiter.Allow(suite.T().Context(), "channel", limit)
+	exceeded, match := distributedsync.AsRateLimitExceededError(err)
+	suite.Require().True(match, "Expected requests over the burst to be rejected")
+	suite.Positive(exceeded.RetryAfter)
+	suite.LessOrEqual(exceeded.RetryAfter, time.Second/3)
+
+	suite.NoError(suite.limiter.Allow(suite.T().Context(), "other-channel", limit), "Expected keys to be limited apart")
+
+	time.Sleep(exceeded.RetryAfter)
+	suite.NoError(suite.limiter.Allow(suite.T().Context(), "channel", limit), "Expected requests to fit again after waiting")
+}
@@ -0,0 +1,204 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	"github.com/soulcodex/rockets-message-processor/pkg/bus"
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+const distributedMutexTestExpiry = 300 * time.Millisecond
+
+type DistributedMutexAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+	mutex        *distributedsync.RedisMutexService
+}
+
+func TestDistributedMutex(t *testing.T) {
+	suite.Run(t, new(DistributedMutexAcceptanceTestSuite))
+}
+
+func (suite *DistributedMutexAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+
+	if suite.common.RedisClient != nil {
+		suite.mutex = distributedsync.NewRedisMutexService(
+			suite.common.RedisClient,
+			suite.common.Logger,
+			distributedsync.WithLockDefaults(distributedsync.WithLockExpiry(distributedMutexTestExpiry)),
+		)
+	}
+}
+
+func (suite *DistributedMutexAcceptanceTestSuite) TestLeaseIsExtendedWhileCallbackRuns() {
+	suite.requireRedis()
+	var wg sync.WaitGroup
+	order := make(chan string, 2)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := suite.mutex.Mutex(suite.T().Context(), "rocket:slow", func(ctx context.Context) (interface{}, error) {
+			time.Sleep(3 * distributedMutexTestExpiry)
+			order <- "slow"
+			return nil, ctx.Err()
+		})
+		suite.NoError(err)
+	}()
+
+	time.Sleep(distributedMutexTestExpiry / 3)
+	_, err := suite.mutex.Mutex(suite.T().Context(), "rocket:slow", func(context.Context) (interface{}, error) {
+		order <- "next"
+		return nil, nil
+	})
+	suite.Require().NoError(err)
+	wg.Wait()
+
+	suite.Equal("slow", <-order, "Expected the slow holder to keep the mutex until it finished")
+	suite.Equal("next", <-order)
+}
+
+func (suite *DistributedMutexAcceptanceTestSuite) TestLostLeaseCancelsCallback() {
+	suite.requireRedis()
+	errLateWrite := errors.New("write rejected for a stale fencing token")
+	result, err := suite.mutex.Mutex(suite.T().Context(), "rocket:lost", func(ctx context.Context) (interface{}, error) {
+		suite.common.RedisClient.Del(ctx, "distributed-sync-mutex:rocket:lost")
+
+		select {
+		case <-ctx.Done():
+			suite.True(distributedsync.IsMutexLeaseLostError(context.Cause(ctx)))
+		case <-time.After(3 * distributedMutexTestExpiry):
+			suite.Fail("Expected the callback context to be cancelled once the lease was lost")
+		}
+
+		return "written", errLateWrite
+	})
+
+	suite.Require().Error(err)
+	suite.True(distributedsync.IsMutexLeaseLostError(err))
+	suite.ErrorIs(err, errLateWrite, "Expected the callback error to be kept along with the lost lease")
+	suite.Equal("written", result, "Expected the callback result to be kept along with the lost lease")
+}
+
+func (suite *DistributedMutexAcceptanceTestSuite) TestFencingTokensIncreasePerKey() {
+	suite.requireRedis()
+	tokens := make([]uint64, 0, 3)
+	for range 3 {
+		_, err := suite.mutex.Mutex(suite.T().Context(), "rocket:fenced", func(ctx context.Context) (interface{}, error) {
+			token, fenced := distributedsync.FencingTokenFromContext(ctx)
+			suite.Require().True(fenced)
+			tokens = append(tokens, token.Value)
+			return nil, nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	suite.Equal([]uint64{1, 2, 3}, tokens)
+}
+
+func (suite *DistributedMutexAcceptanceTestSuite) TestContendedMutex_FailsWithinBudget() {
+	suite.requireRedis()
+
+	testCases := []struct {
+		name string
+		lock func(fn distributedsync.MutexCallback) (interface{}, error)
+	}{
+		{
+			name: "try fails right away",
+			lock: func(fn distributedsync.MutexCallback) (interface{}, error) {
+				return suite.mutex.TryMutex(suite.T().Context(), "rocket:contended", fn)
+			},
+		},
+		{
+			name: "wait fails once the budget is spent",
+			lock: func(fn distributedsync.MutexCallback) (interface{}, error) {
+				return suite.mutex.Mutex(
+					suite.T().Context(),
+					"rocket:contended",
+					fn,
+					distributedsync.WithLockWaitBudget(100*time.Millisecond),
+					distributedsync.WithLockRetryDelay(20*time.Millisecond),
+				)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := suite.mutex.Mutex(suite.T().Context(), "rocket:contended", func(context.Context) (interface{}, error) {
+			startedAt := time.Now()
+			_, lockErr := tc.lock(func(context.Context) (interface{}, error) {
+				return "reentered", nil
+			})
+
+			suite.Less(time.Since(startedAt), time.Second, tc.name)
+			return nil, lockErr
+		})
+
+		_, contended := distributedsync.AsMutexContendedError(err)
+		suite.True(contended, tc.name)
+	}
+}
+
+func (suite *DistributedMutexAcceptanceTestSuite) TestContendedRocket_AnsweredAsLocked() {
+	handler := rocketentrypoint.HandleReceiveRocketMessageV1HTTP(
+		suite.common.EventBus,
+		suite.common.Mutex,
+		suite.common.Deduplicator,
+		suite.rocketModule.Messages,
+		suite.common.TimeProvider,
+		suite.common.Logger,
+		httpserver.NewJSONResponseMiddleware(suite.common.Logger),
+		bus.WithTryLock(),
+		bus.WithMutexOptions(distributedsync.WithLockRetryDelay(1500*time.Millisecond)),
+	)
+
+	rocketID := suite.common.UUIDProvider.New().String()
+	body := []byte(fmt.Sprintf(
+		`{"metadata": {"channel": "%s","messageNumber": 1,"messageTime": "%s","messageType": "RocketLaunched"},`+
+			`"message": {"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}}`,
+		rocketID, time.Now().Add(-time.Hour).Format(time.RFC3339),
+	))
+
+	_, err := suite.common.Mutex.Mutex(suite.T().Context(), "rocket:"+rocketID, func(context.Context) (interface{}, error) {
+		request := httptest.NewRequestWithContext(suite.T().Context(), http.MethodPost, "/messages", bytes.NewBuffer(body))
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		suite.Equal(http.StatusLocked, response.Code, "Expected messages of a locked rocket to be rejected")
+		suite.Equal("2", response.Header().Get("Retry-After"))
+		return nil, nil
+	})
+	suite.Require().NoError(err)
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", body)
+	suite.Equal(http.StatusOK, response.Code, "Expected the rejected message to be processed once retried")
+}
+
+func (suite *DistributedMutexAcceptanceTestSuite) requireRedis() {
+	if suite.mutex == nil {
+		suite.T().Skip("the redis mutex needs redis, the service runs standalone")
+	}
+}
@@ -34,7 +34,7 @@ func (suite *FindRocketByIDAcceptanceTestSuite) SetupSuite() {
 		".test.env",
 	)
 	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
-	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.common.FlushAll(suite.T().Context())
 	suite.rocketID = rocketdomain.RocketID(suite.common.UUIDProvider.New().String())
 
 	rocket := rockettest.NewRocketMother(rockettest.WithRocketID(suite.rocketID.String())).Build(suite.T())
@@ -0,0 +1,254 @@
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+)
+
+type IdempotencyKeyAcceptanceTestSuite struct {
+	suite.Suite
+
+	common        *di.CommonServices
+	rocketModule  *di.RocketModule
+	missionModule *di.MissionModule
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	suite.Run(t, new(IdempotencyKeyAcceptanceTestSuite))
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.missionModule = di.NewMissionModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) TestIdempotencyKey_ReplaysFirstResponse() {
+	key := suite.common.UUIDProvider.New().String()
+	body := suite.rocketLaunchedEventBody(suite.common.UUIDProvider.New().String())
+
+	first := suite.executeWithKey(http.MethodPost, "/messages", key, body)
+	suite.Require().Equal(http.StatusOK, first.Code, "Expected status code 200 OK")
+	suite.Empty(first.Header().Get(httpserver.HeaderIdempotencyReplayed))
+
+	repeat := suite.executeWithKey(http.MethodPost, "/messages", key, body)
+	suite.Equal(http.StatusOK, repeat.Code, "Expected the first status code to be replayed")
+	suite.Equal("true", repeat.Header().Get(httpserver.HeaderIdempotencyReplayed))
+	suite.Equal(first.Header().Get("Content-Type"), repeat.Header().Get("Content-Type"))
+	suite.Equal(first.Body.String(), repeat.Body.String(), "Expected the first body to be replayed verbatim")
+	suite.Contains(repeat.Body.String(), `"outcome":"applied"`)
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) TestIdempotencyKey_ReplaysClientErrors() {
+	key := suite.common.UUIDProvider.New().String()
+	body := []byte(`{"code": "VOSKHOD", "name": "Voskhod", "target": ""}`)
+
+	first := suite.executeWithKey(http.MethodPost, "/missions", key, body)
+	suite.Require().Equal(http.StatusBadRequest, first.Code, "Expected status code 400 Bad Request")
+
+	repeat := suite.executeWithKey(http.MethodPost, "/missions", key, body)
+	suite.Equal(http.StatusBadRequest, repeat.Code)
+	suite.Equal("true", repeat.Header().Get(httpserver.HeaderIdempotencyReplayed))
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) TestIdempotencyKey_FailDifferentRequest() {
+	key := suite.common.UUIDProvider.New().String()
+
+	first := suite.executeWithKey(http.MethodPost, "/missions", key, []byte(`{"code": "MIR", "name": "Mir", "target": "Low Earth Orbit"}`))
+	suite.Require().Equal(http.StatusCreated, first.Code, "Expected status code 201 Created")
+
+	other := []byte(`{"code": "SALYUT", "name": "Salyut", "target": "Low Earth Orbit"}`)
+	repeat := suite.executeWithKey(http.MethodPost, "/missions", key, other)
+	suite.Equal(http.StatusUnprocessableEntity, repeat.Code, "Expected status code 422 Unprocessable Entity")
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) TestIdempotencyKey_ReplaysTerminalConflicts() {
+	body := []byte(`{"code": "SKYLAB", "name": "Skylab", "target": "Low Earth Orbit"}`)
+	existing := suite.executeWithKey(http.MethodPost, "/missions", suite.common.UUIDProvider.New().String(), body)
+	suite.Require().Equal(http.StatusCreated, existing.Code, "Expected status code 201 Created")
+
+	key := suite.common.UUIDProvider.New().String()
+	first := suite.executeWithKey(http.MethodPost, "/missions", key, body)
+	suite.Require().Equal(http.StatusConflict, first.Code, "Expected status code 409 Conflict")
+
+	repeat := suite.executeWithKey(http.MethodPost, "/missions", key, body)
+	suite.Equal(http.StatusConflict, repeat.Code)
+	suite.Equal("true", repeat.Header().Get(httpserver.HeaderIdempotencyReplayed), "Expected terminal conflicts to be replayed")
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) TestIdempotencyKey_ReplaysExplodedRocketReceipts() {
+	rocketID, launchedAt := suite.common.UUIDProvider.New().String(), time.Now().Add(-time.Hour)
+	for number, message := range []string{
+		suite.rocketEventBody(rocketID, 1, "RocketLaunched", `{"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}`, launchedAt),
+		suite.rocketEventBody(rocketID, 2, "RocketExploded", `{"reason": "PRESSURE_VESSEL_FAILURE"}`, launchedAt.Add(time.Minute)),
+	} {
+		response := suite.executeWithKey(http.MethodPost, "/messages", suite.common.UUIDProvider.New().String(), []byte(message))
+		suite.Require().Equal(http.StatusOK, response.Code, "Expected message %d to be applied", number+1)
+	}
+
+	key := suite.common.UUIDProvider.New().String()
+	late := []byte(suite.rocketEventBody(rocketID, 3, "RocketSpeedIncreased", `{"by": 100}`, launchedAt.Add(2*time.Minute)))
+	first := suite.executeWithKey(http.MethodPost, "/messages", key, late)
+	suite.Require().Equal(http.StatusConflict, first.Code, "Expected status code 409 Conflict")
+	suite.Require().Contains(first.Body.String(), `"outcome":"rocket_exploded"`)
+
+	repeat := suite.executeWithKey(http.MethodPost, "/messages", key, late)
+	suite.Equal(http.StatusConflict, repeat.Code)
+	suite.Equal("true", repeat.Header().Get(httpserver.HeaderIdempotencyReplayed), "Expected exploded rocket receipts to be replayed")
+	suite.Equal(first.Body.String(), repeat.Body.String())
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) TestIdempotencyKey_FailConcurrentRepeat() {
+	for name, store := range suite.idempotencyStores() {
+		suite.Run(name, func() {
+			entered, proceed := make(chan struct{}), make(chan struct{})
+			handler := httpserver.NewIdempotencyMiddleware(store, time.Hour, suite.common.Logger).Middleware(
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					close(entered)
+					<-proceed
+					w.WriteHeader(http.StatusCreated)
+				}),
+			)
+
+			key := suite.common.UUIDProvider.New().String()
+			serve := func() *httptest.ResponseRecorder {
+				body := bytes.NewBufferString(`{"code": "SOYUZ", "name": "Soyuz", "target": "Low Earth Orbit"}`)
+				req := httptest.NewRequestWithContext(suite.T().Context(), http.MethodPost, "/missions", body)
+				req.Header.Set(httpserver.HeaderIdempotencyKey, key)
+				recorder := httptest.NewRecorder()
+				handler.ServeHTTP(recorder, req)
+
+				return recorder
+			}
+
+			firstDone := make(chan *httptest.ResponseRecorder)
+			go func() { firstDone <- serve() }()
+			<-entered
+
+			concurrent := serve()
+			suite.Equal(http.StatusConflict, concurrent.Code, "Expected repeats to be rejected while the first is processed")
+
+			close(proceed)
+			suite.Equal(http.StatusCreated, (<-firstDone).Code)
+
+			repeat := serve()
+			suite.Equal(http.StatusCreated, repeat.Code)
+			suite.Equal("true", repeat.Header().Get(httpserver.HeaderIdempotencyReplayed))
+		})
+	}
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) TestIdempotencyKey_ReleasesUnansweredRequests() {
+	for name, store := range suite.idempotencyStores() {
+		suite.Run(name, func() {
+			responses := []struct {
+				statusCode int
+				retryAfter string
+			}{
+				{statusCode: http.StatusServiceUnavailable},
+				{statusCode: http.StatusTooManyRequests},
+				{statusCode: http.StatusConflict, retryAfter: "1"},
+				{statusCode: http.StatusAccepted},
+			}
+			handler := httpserver.NewIdempotencyMiddleware(store, time.Hour, suite.common.Logger).Middleware(
+				http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					if responses[0].retryAfter != "" {
+						w.Header().Set(httpserver.HeaderRetryAfter, responses[0].retryAfter)
+					}
+					w.WriteHeader(responses[0].statusCode)
+					responses = responses[1:]
+				}),
+			)
+
+			key := suite.common.UUIDProvider.New().String()
+			expectedCodes := []int{
+				http.StatusServiceUnavailable,
+				http.StatusTooManyRequests,
+				http.StatusConflict,
+				http.StatusAccepted,
+				http.StatusAccepted,
+			}
+			for _, expected := range expectedCodes {
+				req := httptest.NewRequestWithContext(suite.T().Context(), http.MethodPost, "/missions", bytes.NewBufferString("{}"))
+				req.Header.Set(httpserver.HeaderIdempotencyKey, key)
+				recorder := httptest.NewRecorder()
+				handler.ServeHTTP(recorder, req)
+
+				suite.Equal(expected, recorder.Code, "Expected only final responses to be replayed")
+			}
+		})
+	}
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) TestIdempotencyKey_InMemoryStoreEvictsExpiredKeys() {
+	store := httpserver.NewInMemoryIdempotencyStore(suite.common.TimeProvider)
+	for i := range 10 {
+		response := httpserver.IdempotentResponse{Fingerprint: "fingerprint", StatusCode: http.StatusOK}
+		suite.Require().NoError(store.Save(suite.T().Context(), fmt.Sprintf("expiring-%d", i), response, 10*time.Millisecond))
+	}
+	_, _, err := store.Reserve(suite.T().Context(), "lasting", "fingerprint", time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().Equal(11, store.Len())
+
+	suite.Eventually(func() bool {
+		return store.Len() == 1
+	}, time.Second, 10*time.Millisecond, "Expected expired keys to be evicted without being looked up again")
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) idempotencyStores() map[string]httpserver.IdempotencyStore {
+	stores := map[string]httpserver.IdempotencyStore{
+		"in memory": httpserver.NewInMemoryIdempotencyStore(suite.common.TimeProvider),
+	}
+	if suite.common.RedisClient != nil {
+		stores["redis"] = httpserver.NewRedisIdempotencyStore(suite.common.RedisClient)
+	}
+
+	return stores
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) executeWithKey(method, path, key string, body []byte) *httptest.ResponseRecorder {
+	req, err := http.NewRequestWithContext(suite.T().Context(), method, path, bytes.NewBuffer(body))
+	suite.Require().NoError(err)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(httpserver.HeaderIdempotencyKey, key)
+
+	recorder := httptest.NewRecorder()
+	suite.common.Router.GetMuxRouter().ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) rocketEventBody(
+	rocketID string,
+	number int,
+	messageType, message string,
+	at time.Time,
+) string {
+	return fmt.Sprintf(
+		`{"metadata": {"channel": "%s","messageNumber": %d,"messageTime": "%s","messageType": "%s"},"message": %s}`,
+		rocketID, number, at.Format(time.RFC3339), messageType, message,
+	)
+}
+
+func (suite *IdempotencyKeyAcceptanceTestSuite) rocketLaunchedEventBody(rocketID string) []byte {
+	return []byte(fmt.Sprintf(
+		`{"metadata": {"channel": "%s","messageNumber": 1,"messageTime": "%s","messageType": "RocketLaunched"},`+
+			`"message": {"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}}`,
+		rocketID, time.Now().Add(-time.Hour).Format(time.RFC3339),
+	))
+}
@@ -0,0 +1,209 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	missionentrypoint "github.com/soulcodex/rockets-message-processor/internal/mission/infrastructure/entrypoint"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rocketmission "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/mission"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type MissionAcceptanceTestSuite struct {
+	suite.Suite
+
+	common        *di.CommonServices
+	rocketModule  *di.RocketModule
+	missionModule *di.MissionModule
+}
+
+func TestMission(t *testing.T) {
+	suite.Run(t, new(MissionAcceptanceTestSuite))
+}
+
+func (suite *MissionAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.missionModule = di.NewMissionModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *MissionAcceptanceTestSuite) TestCreateMission_Success() {
+	response := suite.createMission("GEMINI", "Gemini program", "Low Earth Orbit")
+	suite.Equal(http.StatusCreated, response.Code, "Expected status code 201 Created")
+
+	mission := suite.findMission("GEMINI")
+	suite.Equal("Gemini program", mission.Name)
+	suite.Equal("Low Earth Orbit", mission.Target)
+	suite.Equal("active", mission.Status)
+	suite.Zero(mission.RocketCount)
+
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/missions", nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	suite.Contains(response.Body.String(), `"code":"GEMINI"`)
+}
+
+func (suite *MissionAcceptanceTestSuite) TestCreateMission_FailAlreadyExists() {
+	suite.Equal(http.StatusCreated, suite.createMission("APOLLO", "Apollo program", "Moon").Code)
+
+	response := suite.createMission("APOLLO", "Apollo program", "Moon")
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
+
+func (suite *MissionAcceptanceTestSuite) TestCreateMission_FailInvalidMission() {
+	response := suite.createMission("MERCURY", "Mercury program", "")
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *MissionAcceptanceTestSuite) TestRetireMission_Success() {
+	suite.Equal(http.StatusCreated, suite.createMission("SKYLAB", "Skylab", "Low Earth Orbit").Code)
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/missions/SKYLAB/retire", nil)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+	suite.Equal("retired", suite.findMission("SKYLAB").Status)
+
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/missions/SKYLAB/retire", nil)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
+
+func (suite *MissionAcceptanceTestSuite) TestRetireMission_FailNotFound() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/missions/VOSTOK/retire", nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *MissionAcceptanceTestSuite) TestMissionRocketCounts_Success() {
+	suite.Equal(http.StatusCreated, suite.createMission("ARTEMIS", "Artemis program", "Moon").Code)
+	suite.Equal(http.StatusCreated, suite.createMission("LUNAR", "Lunar gateway", "Moon").Code)
+
+	reassignedID, stayingID := suite.common.UUIDProvider.New().String(), suite.common.UUIDProvider.New().String()
+	launchedAt := time.Now().Add(-time.Hour)
+	suite.receiveMessages(
+		http.StatusOK,
+		suite.rocketLaunchedEventBody(reassignedID, "ARTEMIS", launchedAt),
+		suite.rocketLaunchedEventBody(stayingID, "ARTEMIS", launchedAt),
+	)
+	suite.requireRocketCount("ARTEMIS", 2, "Expected the launched rockets to join ARTEMIS")
+
+	suite.receiveMessages(http.StatusOK, suite.rocketMissionChangedEventBody(reassignedID, "LUNAR", launchedAt.Add(time.Minute)))
+	suite.requireRocketCount("LUNAR", 1, "Expected the reassigned rocket to join LUNAR")
+	suite.Equal(1, suite.findMission("ARTEMIS").RocketCount, "Expected the reassigned rocket to leave ARTEMIS")
+}
+
+func (suite *MissionAcceptanceTestSuite) TestMissionRocketCounts_ExcludeExplodedRockets() {
+	suite.Equal(http.StatusCreated, suite.createMission("VENERA", "Venera program", "Venus").Code)
+
+	explodedID, flyingID := suite.common.UUIDProvider.New().String(), suite.common.UUIDProvider.New().String()
+	launchedAt := time.Now().Add(-time.Hour)
+	suite.receiveMessages(
+		http.StatusOK,
+		suite.rocketLaunchedEventBody(explodedID, "VENERA", launchedAt),
+		suite.rocketLaunchedEventBody(flyingID, "VENERA", launchedAt),
+	)
+	suite.requireRocketCount("VENERA", 2, "Expected the launched rockets to join VENERA")
+
+	exploded := suite.rocketEventBody(explodedID, 2, `{"reason": "PRESSURE_VESSEL_FAILURE"}`, "RocketExploded", launchedAt.Add(time.Minute))
+	suite.receiveMessages(http.StatusOK, exploded)
+	suite.requireRocketCount("VENERA", 1, "Expected the exploded rocket to leave VENERA")
+}
+
+func (suite *MissionAcceptanceTestSuite) TestMissionRocketCounts_IgnoreRejectedChanges() {
+	suite.Equal(http.StatusCreated, suite.createMission("ORION", "Orion program", "Moon").Code)
+	suite.Equal(http.StatusCreated, suite.createMission("DRAGON", "Dragon program", "Low Earth Orbit").Code)
+
+	rocketID, launchedAt := suite.common.UUIDProvider.New().String(), time.Now().Add(-time.Hour)
+	suite.receiveMessages(http.StatusOK, suite.rocketLaunchedEventBody(rocketID, "ORION", launchedAt))
+	suite.requireRocketCount("ORION", 1, "Expected the launched rocket to join ORION")
+
+	exploded := suite.rocketEventBody(rocketID, 2, `{"reason": "PRESSURE_VESSEL_FAILURE"}`, "RocketExploded", launchedAt.Add(time.Minute))
+	suite.receiveMessages(http.StatusOK, exploded)
+	suite.requireRocketCount("ORION", 0, "Expected the exploded rocket to leave ORION")
+
+	changed := suite.rocketEventBody(rocketID, 3, `{"newMission": "DRAGON"}`, "RocketMissionChanged", launchedAt.Add(2*time.Minute))
+	suite.receiveMessages(http.StatusConflict, changed)
+	suite.Zero(suite.findMission("DRAGON").RocketCount, "Expected the rejected change not to be counted")
+}
+
+func (suite *MissionAcceptanceTestSuite) TestMissionGuard() {
+	suite.Equal(http.StatusCreated, suite.createMission("SOYUZ", "Soyuz", "Low Earth Orbit").Code)
+	suite.Equal(http.StatusCreated, suite.createMission("BURAN", "Buran", "Low Earth Orbit").Code)
+	_, err := suite.missionModule.Retirer.Retire(suite.T().Context(), "BURAN", time.Now())
+	suite.Require().NoError(err)
+
+	guard := rocketmission.NewQueryBusMissionGuard(suite.common.QueryBus)
+	suite.NoError(guard.EnsureAssignable(suite.T().Context(), "SOYUZ"), "Expected active missions to be assignable")
+
+	for _, mission := range []rocketdomain.Mission{"BURAN", "UNKNOWN"} {
+		_, rejected := rocketdomain.AsMissionNotAssignableError(guard.EnsureAssignable(suite.T().Context(), mission))
+		suite.True(rejected, "Expected mission %s to be rejected", mission)
+	}
+}
+
+func (suite *MissionAcceptanceTestSuite) createMission(code, name, target string) *httptest.ResponseRecorder {
+	body := fmt.Sprintf(`{"code": "%s", "name": "%s", "target": "%s"}`, code, name, target)
+	return testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/missions", []byte(body))
+}
+
+func (suite *MissionAcceptanceTestSuite) receiveMessages(expectedStatus int, bodies ...[]byte) {
+	suite.T().Helper()
+
+	for _, body := range bodies {
+		response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", body)
+		suite.Require().Equal(expectedStatus, response.Code, "Unexpected status code receiving the rocket message")
+	}
+}
+
+// requireRocketCount waits for the mission to count the given rockets, as counts follow rocket changes asynchronously.
+func (suite *MissionAcceptanceTestSuite) requireRocketCount(code string, expected int, msg string) {
+	suite.T().Helper()
+
+	suite.Require().Eventually(func() bool {
+		return suite.findMission(code).RocketCount == expected
+	}, time.Second, 10*time.Millisecond, msg)
+}
+
+func (suite *MissionAcceptanceTestSuite) findMission(code string) missionentrypoint.MissionResponseV1 {
+	suite.T().Helper()
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/missions/"+code, nil)
+	suite.Require().Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	var mission missionentrypoint.MissionResponseV1
+	suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &mission), "failed to unmarshal mission response")
+
+	return mission
+}
+
+func (suite *MissionAcceptanceTestSuite) rocketLaunchedEventBody(rocketID, mission string, at time.Time) []byte {
+	content := fmt.Sprintf(`{"type": "Falcon-9","launchSpeed": 500,"mission": "%s"}`, mission)
+	return suite.rocketEventBody(rocketID, 1, content, "RocketLaunched", at)
+}
+
+func (suite *MissionAcceptanceTestSuite) rocketMissionChangedEventBody(rocketID, mission string, at time.Time) []byte {
+	content := fmt.Sprintf(`{"newMission": "%s"}`, mission)
+	return suite.rocketEventBody(rocketID, 2, content, "RocketMissionChanged", at)
+}
+
+func (suite *MissionAcceptanceTestSuite) rocketEventBody(
+	channel string,
+	messageNumber int,
+	content string,
+	messageType string,
+	at time.Time,
+) []byte {
+	return []byte(fmt.Sprintf(
+		`{"metadata": {"channel": "%s","messageNumber": %d,"messageTime": "%s","messageType": "%s"},"message": %s}`,
+		channel, messageNumber, at.Format(time.RFC3339), messageType, content,
+	))
+}
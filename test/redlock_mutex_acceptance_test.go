@@ -0,0 +1,142 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+)
+
+// RedlockMutexAcceptanceTestSuite stands independent Redis nodes in for the databases of the test Redis, and
+// nodes being down for an address nothing listens on.
+type RedlockMutexAcceptanceTestSuite struct {
+	suite.Suite
+
+	common *di.CommonServices
+}
+
+func TestRedlockMutex(t *testing.T) {
+	suite.Run(t, new(RedlockMutexAcceptanceTestSuite))
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	if suite.common.RedisClient == nil {
+		suite.T().Skip("redlock needs redis, the service runs standalone")
+	}
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) SetupTest() {
+	for _, node := range suite.nodes(1, 2, 3) {
+		node.FlushDB(suite.T().Context())
+	}
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) TestMutexGrantedByEveryNode() {
+	mutex := distributedsync.NewRedlockMutexService(suite.nodes(1, 2, 3), suite.common.Logger)
+
+	grant := suite.lock(mutex, "rocket:redlock")
+
+	suite.Len(grant.Nodes, 3)
+	suite.Equal(2, grant.Quorum)
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) TestMutexDegradesWhileMinorityIsDown() {
+	nodes := append(suite.nodes(1, 2), suite.unreachableNode())
+	mutex := distributedsync.NewRedlockMutexService(nodes, suite.common.Logger)
+
+	tokens := make([]uint64, 0, 2)
+	for range 2 {
+		_, err := mutex.Mutex(suite.T().Context(), "rocket:degraded", func(ctx context.Context) (interface{}, error) {
+			grant, granted := distributedsync.MutexGrantFromContext(ctx)
+			suite.Require().True(granted)
+			suite.Equal([]string{nodes[0].Options().Addr + "/1", nodes[1].Options().Addr + "/2"}, grant.Nodes)
+
+			token, _ := distributedsync.FencingTokenFromContext(ctx)
+			tokens = append(tokens, token.Value)
+			return nil, nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	suite.Equal([]uint64{1, 2}, tokens)
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) TestFencingTokensIncreaseAcrossQuorums() {
+	nodes := suite.nodes(1, 2, 3)
+
+	first := distributedsync.NewRedlockMutexService(nodes, suite.common.Logger)
+	suite.lock(first, "rocket:quorums")
+	suite.lock(first, "rocket:quorums")
+
+	second := distributedsync.NewRedlockMutexService(
+		[]*redis.Client{nodes[0], suite.unreachableNode(), nodes[2]},
+		suite.common.Logger,
+	)
+	_, err := second.Mutex(suite.T().Context(), "rocket:quorums", func(ctx context.Context) (interface{}, error) {
+		token, _ := distributedsync.FencingTokenFromContext(ctx)
+		suite.Equal(uint64(3), token.Value, "Expected the token to keep increasing with a different quorum")
+		return nil, nil
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) TestMutexFailsWhileMajorityIsDown() {
+	nodes := []*redis.Client{suite.nodes(1)[0], suite.unreachableNode(), suite.unreachableNode()}
+	mutex := distributedsync.NewRedlockMutexService(nodes, suite.common.Logger)
+
+	_, err := mutex.Mutex(
+		suite.T().Context(),
+		"rocket:unavailable",
+		func(context.Context) (interface{}, error) {
+			suite.Fail("Expected the callback not to run without a quorum")
+			return nil, nil
+		},
+		distributedsync.WithLockWaitBudget(200*time.Millisecond),
+	)
+
+	suite.Require().Error(err)
+	_, contended := distributedsync.AsMutexContendedError(err)
+	suite.False(contended, "Expected nodes being down not to pass for contention")
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) lock(mutex distributedsync.MutexService, key string) distributedsync.MutexGrant {
+	var grant distributedsync.MutexGrant
+	_, err := mutex.Mutex(suite.T().Context(), key, func(ctx context.Context) (interface{}, error) {
+		grant, _ = distributedsync.MutexGrantFromContext(ctx)
+		return nil, nil
+	})
+	suite.Require().NoError(err)
+
+	return grant
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) nodes(databases ...int) []*redis.Client {
+	nodes := make([]*redis.Client, len(databases))
+	for i, database := range databases {
+		options, err := redis.ParseURL(suite.common.Config.RedisURL)
+		suite.Require().NoError(err)
+
+		options.DB = database
+		nodes[i] = redis.NewClient(options)
+	}
+
+	return nodes
+}
+
+func (suite *RedlockMutexAcceptanceTestSuite) unreachableNode() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		MaxRetries:  -1,
+		DialTimeout: 100 * time.Millisecond,
+	})
+}
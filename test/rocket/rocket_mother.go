@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
 )
 
@@ -21,6 +23,18 @@ func WithLaunchSpeed(speed int64) RocketMotherOpt {
 	}
 }
 
+func WithRocketType(rocketType string) RocketMotherOpt {
+	return func(m *RocketMother) {
+		m.primitives.RocketType = rocketType
+	}
+}
+
+func WithMission(mission string) RocketMotherOpt {
+	return func(m *RocketMother) {
+		m.primitives.Mission = mission
+	}
+}
+
 func WithSoftDeletion() RocketMotherOpt {
 	return func(m *RocketMother) {
 		now := time.Now()
@@ -28,6 +42,13 @@ func WithSoftDeletion() RocketMotherOpt {
 	}
 }
 
+func WithCreationDate(at time.Time) RocketMotherOpt {
+	return func(m *RocketMother) {
+		m.primitives.CreatedAt = at
+		m.primitives.UpdatedAt = at
+	}
+}
+
 func WithUpdateDate(at time.Time) RocketMotherOpt {
 	return func(m *RocketMother) {
 		m.primitives.UpdatedAt = at
@@ -67,7 +88,7 @@ func (m *RocketMother) Build(t *testing.T) *rocketdomain.Rocket {
 	)
 
 	if m.primitives.DeletedAt != nil {
-		rocket.Delete(time.Now())
+		require.NoError(t, rocket.Delete(time.Now()), "failed to explode rocket")
 	}
 
 	return rocket
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type RocketEventReceiptAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+}
+
+func TestRocketEventReceipt(t *testing.T) {
+	suite.Run(t, new(RocketEventReceiptAcceptanceTestSuite))
+}
+
+func (suite *RocketEventReceiptAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *RocketEventReceiptAcceptanceTestSuite) TestRocketEventReceipt_Outcomes() {
+	rocketID, launchedAt := suite.common.UUIDProvider.New().String(), time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	testCases := []struct {
+		name            string
+		messageNumber   int
+		messageType     string
+		content         string
+		at              time.Time
+		expectedStatus  int
+		expectedOutcome string
+		expectedVersion uint64
+		expectedState   string
+	}{
+		{
+			name:          "launch is applied",
+			messageNumber: 1, messageType: "RocketLaunched", at: launchedAt,
+			content:        `{"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}`,
+			expectedStatus: http.StatusOK, expectedOutcome: "applied", expectedVersion: 1, expectedState: "launched",
+		},
+		{
+			name:          "speed change is applied",
+			messageNumber: 3, messageType: "RocketSpeedIncreased", at: launchedAt.Add(2 * time.Minute),
+			content:        `{"by": 100}`,
+			expectedStatus: http.StatusOK, expectedOutcome: "applied", expectedVersion: 2, expectedState: "in_flight",
+		},
+		{
+			name:          "late speed change is ignored",
+			messageNumber: 2, messageType: "RocketSpeedIncreased", at: launchedAt.Add(time.Minute),
+			content:        `{"by": 100}`,
+			expectedStatus: http.StatusOK, expectedOutcome: "stale_ignored", expectedVersion: 2, expectedState: "in_flight",
+		},
+		{
+			name:          "explosion is applied",
+			messageNumber: 4, messageType: "RocketExploded", at: launchedAt.Add(3 * time.Minute),
+			content:        `{"reason": "PRESSURE_VESSEL_FAILURE"}`,
+			expectedStatus: http.StatusOK, expectedOutcome: "applied", expectedVersion: 3, expectedState: "exploded",
+		},
+		{
+			name:          "mission change after explosion is rejected",
+			messageNumber: 5, messageType: "RocketMissionChanged", at: launchedAt.Add(4 * time.Minute),
+			content:        `{"newMission": "LUNAR"}`,
+			expectedStatus: http.StatusConflict, expectedOutcome: "rocket_exploded", expectedVersion: 3, expectedState: "exploded",
+		},
+	}
+
+	for _, tc := range testCases {
+		body := fmt.Sprintf(
+			`{"metadata": {"channel": "%s","messageNumber": %d,"messageTime": "%s","messageType": "%s"},"message": %s}`,
+			rocketID, tc.messageNumber, tc.at.Format(time.RFC3339), tc.messageType, tc.content,
+		)
+
+		response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", []byte(body))
+		suite.Require().Equal(tc.expectedStatus, response.Code, tc.name)
+
+		var receipt rocketentrypoint.RocketEventReceiptResponseV1
+		suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &receipt), "failed to unmarshal receipt response")
+		suite.NotEmpty(receipt.EventID, tc.name)
+		suite.Equal(rocketID, receipt.RocketID, tc.name)
+		suite.Equal(tc.expectedOutcome, receipt.Outcome, tc.name)
+		suite.Equal(tc.expectedVersion, receipt.RocketVersion, tc.name)
+		suite.Equal(tc.expectedState, receipt.RocketStatus, tc.name)
+	}
+}
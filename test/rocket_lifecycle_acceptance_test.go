@@ -0,0 +1,107 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type RocketLifecycleAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+}
+
+func TestRocketLifecycle(t *testing.T) {
+	suite.Run(t, new(RocketLifecycleAcceptanceTestSuite))
+}
+
+func (suite *RocketLifecycleAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *RocketLifecycleAcceptanceTestSuite) TestRocketLifecycle_Success() {
+	rocketID, launchedAt := suite.common.UUIDProvider.New().String(), time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	suite.Equal(http.StatusOK, suite.sendRocketEvent(rocketID, 1, `{"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}`, "RocketLaunched", launchedAt).Code)
+	suite.Equal(rocketdomain.RocketStatusLaunched, suite.rocketStatus(rocketID))
+
+	suite.Equal(http.StatusOK, suite.sendRocketEvent(rocketID, 2, `{"by": 100}`, "RocketSpeedIncreased", launchedAt.Add(time.Minute)).Code)
+	suite.Equal(rocketdomain.RocketStatusInFlight, suite.rocketStatus(rocketID))
+
+	suite.Equal(http.StatusOK, suite.sendRocketEvent(rocketID, 3, `{"reason": "PRESSURE_VESSEL_FAILURE"}`, "RocketExploded", launchedAt.Add(2*time.Minute)).Code)
+	suite.Equal(rocketdomain.RocketStatusExploded, suite.rocketStatus(rocketID))
+}
+
+func (suite *RocketLifecycleAcceptanceTestSuite) TestRocketLifecycle_FailChangeAfterExplosion() {
+	rocketID, launchedAt := suite.common.UUIDProvider.New().String(), time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	suite.Require().Equal(http.StatusOK, suite.sendRocketEvent(rocketID, 1, `{"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}`, "RocketLaunched", launchedAt).Code)
+	suite.Require().Equal(http.StatusOK, suite.sendRocketEvent(rocketID, 3, `{"reason": "PRESSURE_VESSEL_FAILURE"}`, "RocketExploded", launchedAt.Add(2*time.Minute)).Code)
+
+	response := suite.sendRocketEvent(rocketID, 2, `{"by": 100}`, "RocketSpeedIncreased", launchedAt.Add(time.Minute))
+	suite.Equal(http.StatusConflict, response.Code, "Expected late messages on exploded rockets to be reported as 409 Conflict")
+
+	response = suite.sendRocketEvent(rocketID, 4, `{"newMission": "LUNAR"}`, "RocketMissionChanged", launchedAt.Add(3*time.Minute))
+	suite.Equal(http.StatusConflict, response.Code, "Expected changes on exploded rockets to be reported as 409 Conflict")
+}
+
+func (suite *RocketLifecycleAcceptanceTestSuite) TestRocketLifecycle_FailRelaunch() {
+	rocketID, launchedAt := suite.common.UUIDProvider.New().String(), time.Now().Add(-time.Hour).Truncate(time.Second)
+	launched := `{"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}`
+
+	suite.Require().Equal(http.StatusOK, suite.sendRocketEvent(rocketID, 1, launched, "RocketLaunched", launchedAt).Code)
+
+	response := suite.sendRocketEvent(rocketID, 2, launched, "RocketLaunched", launchedAt.Add(time.Minute))
+	suite.Equal(http.StatusConflict, response.Code, "Expected launching a flying rocket again to be reported as 409 Conflict")
+
+	suite.Require().Equal(http.StatusOK, suite.sendRocketEvent(rocketID, 3, `{"reason": "PRESSURE_VESSEL_FAILURE"}`, "RocketExploded", launchedAt.Add(2*time.Minute)).Code)
+
+	response = suite.sendRocketEvent(rocketID, 4, launched, "RocketLaunched", launchedAt.Add(3*time.Minute))
+	suite.Equal(http.StatusConflict, response.Code, "Expected launching an exploded rocket again to be reported as 409 Conflict")
+	suite.Contains(response.Body.String(), `"rocket_status":"exploded"`)
+
+	rocket, err := suite.rocketModule.Repository.Find(suite.T().Context(), rocketdomain.RocketID(rocketID))
+	suite.Require().NoError(err)
+	suite.Equal(rocketdomain.RocketStatusExploded, rocket.Status(), "Expected the exploded rocket to be left untouched")
+	suite.Equal(uint64(2), rocket.Version(), "Expected the rocket version not to be reset")
+}
+
+func (suite *RocketLifecycleAcceptanceTestSuite) rocketStatus(rocketID string) rocketdomain.RocketStatus {
+	suite.T().Helper()
+
+	rocket, err := suite.rocketModule.Repository.Find(suite.T().Context(), rocketdomain.RocketID(rocketID))
+	suite.Require().NoError(err)
+
+	return rocket.Status()
+}
+
+func (suite *RocketLifecycleAcceptanceTestSuite) sendRocketEvent(
+	channel string,
+	messageNumber int,
+	content string,
+	messageType string,
+	at time.Time,
+) *httptest.ResponseRecorder {
+	body := fmt.Sprintf(
+		`{"metadata": {"channel": "%s","messageNumber": %d,"messageTime": "%s","messageType": "%s"},"message": %s}`,
+		channel, messageNumber, at.Format(time.RFC3339), messageType, content,
+	)
+
+	return testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", []byte(body))
+}
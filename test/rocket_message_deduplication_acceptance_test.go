@@ -0,0 +1,343 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	"github.com/soulcodex/rockets-message-processor/pkg/messaging"
+	messagingmock "github.com/soulcodex/rockets-message-processor/pkg/messaging/mock"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type RocketMessageDeduplicationAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+}
+
+func TestRocketMessageDeduplication(t *testing.T) {
+	suite.Run(t, new(RocketMessageDeduplicationAcceptanceTestSuite))
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) TestDuplicateMessage_AnsweredAsNoOp() {
+	body := suite.rocketLaunchedEventBody(suite.common.UUIDProvider.New().String())
+
+	first := suite.receiptOf(testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", body))
+	suite.Equal("applied", first.Outcome)
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", body)
+	suite.Require().Equal(http.StatusOK, response.Code, "Expected duplicates to be answered with 200 OK")
+	duplicate := suite.receiptOf(response)
+	suite.Equal("duplicate", duplicate.Outcome)
+	suite.Equal(first.EventID, duplicate.EventID)
+	suite.Equal(first.RocketID, duplicate.RocketID)
+
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/messages/"+first.EventID, nil)
+	suite.Require().Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	suite.Contains(response.Body.String(), `"outcome":"applied"`, "Expected the original delivery to be kept in the ledger")
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) TestDeduplicatorUnavailable_FailurePolicy() {
+	unreachable := messaging.NewDefaultRedisDeduplicator(redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		MaxRetries:  -1,
+		DialTimeout: 100 * time.Millisecond,
+	}))
+
+	testCases := []struct {
+		name           string
+		policy         messaging.DeduplicatorFailurePolicy
+		expectedStatus int
+	}{
+		{name: "fail closed rejects the message", policy: messaging.DeduplicatorFailClosed, expectedStatus: http.StatusServiceUnavailable},
+		{name: "fail open processes the message", policy: messaging.DeduplicatorFailOpen, expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		handler := rocketentrypoint.HandleReceiveRocketMessageV1HTTP(
+			suite.common.EventBus,
+			suite.common.Mutex,
+			messaging.WithFailurePolicy(unreachable, tc.policy, suite.common.Logger),
+			suite.rocketModule.Messages,
+			suite.common.TimeProvider,
+			suite.common.Logger,
+			httpserver.NewJSONResponseMiddleware(suite.common.Logger),
+		)
+
+		body := suite.rocketLaunchedEventBody(suite.common.UUIDProvider.New().String())
+		request := httptest.NewRequestWithContext(suite.T().Context(), http.MethodPost, "/messages", bytes.NewBuffer(body))
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+
+		suite.Equal(tc.expectedStatus, response.Code, tc.name)
+	}
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) TestCommitFailure_AnsweredWithReceipt() {
+	deduplicator := &messagingmock.DeduplicatorMock{
+		ClaimFunc: func(_ context.Context, _ messaging.Message) (messaging.Claim, error) {
+			return messaging.Claim{Status: messaging.ClaimAcquired, Token: "claim"}, nil
+		},
+		CommitFunc: func(_ context.Context, message messaging.Message, _ messaging.Claim) error {
+			return messaging.NewDeduplicatorUnavailableError(message, errors.New("connection refused"))
+		},
+	}
+	handler := rocketentrypoint.HandleReceiveRocketMessageV1HTTP(
+		suite.common.EventBus,
+		suite.common.Mutex,
+		deduplicator,
+		suite.rocketModule.Messages,
+		suite.common.TimeProvider,
+		suite.common.Logger,
+		httpserver.NewJSONResponseMiddleware(suite.common.Logger),
+	)
+
+	body := suite.rocketLaunchedEventBody(suite.common.UUIDProvider.New().String())
+	request := httptest.NewRequestWithContext(suite.T().Context(), http.MethodPost, "/messages", bytes.NewBuffer(body))
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+
+	suite.Equal(http.StatusOK, response.Code, "Expected applied messages to be answered even when their claim can't be committed")
+	suite.Equal("applied", suite.receiptOf(response).Outcome)
+	suite.Len(deduplicator.CommitCalls(), 1)
+	suite.Empty(deduplicator.ReleaseCalls(), "Expected applied messages not to be released")
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) TestConcurrentDeliveries_ProcessedOnce() {
+	body := suite.rocketLaunchedEventBody(suite.common.UUIDProvider.New().String())
+
+	const deliveries = 8
+	responses := make(chan *httptest.ResponseRecorder, deliveries)
+	var wg sync.WaitGroup
+	for range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses <- testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", body)
+		}()
+	}
+	wg.Wait()
+	close(responses)
+
+	applied := 0
+	for response := range responses {
+		suite.Contains([]int{http.StatusOK, http.StatusConflict}, response.Code, "Expected deliveries to be processed or in progress")
+		if response.Code == http.StatusOK && suite.receiptOf(response).Outcome == "applied" {
+			applied++
+		}
+	}
+
+	suite.Equal(1, applied, "Expected a single delivery to be applied")
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) TestRedisDeduplicator_ClaimProtocol() {
+	suite.requireRedis()
+
+	deduplicator := messaging.NewDefaultRedisDeduplicator(suite.common.RedisClient)
+	message := fakeRocketMessage(suite.common.UUIDProvider.New().String())
+
+	claim, err := deduplicator.Claim(suite.T().Context(), message)
+	suite.Require().NoError(err)
+	suite.Require().True(claim.Acquired())
+
+	concurrent, err := deduplicator.Claim(suite.T().Context(), message)
+	suite.Require().NoError(err)
+	suite.Equal(messaging.ClaimInProgress, concurrent.Status, "Expected concurrent deliveries to be rejected")
+
+	suite.Require().NoError(deduplicator.Release(suite.T().Context(), message, claim))
+	retried, err := deduplicator.Claim(suite.T().Context(), message)
+	suite.Require().NoError(err)
+	suite.Require().True(retried.Acquired(), "Expected released messages to be claimed again")
+
+	suite.True(messaging.IsMessageClaimLostError(deduplicator.Commit(suite.T().Context(), message, claim)))
+	suite.Require().NoError(deduplicator.Commit(suite.T().Context(), message, retried))
+	suite.NoError(deduplicator.Commit(suite.T().Context(), message, claim), "Expected claims committed by another delivery to be a no-op")
+
+	duplicate, err := deduplicator.Claim(suite.T().Context(), message)
+	suite.Require().NoError(err)
+	suite.Equal(messaging.ClaimDuplicate, duplicate.Status)
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) TestRedisSequenceDeduplicator_HighWaterMark() {
+	suite.requireRedis()
+
+	deduplicator, err := messaging.NewRedisSequenceDeduplicator(
+		suite.common.RedisClient,
+		messaging.WithRedisSequenceDeduplicatorWindowSize(4),
+	)
+	suite.Require().NoError(err)
+	channel := suite.common.UUIDProvider.New().String()
+
+	for _, number := range []uint64{1, 2, 5, 7} {
+		suite.Equal(messaging.ClaimAcquired, suite.claimAndCommit(deduplicator, channel, number), "message %d", number)
+	}
+
+	gaps, err := deduplicator.Gaps(suite.T().Context(), channel)
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{4, 6}, gaps, "Expected unseen numbers of the window to be reported")
+
+	testCases := []struct {
+		name     string
+		number   uint64
+		expected messaging.ClaimStatus
+	}{
+		{name: "processed number within the window", number: 5, expected: messaging.ClaimDuplicate},
+		{name: "high-water mark", number: 7, expected: messaging.ClaimDuplicate},
+		{name: "number below the window", number: 2, expected: messaging.ClaimDuplicate},
+		{name: "late number within the window", number: 6, expected: messaging.ClaimAcquired},
+		{name: "number above the high-water mark", number: 9, expected: messaging.ClaimAcquired},
+	}
+
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, suite.claimAndCommit(deduplicator, channel, tc.number), tc.name)
+	}
+
+	gaps, err = deduplicator.Gaps(suite.T().Context(), channel)
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{8}, gaps)
+
+	message := fakeSequencedMessage{channel: channel, number: 10}
+	claim, err := deduplicator.Claim(suite.T().Context(), message)
+	suite.Require().NoError(err)
+	suite.Require().True(claim.Acquired())
+	concurrent, err := deduplicator.Claim(suite.T().Context(), message)
+	suite.Require().NoError(err)
+	suite.Equal(messaging.ClaimInProgress, concurrent.Status, "Expected concurrent deliveries to be rejected")
+
+	stale := messaging.Claim{Status: messaging.ClaimAcquired, Token: "expired-claim"}
+	suite.True(messaging.IsMessageClaimLostError(deduplicator.Commit(suite.T().Context(), message, stale)))
+	suite.Require().NoError(deduplicator.Commit(suite.T().Context(), message, claim))
+	suite.NoError(deduplicator.Commit(suite.T().Context(), message, stale), "Expected numbers committed by another delivery to be a no-op")
+
+	suite.Equal(messaging.ClaimAcquired, suite.claimAndCommit(deduplicator, channel, 20), "Expected numbers a window ahead to be processed")
+	gaps, err = deduplicator.Gaps(suite.T().Context(), channel)
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{17, 18, 19}, gaps, "Expected jumping a whole window ahead to evict it")
+	suite.Equal(messaging.ClaimDuplicate, suite.claimAndCommit(deduplicator, channel, 16), "Expected evicted numbers to be duplicates")
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) TestRocketMessageGaps_Reported() {
+	deduplicator, err := messaging.NewInMemorySequenceDeduplicator(suite.common.TimeProvider, time.Minute, 4)
+	suite.Require().NoError(err)
+	router := mux.NewRouter()
+	router.Handle(
+		"/rockets/{rocket_id}/message-gaps",
+		rocketentrypoint.HandleRocketMessageGapsV1HTTP(deduplicator, httpserver.NewJSONResponseMiddleware(suite.common.Logger)),
+	)
+
+	rocketID := suite.common.UUIDProvider.New().String()
+	for _, number := range []uint64{1, 3, 5} {
+		suite.Equal(messaging.ClaimAcquired, suite.claimAndCommit(deduplicator, rocketID, number), "message %d", number)
+	}
+
+	testCases := []struct {
+		name           string
+		rocketID       string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{name: "gaps of the rocket", rocketID: rocketID, expectedStatus: http.StatusOK, expectedBody: `"gaps":[2,4]`},
+		{name: "unknown rocket", rocketID: suite.common.UUIDProvider.New().String(), expectedStatus: http.StatusOK, expectedBody: `"gaps":[]`},
+		{name: "invalid rocket id", rocketID: "invalid-id", expectedStatus: http.StatusBadRequest, expectedBody: "invalid rocket_id format"},
+	}
+
+	for _, tc := range testCases {
+		path := "/rockets/" + tc.rocketID + "/message-gaps"
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, httptest.NewRequestWithContext(suite.T().Context(), http.MethodGet, path, nil))
+		suite.Equal(tc.expectedStatus, response.Code, tc.name)
+		suite.Contains(response.Body.String(), tc.expectedBody, tc.name)
+	}
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) claimAndCommit(
+	deduplicator messaging.Deduplicator,
+	channel string,
+	number uint64,
+) messaging.ClaimStatus {
+	suite.T().Helper()
+
+	message := fakeSequencedMessage{channel: channel, number: number}
+	claim, err := deduplicator.Claim(suite.T().Context(), message)
+	suite.Require().NoError(err)
+
+	if claim.Acquired() {
+		suite.Require().NoError(deduplicator.Commit(suite.T().Context(), message, claim))
+	}
+
+	return claim.Status
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) requireRedis() {
+	if suite.common.RedisClient == nil {
+		suite.T().Skip("redis deduplicators need redis, the service runs standalone")
+	}
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) receiptOf(
+	response *httptest.ResponseRecorder,
+) rocketentrypoint.RocketEventReceiptResponseV1 {
+	suite.T().Helper()
+
+	var receipt rocketentrypoint.RocketEventReceiptResponseV1
+	suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &receipt), "failed to unmarshal receipt response")
+
+	return receipt
+}
+
+func (suite *RocketMessageDeduplicationAcceptanceTestSuite) rocketLaunchedEventBody(rocketID string) []byte {
+	return []byte(fmt.Sprintf(
+		`{"metadata": {"channel": "%s","messageNumber": 1,"messageTime": "%s","messageType": "RocketLaunched"},`+
+			`"message": {"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}}`,
+		rocketID, time.Now().Add(-time.Hour).Format(time.RFC3339),
+	))
+}
+
+type fakeRocketMessage string
+
+func (m fakeRocketMessage) Identifier() string {
+	return string(m)
+}
+
+type fakeSequencedMessage struct {
+	channel string
+	number  uint64
+}
+
+func (m fakeSequencedMessage) Identifier() string {
+	return fmt.Sprintf("%s:%d", m.channel, m.number)
+}
+
+func (m fakeSequencedMessage) Channel() string {
+	return m.channel
+}
+
+func (m fakeSequencedMessage) SequenceNumber() uint64 {
+	return m.number
+}
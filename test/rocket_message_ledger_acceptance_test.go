@@ -0,0 +1,136 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rocketmock "github.com/soulcodex/rockets-message-processor/internal/rocket/domain/mock"
+	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	httpserver "github.com/soulcodex/rockets-message-processor/pkg/http-server"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type RocketMessageLedgerAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+}
+
+func TestRocketMessageLedger(t *testing.T) {
+	suite.Run(t, new(RocketMessageLedgerAcceptanceTestSuite))
+}
+
+func (suite *RocketMessageLedgerAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *RocketMessageLedgerAcceptanceTestSuite) TestFindRocketMessage_Outcomes() {
+	rocketID, launchedAt := suite.common.UUIDProvider.New().String(), time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name            string
+		messageNumber   int
+		messageType     string
+		content         string
+		expectedStatus  int
+		expectedOutcome string
+		expectedError   bool
+	}{
+		{
+			name:          "launch is recorded as applied",
+			messageNumber: 1, messageType: "RocketLaunched",
+			content:        `{"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}`,
+			expectedStatus: http.StatusOK, expectedOutcome: "applied",
+		},
+		{
+			name:          "explosion is recorded as applied",
+			messageNumber: 2, messageType: "RocketExploded",
+			content:        `{"reason": "PRESSURE_VESSEL_FAILURE"}`,
+			expectedStatus: http.StatusOK, expectedOutcome: "applied",
+		},
+		{
+			name:          "mission change after explosion is recorded with its error",
+			messageNumber: 3, messageType: "RocketMissionChanged",
+			content:        `{"newMission": "LUNAR"}`,
+			expectedStatus: http.StatusConflict, expectedOutcome: "rocket_exploded", expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		body := fmt.Sprintf(
+			`{"metadata": {"channel": "%s","messageNumber": %d,"messageTime": "%s","messageType": "%s"},"message": %s}`,
+			rocketID, tc.messageNumber, launchedAt.Add(time.Duration(tc.messageNumber)*time.Minute).Format(time.RFC3339),
+			tc.messageType, tc.content,
+		)
+
+		response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", []byte(body))
+		suite.Require().Equal(tc.expectedStatus, response.Code, tc.name)
+
+		var receipt rocketentrypoint.RocketEventReceiptResponseV1
+		suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &receipt), "failed to unmarshal receipt response")
+
+		response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/messages/"+receipt.EventID, nil)
+		suite.Require().Equal(http.StatusOK, response.Code, tc.name)
+
+		var message rocketentrypoint.RocketMessageResponseV1
+		suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &message), "failed to unmarshal message response")
+		suite.Equal(receipt.EventID, message.EventID, tc.name)
+		suite.Equal(rocketID, message.RocketID, tc.name)
+		suite.Equal(tc.expectedOutcome, message.Outcome, tc.name)
+		suite.False(message.ReceivedAt.IsZero(), tc.name)
+		suite.Equal(tc.expectedError, message.Error != nil, tc.name)
+	}
+}
+
+func (suite *RocketMessageLedgerAcceptanceTestSuite) TestReceiveRocketMessage_LedgerUnavailable() {
+	ledger := &rocketmock.RocketMessageLedgerMock{
+		RecordFunc: func(_ context.Context, _ rocketdomain.RocketMessageRecord) error {
+			return errors.New("ledger unavailable")
+		},
+	}
+	handler := rocketentrypoint.HandleReceiveRocketMessageV1HTTP(
+		suite.common.EventBus,
+		suite.common.Mutex,
+		suite.common.Deduplicator,
+		ledger,
+		suite.common.TimeProvider,
+		suite.common.Logger,
+		httpserver.NewJSONResponseMiddleware(suite.common.Logger),
+	)
+
+	rocketID := suite.common.UUIDProvider.New().String()
+	body := []byte(fmt.Sprintf(
+		`{"metadata": {"channel": "%s","messageNumber": 1,"messageTime": "%s","messageType": "RocketLaunched"},"message": %s}`,
+		rocketID, time.Now().Format(time.RFC3339), `{"type": "Falcon-9","launchSpeed": 500,"mission": "ARTEMIS"}`,
+	))
+	request := httptest.NewRequestWithContext(suite.T().Context(), http.MethodPost, "/messages", bytes.NewBuffer(body))
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+
+	suite.Equal(http.StatusOK, response.Code, "Expected processed messages to be answered even when they can't be recorded")
+	suite.Contains(response.Body.String(), `"outcome":"applied"`)
+	suite.Len(ledger.RecordCalls(), 1)
+}
+
+func (suite *RocketMessageLedgerAcceptanceTestSuite) TestFindRocketMessage_FailNotFound() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/messages/unknown-event", nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
@@ -35,7 +35,7 @@ func (suite *RocketMessageReceiveSmokeTestSuite) SetupSuite() {
 		".test.env",
 	)
 	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
-	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.common.FlushAll(suite.T().Context())
 	suite.rocketID = rocketdomain.RocketID(suite.common.UUIDProvider.New().String())
 
 	rocket := rockettest.NewRocketMother(rockettest.WithRocketID(suite.rocketID.String())).Build(suite.T())
@@ -67,31 +67,31 @@ func (suite *RocketMessageReceiveSmokeTestSuite) TestReceiveRocketLaunched_Succe
 		time.Now(),
 	)
 	response := suite.executeJSONRequest(http.MethodPost, "/messages", eventBody)
-	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 200 OK")
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
 }
 
 func (suite *RocketMessageReceiveSmokeTestSuite) TestReceiveRocketExploded_Success() {
 	eventBody := suite.rocketExplodedEventBody(suite.rocketID.String(), time.Now())
 	response := suite.executeJSONRequest(http.MethodPost, "/messages", eventBody)
-	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 200 OK")
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
 }
 
 func (suite *RocketMessageReceiveSmokeTestSuite) TestReceiveRocketMissionChanged_Success() {
 	eventBody := suite.rocketMissionChangedEventBody(suite.rocketID.String(), "LUNAR", time.Now())
 	response := suite.executeJSONRequest(http.MethodPost, "/messages", eventBody)
-	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 200 OK")
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
 }
 
 func (suite *RocketMessageReceiveSmokeTestSuite) TestReceiveRocketSpeedIncreased_Success() {
 	eventBody := suite.rocketSpeedIncreasedEventBody(suite.rocketID.String(), 500, time.Now())
 	response := suite.executeJSONRequest(http.MethodPost, "/messages", eventBody)
-	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 200 OK")
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
 }
 
 func (suite *RocketMessageReceiveSmokeTestSuite) TestReceiveRocketSpeedDecreased_Success() {
 	eventBody := suite.rocketSpeedDecreasedEventBody(suite.rocketID.String(), 500, time.Now())
 	response := suite.executeJSONRequest(http.MethodPost, "/messages", eventBody)
-	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 200 OK")
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
 }
 
 func (suite *RocketMessageReceiveSmokeTestSuite) executeJSONRequest(
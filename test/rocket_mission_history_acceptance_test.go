@@ -0,0 +1,127 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	rockettest "github.com/soulcodex/rockets-message-processor/test/rocket"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type RocketMissionHistoryAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+
+	reassignedID string
+	stayingID    string
+	launchedAt   time.Time
+	reassignedAt time.Time
+}
+
+func TestRocketMissionHistory(t *testing.T) {
+	suite.Run(t, new(RocketMissionHistoryAcceptanceTestSuite))
+}
+
+func (suite *RocketMissionHistoryAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+
+	suite.launchedAt = time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	suite.reassignedAt = suite.launchedAt.Add(30 * time.Minute)
+	suite.reassignedID = suite.common.UUIDProvider.New().String()
+	suite.stayingID = suite.common.UUIDProvider.New().String()
+
+	for _, id := range []string{suite.reassignedID, suite.stayingID} {
+		rocket := rockettest.NewRocketMother(
+			rockettest.WithRocketID(id),
+			rockettest.WithCreationDate(suite.launchedAt),
+		).Build(suite.T())
+		suite.Require().NoError(suite.rocketModule.Repository.Save(suite.T().Context(), rocket))
+	}
+
+	_, err := suite.rocketModule.Updater.Update(
+		suite.T().Context(),
+		suite.reassignedID,
+		rocketdomain.WithMission("LUNAR", suite.reassignedAt),
+	)
+	suite.Require().NoError(err, "failed to reassign rocket for suite setup")
+}
+
+func (suite *RocketMissionHistoryAcceptanceTestSuite) TestRocketMissions_Success() {
+	response := testutils.ExecuteJSONRequest(
+		suite.T(), suite.common.Router, http.MethodGet, "/rockets/"+suite.reassignedID+"/missions", nil,
+	)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	var missions rocketentrypoint.MissionAssignmentsResponseV1
+	suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &missions))
+	suite.Equal(rocketentrypoint.MissionAssignmentsResponseV1{
+		{Mission: "ARTEMIS", EffectiveFrom: suite.launchedAt, EffectiveTo: &suite.reassignedAt},
+		{Mission: "LUNAR", EffectiveFrom: suite.reassignedAt},
+	}, missions)
+}
+
+func (suite *RocketMissionHistoryAcceptanceTestSuite) TestRocketMissions_FailNotFound() {
+	path := "/rockets/" + suite.common.UUIDProvider.New().String() + "/missions"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusNotFound, response.Code, "Expected status code 404 Not Found")
+}
+
+func (suite *RocketMissionHistoryAcceptanceTestSuite) TestMissionRockets_SuccessInThePast() {
+	rockets := suite.missionRockets("ARTEMIS", suite.reassignedAt.Add(-time.Minute))
+	suite.Len(rockets, 2, "Expected both rockets to be flying ARTEMIS before the reassignment")
+}
+
+func (suite *RocketMissionHistoryAcceptanceTestSuite) TestMissionRockets_SuccessNow() {
+	rockets := suite.missionRockets("ARTEMIS", time.Time{})
+	suite.Require().Len(rockets, 1, "Expected only the rocket still flying ARTEMIS")
+	suite.Equal(suite.stayingID, rockets[0].RocketID)
+	suite.Nil(rockets[0].EffectiveTo)
+
+	rockets = suite.missionRockets("LUNAR", time.Time{})
+	suite.Require().Len(rockets, 1, "Expected the reassigned rocket to be flying LUNAR")
+	suite.Equal(suite.reassignedID, rockets[0].RocketID)
+	suite.Equal(suite.reassignedAt, rockets[0].EffectiveFrom)
+}
+
+func (suite *RocketMissionHistoryAcceptanceTestSuite) TestMissionRockets_FailInvalidTime() {
+	response := testutils.ExecuteJSONRequest(
+		suite.T(), suite.common.Router, http.MethodGet, "/missions/ARTEMIS/rockets?at=yesterday", nil,
+	)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *RocketMissionHistoryAcceptanceTestSuite) missionRockets(
+	mission string,
+	at time.Time,
+) rocketentrypoint.MissionRocketsResponseV1 {
+	suite.T().Helper()
+
+	path := "/missions/" + url.PathEscape(mission) + "/rockets"
+	if !at.IsZero() {
+		path += "?" + url.Values{"at": {at.Format(time.RFC3339)}}.Encode()
+	}
+
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	var rockets rocketentrypoint.MissionRocketsResponseV1
+	suite.NoError(json.Unmarshal(response.Body.Bytes(), &rockets), "failed to unmarshal mission rockets response")
+
+	return rockets
+}
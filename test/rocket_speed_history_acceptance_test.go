@@ -0,0 +1,109 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type RocketSpeedHistoryAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+
+	rocketID rocketdomain.RocketID
+	start    time.Time
+}
+
+func TestRocketSpeedHistory(t *testing.T) {
+	suite.Run(t, new(RocketSpeedHistoryAcceptanceTestSuite))
+}
+
+func (suite *RocketSpeedHistoryAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+	suite.rocketID = rocketdomain.RocketID(suite.common.UUIDProvider.New().String())
+	suite.start = time.Now().UTC().Add(-time.Hour).Truncate(time.Minute)
+
+	points := []rocketdomain.RocketSpeedPoint{
+		{At: suite.start.Add(time.Minute), Value: 3500, Delta: 500, MessageNumber: 2},
+		{At: suite.start.Add(2 * time.Minute), Value: 3000, Delta: -500, MessageNumber: 3},
+		{At: suite.start.Add(12 * time.Minute), Value: 4000, Delta: 1000, MessageNumber: 4},
+	}
+
+	for _, point := range points {
+		err := suite.rocketModule.SpeedHistory.Record(suite.T().Context(), suite.rocketID, point)
+		suite.Require().NoError(err, "failed to record rocket speed for suite setup")
+	}
+}
+
+func (suite *RocketSpeedHistoryAcceptanceTestSuite) TestRocketSpeedHistory_SuccessRawPoints() {
+	response := suite.speedRequest(url.Values{"from": {suite.start.Format(time.RFC3339)}})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	history := suite.historyResponse(response)
+	suite.Empty(history.Buckets, "Expected no buckets without compaction")
+	suite.Require().Len(history.Points, 3, "Expected every recorded point")
+	suite.Equal(rocketentrypoint.RocketSpeedPointResponseV1{
+		At: suite.start.Add(time.Minute), Value: 3500, Delta: 500, MessageNumber: 2,
+	}, history.Points[0])
+}
+
+func (suite *RocketSpeedHistoryAcceptanceTestSuite) TestRocketSpeedHistory_SuccessDownsampled() {
+	response := suite.speedRequest(url.Values{
+		"from": {suite.start.Format(time.RFC3339)},
+		"to":   {suite.start.Add(30 * time.Minute).Format(time.RFC3339)},
+		"step": {"10m"},
+	})
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	history := suite.historyResponse(response)
+	suite.Empty(history.Points, "Expected points to be downsampled")
+	suite.Require().Len(history.Buckets, 2, "Expected one bucket per step with points")
+	suite.Equal(rocketentrypoint.RocketSpeedBucketResponseV1{
+		From: suite.start, To: suite.start.Add(10 * time.Minute), Min: 3000, Max: 3500, Avg: 3250, Count: 2,
+	}, history.Buckets[0])
+	suite.Equal(int64(4000), history.Buckets[1].Max)
+}
+
+func (suite *RocketSpeedHistoryAcceptanceTestSuite) TestRocketSpeedHistory_FailInvalidRange() {
+	response := suite.speedRequest(url.Values{
+		"from": {suite.start.Format(time.RFC3339)},
+		"to":   {suite.start.Add(-time.Minute).Format(time.RFC3339)},
+		"step": {"-1m"},
+	})
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *RocketSpeedHistoryAcceptanceTestSuite) speedRequest(params url.Values) *httptest.ResponseRecorder {
+	path := "/rockets/" + suite.rocketID.String() + "/speed?" + params.Encode()
+	return testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+}
+
+func (suite *RocketSpeedHistoryAcceptanceTestSuite) historyResponse(
+	res *httptest.ResponseRecorder,
+) rocketentrypoint.RocketSpeedHistoryResponseV1 {
+	suite.T().Helper()
+
+	var history rocketentrypoint.RocketSpeedHistoryResponseV1
+	err := json.Unmarshal(res.Body.Bytes(), &history)
+	suite.NoError(err, "failed to unmarshal rocket speed history response")
+
+	return history
+}
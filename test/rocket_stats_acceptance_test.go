@@ -0,0 +1,125 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	rockettest "github.com/soulcodex/rockets-message-processor/test/rocket"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type RocketStatsAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+}
+
+func TestRocketStats(t *testing.T) {
+	suite.Run(t, new(RocketStatsAcceptanceTestSuite))
+}
+
+func (suite *RocketStatsAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+
+	rockets := []*rocketdomain.Rocket{
+		rockettest.NewRocketMother(
+			rockettest.WithRocketID(suite.common.UUIDProvider.New().String()),
+			rockettest.WithLaunchSpeed(3000),
+		).Build(suite.T()),
+		rockettest.NewRocketMother(
+			rockettest.WithRocketID(suite.common.UUIDProvider.New().String()),
+			rockettest.WithRocketType("Falcon Heavy"),
+			rockettest.WithMission("LUNAR"),
+			rockettest.WithLaunchSpeed(5000),
+		).Build(suite.T()),
+		rockettest.NewRocketMother(
+			rockettest.WithRocketID(suite.common.UUIDProvider.New().String()),
+			rockettest.WithLaunchSpeed(7000),
+		).Build(suite.T()),
+	}
+
+	for _, rocket := range rockets {
+		err := suite.rocketModule.Repository.Save(suite.T().Context(), rocket)
+		suite.Require().NoError(err, "failed to save rocket for suite setup")
+	}
+
+	// Saving the same rocket again must replace its previous figures instead of adding them twice.
+	suite.Require().NoError(rockets[0].ChangeLaunchSpeed(4000, time.Now().Add(time.Minute)))
+	suite.Require().NoError(suite.rocketModule.Repository.Save(suite.T().Context(), rockets[0]))
+
+	suite.Require().NoError(rockets[2].Delete(time.Now().Add(time.Minute)))
+	suite.Require().NoError(suite.rocketModule.Repository.Save(suite.T().Context(), rockets[2]))
+}
+
+func (suite *RocketStatsAcceptanceTestSuite) TestRocketStats_SuccessOverall() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/rockets/stats", nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	report := suite.statsResponse(response)
+	suite.Nil(report.GroupBy, "Expected no grouping")
+	suite.Empty(report.Groups, "Expected no groups")
+	suite.Equal(2, report.Overall.Active, "Expected active rockets to match")
+	suite.Equal(1, report.Overall.Exploded, "Expected exploded rockets to match")
+	suite.Equal(map[string]int{"Falcon 9": 1, "Falcon Heavy": 1}, report.Overall.ByRocketType)
+	suite.Equal(map[string]int{"ARTEMIS": 1, "LUNAR": 1}, report.Overall.ByMission)
+	suite.Equal(rocketentrypoint.LaunchSpeedStatsResponseV1{
+		Min: 4000, Max: 5000, Avg: 4500, P50: 4000, P90: 5000, P95: 5000, P99: 5000,
+	}, report.Overall.LaunchSpeed)
+}
+
+func (suite *RocketStatsAcceptanceTestSuite) TestRocketStats_SuccessGroupedByRocketType() {
+	const path = "/rockets/stats?group_by=rocket_type"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	report := suite.statsResponse(response)
+	suite.Require().Len(report.Groups, 2, "Expected one group per rocket type")
+	suite.Equal("Falcon 9", report.Groups[0].Key)
+	suite.Equal(1, report.Groups[0].Active)
+	suite.Equal(1, report.Groups[0].Exploded)
+	suite.Equal("Falcon Heavy", report.Groups[1].Key)
+	suite.Equal(int64(5000), report.Groups[1].LaunchSpeed.Max)
+}
+
+func (suite *RocketStatsAcceptanceTestSuite) TestRocketStats_SuccessFiltered() {
+	const path = "/rockets/stats?group_by=mission&mission=ARTEMIS"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	report := suite.statsResponse(response)
+	suite.Equal(1, report.Overall.Active, "Expected active rockets to match")
+	suite.Equal(1, report.Overall.Exploded, "Expected exploded rockets to match")
+	suite.Require().Len(report.Groups, 1, "Expected only the filtered mission")
+	suite.Equal("ARTEMIS", report.Groups[0].Key)
+}
+
+func (suite *RocketStatsAcceptanceTestSuite) TestRocketStats_FailUnknownGrouping() {
+	const path = "/rockets/stats?group_by=launch_speed"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *RocketStatsAcceptanceTestSuite) statsResponse(res *httptest.ResponseRecorder) rocketentrypoint.RocketStatsReportResponseV1 {
+	suite.T().Helper()
+
+	var report rocketentrypoint.RocketStatsReportResponseV1
+	err := json.Unmarshal(res.Body.Bytes(), &report)
+	suite.NoError(err, "failed to unmarshal rocket stats response")
+
+	return report
+}
@@ -0,0 +1,107 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	rocketdomain "github.com/soulcodex/rockets-message-processor/internal/rocket/domain"
+	rocketentrypoint "github.com/soulcodex/rockets-message-processor/internal/rocket/infrastructure/entrypoint"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type RocketTypesAcceptanceTestSuite struct {
+	suite.Suite
+
+	common       *di.CommonServices
+	rocketModule *di.RocketModule
+}
+
+func TestRocketTypes(t *testing.T) {
+	suite.Run(t, new(RocketTypesAcceptanceTestSuite))
+}
+
+func (suite *RocketTypesAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+}
+
+func (suite *RocketTypesAcceptanceTestSuite) TestRegisterRocketType_Success() {
+	response := suite.registerRocketType("Electron", `{"aliases": ["Electron-RL"], "min_speed": 10, "max_speed": 8000}`)
+	suite.Equal(http.StatusNoContent, response.Code, "Expected status code 204 No Content")
+
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/rocket-types", nil)
+	suite.Require().Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	var rocketTypes rocketentrypoint.RocketTypesResponseV1
+	suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &rocketTypes), "failed to unmarshal rocket types response")
+	suite.Contains(rocketTypes, rocketentrypoint.RocketTypeResponseV1{
+		Name:     "Electron",
+		Aliases:  []string{"Electron-RL"},
+		MinSpeed: 10,
+		MaxSpeed: 8000,
+	})
+}
+
+func (suite *RocketTypesAcceptanceTestSuite) TestRegisterRocketType_FailInvalidSpec() {
+	response := suite.registerRocketType("Vega-C", `{"min_speed": 9000, "max_speed": 100}`)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *RocketTypesAcceptanceTestSuite) TestRegisterRocketType_FailAliasTaken() {
+	suite.Require().Equal(http.StatusNoContent, suite.registerRocketType("Ariane 5", `{"aliases": ["A5"], "max_speed": 9000}`).Code)
+
+	response := suite.registerRocketType("Atlas V", `{"aliases": ["a5"], "max_speed": 9000}`)
+	suite.Equal(http.StatusConflict, response.Code, "Expected status code 409 Conflict")
+}
+
+func (suite *RocketTypesAcceptanceTestSuite) TestRocketLaunch_HeldToRocketTypeLimits() {
+	suite.Require().Equal(http.StatusNoContent, suite.registerRocketType("Falcon Heavy", `{"aliases": ["FH"], "max_speed": 5000}`).Code)
+
+	rocketID, launchedAt := suite.common.UUIDProvider.New().String(), time.Now().Add(-time.Hour)
+	response := suite.sendRocketEvent(rocketID, 1, `{"type": "falcon-heavy","launchSpeed": 4500,"mission": "ARTEMIS"}`, "RocketLaunched", launchedAt)
+	suite.Require().Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+
+	rocket, err := suite.rocketModule.Repository.Find(suite.T().Context(), rocketdomain.RocketID(rocketID))
+	suite.Require().NoError(err)
+	suite.Equal("Falcon Heavy", rocket.Primitives().RocketType, "Expected the canonical rocket type name")
+
+	response = suite.sendRocketEvent(rocketID, 2, `{"by": 600}`, "RocketSpeedIncreased", launchedAt.Add(time.Minute))
+	suite.Equal(http.StatusUnprocessableEntity, response.Code, "Expected status code 422 Unprocessable Entity")
+
+	otherID := suite.common.UUIDProvider.New().String()
+	response = suite.sendRocketEvent(otherID, 1, `{"type": "FH","launchSpeed": 5001,"mission": "ARTEMIS"}`, "RocketLaunched", launchedAt)
+	suite.Equal(http.StatusUnprocessableEntity, response.Code, "Expected status code 422 Unprocessable Entity")
+}
+
+func (suite *RocketTypesAcceptanceTestSuite) registerRocketType(name, body string) *httptest.ResponseRecorder {
+	path := "/rocket-types/" + url.PathEscape(name)
+	return testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPut, path, []byte(body))
+}
+
+func (suite *RocketTypesAcceptanceTestSuite) sendRocketEvent(
+	channel string,
+	messageNumber int,
+	content string,
+	messageType string,
+	at time.Time,
+) *httptest.ResponseRecorder {
+	body := fmt.Sprintf(
+		`{"metadata": {"channel": "%s","messageNumber": %d,"messageTime": "%s","messageType": "%s"},"message": %s}`,
+		channel, messageNumber, at.Format(time.RFC3339), messageType, content,
+	)
+
+	return testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodPost, "/messages", []byte(body))
+}
@@ -0,0 +1,140 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/soulcodex/rockets-message-processor/cmd/di"
+	distributedsync "github.com/soulcodex/rockets-message-processor/pkg/distributed-sync"
+	testutils "github.com/soulcodex/rockets-message-processor/test/utils"
+)
+
+type schedulerJobsResponse struct {
+	Leader bool `json:"leader"`
+	Jobs   []struct {
+		Name           string     `json:"name"`
+		Schedule       string     `json:"schedule"`
+		LastRunAt      *time.Time `json:"last_run_at"`
+		LastDurationMs *int64     `json:"last_duration_ms"`
+		LastError      *string    `json:"last_error"`
+	} `json:"jobs"`
+}
+
+type SchedulerAcceptanceTestSuite struct {
+	suite.Suite
+
+	common          *di.CommonServices
+	schedulerModule *di.SchedulerModule
+	stopScheduler   context.CancelFunc
+	heartbeats      atomic.Int32
+}
+
+func TestScheduler(t *testing.T) {
+	suite.Run(t, new(SchedulerAcceptanceTestSuite))
+}
+
+func (suite *SchedulerAcceptanceTestSuite) SetupSuite() {
+	suite.common = di.MustInitCommonServicesWithEnvFiles(
+		suite.T().Context(),
+		"../.env",
+		".test.env",
+	)
+	_ = di.NewRocketModule(suite.T().Context(), suite.common)
+	suite.common.FlushAll(suite.T().Context())
+
+	suite.Require().NoError(suite.common.Scheduler.Register("heartbeat", "@every 20ms", func(context.Context) error {
+		suite.heartbeats.Add(1)
+		return nil
+	}))
+
+	var ctx context.Context
+	ctx, suite.stopScheduler = context.WithCancel(context.Background())
+	suite.schedulerModule = di.NewSchedulerModule(ctx, suite.common)
+}
+
+func (suite *SchedulerAcceptanceTestSuite) TearDownSuite() {
+	suite.stopScheduler()
+}
+
+func (suite *SchedulerAcceptanceTestSuite) TestAdminJobs_ReportsJobsRunByTheLeader() {
+	suite.Require().Eventually(func() bool {
+		return suite.schedulerModule.Leader.IsLeader() && suite.heartbeats.Load() > 0
+	}, 5*time.Second, 10*time.Millisecond, "Expected the only instance to lead and run the jobs")
+
+	suite.Require().Eventually(func() bool {
+		return suite.findJobs().Jobs[0].LastRunAt != nil
+	}, time.Second, 10*time.Millisecond, "Expected the status of the job run to be recorded")
+
+	response := suite.findJobs()
+	suite.True(response.Leader)
+
+	heartbeat := response.Jobs[0]
+	suite.Equal("heartbeat", heartbeat.Name)
+	suite.Equal("@every 20ms", heartbeat.Schedule)
+	suite.NotNil(heartbeat.LastDurationMs)
+	suite.Nil(heartbeat.LastError)
+
+	if suite.common.Config.Standalone() || suite.common.Config.SpeedHistoryStore == "memory" {
+		suite.Len(response.Jobs, 1, "Expected speed histories kept in memory to be compacted by every instance instead")
+		return
+	}
+
+	suite.Require().Len(response.Jobs, 2)
+	compaction := response.Jobs[1]
+	suite.Equal("rocket-speed-history-compaction", compaction.Name)
+	suite.Equal("@every 10m0s", compaction.Schedule)
+	suite.Nil(compaction.LastRunAt, "Expected jobs that never ran to have no status")
+}
+
+func (suite *SchedulerAcceptanceTestSuite) TestLeaderLosingItsLease_IsToldAndRunsAgain() {
+	if suite.common.RedisClient == nil {
+		suite.T().Skip("leases are only lost on redis, the service runs standalone")
+	}
+
+	ctx, cancel := context.WithCancel(suite.T().Context())
+	defer cancel()
+
+	mutex := distributedsync.NewRedisMutexService(suite.common.RedisClient, suite.common.Logger)
+	leader := distributedsync.NewLeaderElector(
+		mutex,
+		"lease-loss",
+		suite.common.Logger,
+		distributedsync.WithLeaderLease(300*time.Millisecond),
+		distributedsync.WithLeaderRetryInterval(20*time.Millisecond),
+	)
+
+	var elections, losses atomic.Int32
+	go leader.Campaign(ctx, distributedsync.LeaderCallbacks{
+		OnElected: func(ctx context.Context) {
+			elections.Add(1)
+			<-ctx.Done()
+		},
+		OnLost: func(err error) {
+			suite.True(distributedsync.IsMutexLeaseLostError(err))
+			losses.Add(1)
+		},
+	})
+
+	suite.Require().Eventually(leader.IsLeader, time.Second, 10*time.Millisecond)
+	suite.common.RedisClient.Del(ctx, "distributed-sync-mutex:leader-election:lease-loss")
+
+	suite.Require().Eventually(func() bool {
+		return losses.Load() == 1 && elections.Load() == 2
+	}, 2*time.Second, 10*time.Millisecond, "Expected the lost leadership to be told and taken again")
+}
+
+func (suite *SchedulerAcceptanceTestSuite) findJobs() schedulerJobsResponse {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/admin/jobs", nil)
+	suite.Require().Equal(http.StatusOK, response.Code)
+
+	var jobs schedulerJobsResponse
+	suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &jobs))
+
+	return jobs
+}
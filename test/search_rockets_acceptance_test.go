@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
@@ -37,7 +38,7 @@ func (suite *SearchRocketsAcceptanceTestSuite) SetupSuite() {
 		".test.env",
 	)
 	suite.rocketModule = di.NewRocketModule(suite.T().Context(), suite.common)
-	suite.common.RedisClient.FlushAll(suite.T().Context())
+	suite.common.FlushAll(suite.T().Context())
 
 	rocketOne := rockettest.NewRocketMother(
 		rockettest.WithRocketID(suite.common.UUIDProvider.New().String()),
@@ -117,12 +118,116 @@ func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_SuccessUpdateDa
 	}
 }
 
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_SuccessByMultipleKeys() {
+	const path = "/rockets?sort=mission,-launch_speed,id"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	rocketsResponse := suite.rocketResponse(response)
+	suite.Require().Len(rocketsResponse, 2, "Expected number of rockets to match")
+
+	for idx, rocket := range suite.rocketsBySpeed.All() {
+		suite.Equal(rocket.Primitives().ID, rocketsResponse[idx].ID, "Expected rocket ID to match")
+	}
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_FailUnknownSortKey() {
+	const path = "/rockets?sort=-launch_speed,name"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+	suite.Contains(response.Body.String(), "unknown sort field 'name'", "Expected unknown key to be reported")
+	suite.Contains(response.Body.String(), "rocket_type", "Expected allowed fields to be listed")
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_SuccessFilteredByLaunchSpeed() {
+	const path = "/rockets?launch_speed_min=4000&mission=ARTEMIS"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	rocketsResponse := suite.rocketResponse(response)
+	suite.Require().Len(rocketsResponse, 1, "Expected number of rockets to match")
+	suite.Equal(suite.rocketsBySpeed.All()[0].Primitives().ID, rocketsResponse[0].ID, "Expected rocket ID to match")
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_FailMalformedFilters() {
+	const path = "/rockets?launch_speed_min=fast&created_after=yesterday"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+	suite.Contains(response.Body.String(), "launch_speed_min", "Expected malformed param to be reported")
+	suite.Contains(response.Body.String(), "created_after", "Expected malformed param to be reported")
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_FailInvalidLaunchSpeedRange() {
+	const path = "/rockets?launch_speed_min=6000&launch_speed_max=1000"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_SuccessPaginated() {
+	const path = "/rockets?sort=-launch_speed&limit=1"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	firstPage := suite.rocketPageResponse(response)
+	suite.Require().Len(firstPage.Data, 1, "Expected page size to match the limit")
+	suite.True(firstPage.HasMore, "Expected more rockets to be available")
+	suite.Require().NotNil(firstPage.NextCursor, "Expected next cursor to be present")
+	suite.Contains(response.Header().Get("Link"), `rel="next"`, "Expected link to the next page")
+	suite.Equal(suite.rocketsBySpeed.All()[0].Primitives().ID, firstPage.Data[0].ID, "Expected rocket ID to match")
+
+	nextPath := path + "&cursor=" + *firstPage.NextCursor
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, nextPath, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	secondPage := suite.rocketPageResponse(response)
+	suite.Require().Len(secondPage.Data, 1, "Expected page size to match the limit")
+	suite.False(secondPage.HasMore, "Expected no more rockets to be available")
+	suite.Nil(secondPage.NextCursor, "Expected next cursor to be absent")
+	suite.Empty(response.Header().Get("Link"), "Expected no link to a next page")
+	suite.Equal(suite.rocketsBySpeed.All()[1].Primitives().ID, secondPage.Data[0].ID, "Expected rocket ID to match")
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_FailCursorFromAnotherSort() {
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, "/rockets?limit=1", nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	page := suite.rocketPageResponse(response)
+	suite.Require().NotNil(page.NextCursor, "Expected next cursor to be present")
+
+	path := "/rockets?sort=launch_speed&cursor=" + *page.NextCursor
+	response = testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_FailMalformedCursor() {
+	const path = "/rockets?cursor=not-a-cursor"
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_SuccessFilteredByExpression() {
+	path := "/rockets?filter=" + url.QueryEscape(`launch_speed > 4000 and mission in ("ARTEMIS", "LUNAR")`)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusOK, response.Code, "Expected status code 200 OK")
+	rocketsResponse := suite.rocketResponse(response)
+	suite.Require().Len(rocketsResponse, 1, "Expected number of rockets to match")
+	suite.Equal(suite.rocketsBySpeed.All()[0].Primitives().ID, rocketsResponse[0].ID, "Expected rocket ID to match")
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) TestSearchRockets_FailMalformedExpression() {
+	path := "/rockets?filter=" + url.QueryEscape(`launch_speed > "fast"`)
+	response := testutils.ExecuteJSONRequest(suite.T(), suite.common.Router, http.MethodGet, path, nil)
+	suite.Equal(http.StatusBadRequest, response.Code, "Expected status code 400 Bad Request")
+	suite.Contains(response.Body.String(), "position 16", "Expected error position to be reported")
+}
+
 func (suite *SearchRocketsAcceptanceTestSuite) rocketResponse(res *httptest.ResponseRecorder) rocketentrypoint.RocketsResponseV1 {
 	suite.T().Helper()
 
-	var rocketResponse rocketentrypoint.RocketsResponseV1
-	err := json.Unmarshal(res.Body.Bytes(), &rocketResponse)
-	suite.NoError(err, "failed to unmarshal rocket response")
+	return suite.rocketPageResponse(res).Data
+}
+
+func (suite *SearchRocketsAcceptanceTestSuite) rocketPageResponse(res *httptest.ResponseRecorder) rocketentrypoint.RocketsPageResponseV1 {
+	suite.T().Helper()
+
+	var pageResponse rocketentrypoint.RocketsPageResponseV1
+	err := json.Unmarshal(res.Body.Bytes(), &pageResponse)
+	suite.NoError(err, "failed to unmarshal rockets page response")
 
-	return rocketResponse
+	return pageResponse
 }